@@ -100,6 +100,7 @@ import (
 	"github.com/tharsis/ethermint/encoding"
 
 	"freemasonry.cc/blockchain/app/ante"
+	"freemasonry.cc/blockchain/app/upgrades/v2"
 	srvflags "github.com/tharsis/ethermint/server/flags"
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm"
@@ -130,6 +131,7 @@ import (
 	vestingkeeper "github.com/tharsis/evmos/v4/x/vesting/keeper"
 	vestingtypes "github.com/tharsis/evmos/v4/x/vesting/types"
 
+	chatclient "freemasonry.cc/blockchain/x/chat/client"
 	chatkeeper "freemasonry.cc/blockchain/x/chat/keeper"
 	chattypes "freemasonry.cc/blockchain/x/chat/types"
 )
@@ -169,6 +171,7 @@ var (
 			
 			erc20client.RegisterCoinProposalHandler, erc20client.RegisterERC20ProposalHandler, erc20client.ToggleTokenConversionProposalHandler,
 			incentivesclient.RegisterIncentiveProposalHandler, incentivesclient.CancelIncentiveProposalHandler,
+			chatclient.SetGiftProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -207,7 +210,9 @@ var (
 		
 		chattypes.ModuleName:     nil,
 		chattypes.ModuleBurnName: {authtypes.Burner},
+		chattypes.RewardPoolName: nil,
 		commtypes.ModuleName:     nil,
+		commtypes.ModuleBurnName: {authtypes.Burner},
 	}
 
 	
@@ -215,6 +220,7 @@ var (
 		distrtypes.ModuleName:      true,
 		incentivestypes.ModuleName: true,
 		chattypes.ModuleName:       true,
+		chattypes.RewardPoolName:   true,
 	}
 )
 
@@ -422,7 +428,8 @@ func NewEvmos(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
-		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper))
+		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper)).
+		AddRoute(chattypes.RouterKey, chat.NewChatProposalHandler(&app.ChatKeeper))
 		
 
 	govKeeper := govkeeper.NewKeeper(
@@ -445,11 +452,15 @@ func NewEvmos(
 	
 	
 	
+	app.CommKeeper = commkeeper.NewKeeper(keys[commtypes.StoreKey], appCodec, app.GetSubspace(commtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, &app.StakingKeeper)
+
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
 			app.ClaimsKeeper.Hooks(),
+			app.CommKeeper,
 		),
 	)
 
@@ -547,10 +558,6 @@ func NewEvmos(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	
-	app.CommKeeper = commkeeper.NewKeeper(keys[commtypes.StoreKey], appCodec, app.GetSubspace(commtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
-
-	
 	app.ChatKeeper = chatkeeper.NewKeeper(keys[chattypes.StoreKey], appCodec, app.GetSubspace(chattypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.CommKeeper)
 
@@ -596,8 +603,8 @@ func NewEvmos(
 		claims.NewAppModule(appCodec, *app.ClaimsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		recovery.NewAppModule(*app.RecoveryKeeper),
-		chat.NewAppModule(app.ChatKeeper, app.AccountKeeper),
-		comm.NewAppModule(app.CommKeeper, app.AccountKeeper),
+		chat.NewAppModule(appCodec, app.ChatKeeper, app.AccountKeeper, app.BankKeeper, app.CommKeeper),
+		comm.NewAppModule(appCodec, app.CommKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 	)
 
 	
@@ -613,6 +620,7 @@ func NewEvmos(
 		epochstypes.ModuleName,
 		feemarkettypes.ModuleName,
 		evmtypes.ModuleName,
+		chattypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -634,7 +642,6 @@ func NewEvmos(
 		claimstypes.ModuleName,
 		
 		recoverytypes.ModuleName,
-		chattypes.ModuleName,
 		commtypes.ModuleName,
 	)
 
@@ -706,11 +713,25 @@ func NewEvmos(
 		epochstypes.ModuleName,
 		recoverytypes.ModuleName,
 		
-		crisistypes.ModuleName,
 		chattypes.ModuleName,
 		commtypes.ModuleName,
+		
+		crisistypes.ModuleName,
 	)
 
+	
+	migrationsOrder := make([]string, 0, len(app.mm.Modules))
+	for _, moduleName := range module.DefaultMigrationsOrder(app.mm.ModuleNames()) {
+		switch moduleName {
+		case commtypes.ModuleName:
+		case chattypes.ModuleName:
+			migrationsOrder = append(migrationsOrder, commtypes.ModuleName, chattypes.ModuleName)
+		default:
+			migrationsOrder = append(migrationsOrder, moduleName)
+		}
+	}
+	app.mm.SetOrderMigrations(migrationsOrder...)
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -741,8 +762,8 @@ func NewEvmos(
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
-		chat.NewAppModule(app.ChatKeeper, app.AccountKeeper),
-		comm.NewAppModule(app.CommKeeper, app.AccountKeeper),
+		chat.NewAppModule(appCodec, app.ChatKeeper, app.AccountKeeper, app.BankKeeper, app.CommKeeper),
+		comm.NewAppModule(appCodec, app.CommKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -1037,9 +1058,11 @@ func initParamsKeeper(
 }
 
 func (app *Evmos) setupUpgradeHandlers() {
-	
-	
-	
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v2.UpgradeName,
+		v2.CreateUpgradeHandler(app.mm, app.configurator),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
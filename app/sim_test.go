@@ -0,0 +1,289 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+
+	"freemasonry.cc/blockchain/cmd/config"
+)
+
+
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+const SimAppChainID = "simulation_777-1"
+
+
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+
+func interBlockCacheOpt() func(*baseapp.BaseApp) {
+	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
+}
+
+
+func setupSimulationPowerReduction() func() {
+	powerReduction := sdk.DefaultPowerReduction
+	sdk.DefaultPowerReduction = sdk.NewIntFromUint64(1000000)
+
+	return func() {
+		sdk.DefaultPowerReduction = powerReduction
+	}
+}
+
+
+func randomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, n)
+
+	for i := 0; i < n; i++ {
+		privkeySeed := make([]byte, 15)
+		_, _ = r.Read(privkeySeed)
+
+		prv := secp256k1.GenPrivKeyFromSecret(privkeySeed)
+		ethPrv := &ethsecp256k1.PrivKey{}
+		_ = ethPrv.UnmarshalAmino(prv.Bytes())
+		accs[i].PrivKey = ethPrv
+		accs[i].PubKey = accs[i].PrivKey.PubKey()
+		accs[i].Address = sdk.AccAddress(accs[i].PubKey.Address())
+
+		accs[i].ConsKey = ed25519.GenPrivKeyFromSecret(privkeySeed)
+	}
+
+	return accs
+}
+
+
+func appStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, simConfig simtypes.Config,
+	) (json.RawMessage, []simtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTimestamp := simapp.AppStateFn(cdc, simManager)(r, accs, simConfig)
+
+		rawState := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(appState, &rawState); err != nil {
+			panic(err)
+		}
+
+		for moduleName, genesis := range NewDefaultGenesisState() {
+			if _, ok := rawState[moduleName]; !ok {
+				rawState[moduleName] = genesis
+			}
+		}
+
+		bankState := new(banktypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], bankState)
+		simAddrs := make(map[string]bool, len(simAccs))
+		for _, acc := range simAccs {
+			simAddrs[acc.Address.String()] = true
+		}
+		for i, balance := range bankState.Balances {
+			if !simAddrs[balance.Address] {
+				continue
+			}
+			coin := sdk.NewCoin(config.BaseDenom, balance.Coins.AmountOf(sdk.DefaultBondDenom))
+			bankState.Balances[i].Coins = balance.Coins.Add(coin)
+			bankState.Supply = bankState.Supply.Add(coin)
+		}
+		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+		evmState := new(evmtypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[evmtypes.ModuleName], evmState)
+		evmState.Params.EvmDenom = config.BaseDenom
+		rawState[evmtypes.ModuleName] = cdc.MustMarshalJSON(evmState)
+
+		appState, err := json.Marshal(rawState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, simAccs, chainID, genesisTimestamp
+	}
+}
+
+
+func simulationOperations(app *Evmos, cdc codec.JSONCodec, simConfig simtypes.Config) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       cdc,
+	}
+
+	if simConfig.ParamsFile != "" {
+		bz, err := os.ReadFile(simConfig.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		err = json.Unmarshal(bz, &simState.AppParams)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, key := range []string{stakingsim.OpWeightMsgCreateValidator, stakingsim.OpWeightMsgEditValidator} {
+		if _, ok := simState.AppParams[key]; !ok {
+			simState.AppParams[key] = json.RawMessage("0")
+		}
+	}
+
+	simState.ParamChanges = app.SimulationManager().GenerateParamChanges(simConfig.Seed)
+	simState.Contents = app.SimulationManager().GetProposalContents(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
+
+func simulationStoreHashes(app *Evmos) map[string]string {
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	storeHashes := make(map[string]string)
+
+	for name, key := range app.keys {
+		if name == feemarkettypes.StoreKey {
+			continue
+		}
+
+		hash := sha256.New()
+		iterator := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			hash.Write(iterator.Key())
+			hash.Write(iterator.Value())
+		}
+		iterator.Close()
+
+		storeHashes[name] = fmt.Sprintf("%X", hash.Sum(nil))
+	}
+	return storeHashes
+}
+
+
+func TestFullAppSimulation(t *testing.T) {
+	simConfig, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	defer setupSimulationPowerReduction()()
+
+	simConfig.ChainID = SimAppChainID
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := NewEvmos(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{}, fauxMerkleModeOpt)
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		appStateFn(app.AppCodec(), app.SimulationManager()),
+		randomAccounts,
+		simulationOperations(app, app.AppCodec(), simConfig),
+		app.ModuleAccountAddrs(),
+		simConfig,
+		app.AppCodec(),
+	)
+
+	err = simapp.CheckExportSimulation(app, simConfig, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if simConfig.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+
+func TestAppStateDeterminism(t *testing.T) {
+	if !simapp.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+	defer setupSimulationPowerReduction()()
+
+	simConfig := simapp.NewConfigFromFlags()
+	simConfig.InitialBlockHeight = 1
+	simConfig.ExportParamsPath = ""
+	simConfig.OnOperation = false
+	simConfig.AllInvariants = false
+	simConfig.ChainID = SimAppChainID
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 5
+	storeHashList := make([]map[string]string, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		simConfig.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			var logger log.Logger
+			if simapp.FlagVerboseValue {
+				logger = log.TestingLogger()
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			db := dbm.NewMemDB()
+			app := NewEvmos(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{}, interBlockCacheOpt())
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				simConfig.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				app.BaseApp,
+				appStateFn(app.AppCodec(), app.SimulationManager()),
+				randomAccounts,
+				simulationOperations(app, app.AppCodec(), simConfig),
+				app.ModuleAccountAddrs(),
+				simConfig,
+				app.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			if simConfig.Commit {
+				simapp.PrintStats(db)
+			}
+
+			storeHashes := simulationStoreHashes(app)
+			storeHashList[j] = storeHashes
+
+			if j != 0 {
+				require.Equal(
+					t, storeHashList[0], storeHashes,
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", simConfig.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package v2
+
+const (
+	UpgradeName = "v2.0.0"
+)
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	v2 "freemasonry.cc/blockchain/app/upgrades/v2"
+	chattypes "freemasonry.cc/blockchain/x/chat/types"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+)
+
+
+func resetToV2Params(ctx sdk.Context, app *Evmos, moduleName string, keys ...[]byte) {
+	keep := make(map[string]bool)
+	for _, key := range keys {
+		keep[string(key)] = true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(app.keys[paramstypes.StoreKey]), []byte(moduleName+"/"))
+	iterator := store.Iterator(nil, nil)
+	deleted := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if !keep[string(iterator.Key())] {
+			deleted = append(deleted, append([]byte{}, iterator.Key()...))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range deleted {
+		store.Delete(key)
+	}
+}
+
+
+func TestV2UpgradeFromV2Params(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	resetToV2Params(ctx, app, chattypes.ModuleName,
+		chattypes.KeyCommunityAddress,
+		chattypes.KeyEcologicalAddress,
+		chattypes.KeyCoin,
+		chattypes.KeyChatRewardLog,
+		chattypes.KeyMaxPhoneNumber,
+	)
+	resetToV2Params(ctx, app, commtypes.ModuleName,
+		commtypes.KeyIndexNumHeight,
+		commtypes.KeyRedeemFeeHeight,
+		commtypes.KeyRedeemFee,
+		commtypes.KeyMinDelegate,
+		commtypes.KeyValidity,
+		commtypes.KeyBonusCycle,
+		commtypes.KeyBonusHalve,
+		commtypes.KeyBonus,
+	)
+	require.Panics(t, func() { app.ChatKeeper.GetParams(ctx) })
+	require.Panics(t, func() { app.CommKeeper.GetParams(ctx) })
+
+	fromVM := app.mm.GetVersionMap()
+	fromVM[chattypes.ModuleName] = 2
+	fromVM[commtypes.ModuleName] = 2
+
+	var toVM module.VersionMap
+	require.NotPanics(t, func() {
+		var err error
+		toVM, err = v2.CreateUpgradeHandler(app.mm, app.configurator)(ctx, upgradetypes.Plan{Name: v2.UpgradeName}, fromVM)
+		require.NoError(t, err)
+	})
+	require.Equal(t, app.mm.GetVersionMap(), toVM)
+	require.Equal(t, uint64(3), toVM[chattypes.ModuleName])
+	require.Equal(t, uint64(3), toVM[commtypes.ModuleName])
+
+	chatParams := app.ChatKeeper.GetParams(ctx)
+	require.NoError(t, chatParams.Validate())
+	require.Equal(t, chattypes.DefaultParams().ChatSessionPeriod, chatParams.ChatSessionPeriod)
+	commParams := app.CommKeeper.GetParams(ctx)
+	require.NoError(t, commParams.Validate())
+	require.Equal(t, commtypes.DefaultParams().HeartbeatWindow, commParams.HeartbeatWindow)
+}
@@ -61,22 +61,4 @@ var (
 	MinRealAmountDec = sdk.NewDecWithPrec(1, 10)
 
 	RealToLedgerRateDec = sdk.MustNewDecFromStr("1000000000000000000")
-
-
-	MortgageRatioDecNode = sdk.NewDec(5).Quo(sdk.NewDec(100))
-
-
-	MortgageRatioDecBurn = sdk.NewDec(5).Quo(sdk.NewDec(100))
-
-
-	MortgageRatioDecCommunity = sdk.NewDec(1).Quo(sdk.NewDec(100))
-
-
-	MortgageRatioDecEcological = sdk.NewDec(1).Quo(sdk.NewDec(100))
-
-
-	MortgageRatioDecPos = sdk.NewDec(3).Quo(sdk.NewDec(100))
-
-
-	MortgageRatioDecRemain = sdk.NewDec(85).Quo(sdk.NewDec(100))
 )
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"freemasonry.cc/blockchain/x/chat/types"
@@ -11,116 +14,408 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
-		Short:                      "Querying commands for the erc20 module",
+		Short:                      "Querying commands for the chat module",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
 
 	cmd.AddCommand(
-	
-	
-	
+		GetUserInfoCmd(),
+		GetUserByMobileCmd(),
+		GetResolveMobileCmd(),
+		GetParamsCmd(),
+		GetPendingRewardsCmd(),
+		GetAddressBookCmd(),
+		GetChatSessionsCmd(),
+		GetGiftsCmd(),
+		GetRewardPoolCmd(),
+		GetMobileListingsCmd(),
+		GetMobileListingsBySellerCmd(),
+		GetBlockedUsersCmd(),
 	)
 	return cmd
 }
 
-//
-
-
-
-
-
-
-
-
-
-
-
-
-//
-
-//
-
-
-
-
-//
-
-
-
-//
-
-
-
 
-//
-
-
-
-//
-
-
-
-//
-
-
-
-
-
-
-
-
-
-
-
-
-//
-
-//
-
-
-
-//
+func GetUserInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-info [address]",
+		Short: "Get the chat info of a registered user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUserInfoRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.UserInfo(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetUserByMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-by-mobile [mobile]",
+		Short: "Get the chat info of the user owning a mobile number",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUserByMobileRequest{
+				Mobile: args[0],
+			}
+
+			res, err := queryClient.UserByMobile(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetResolveMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-mobile [mobile]",
+		Short: "Get the address of the user holding a mobile number",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryResolveMobileRequest{
+				Mobile: args[0],
+			}
+
+			res, err := queryClient.ResolveMobile(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets chat params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetPendingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [address]",
+		Short: "Get the chat rewards a user can currently claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRewardsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.PendingRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetAddressBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-book [address]",
+		Short: "Get the address book saved by a chat user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAddressBookRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.AddressBook(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetChatSessionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chat-sessions [address]",
+		Short: "Get the open paid chat sessions sent and received by a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChatSessionsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.ChatSessions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetGiftsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gifts",
+		Short: "Get the gift catalog",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Gifts(context.Background(), &types.QueryGiftsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
-//
 
-//
+func GetRewardPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Short: "Get the chat reward pool balance and runway",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(context.Background(), &types.QueryRewardPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetMobileListingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mobile-listings [mobile-prefix]",
+		Short: "Get the mobile numbers listed for sale, optionally only those starting with a prefix",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMobileListingsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.Prefix = args[0]
+			}
+
+			res, err := queryClient.MobileListings(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mobile listings")
+	return cmd
+}
 
 
+func GetMobileListingsBySellerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mobile-listings-by-seller [seller]",
+		Short: "Get the mobile numbers a user has listed for sale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMobileListingsBySellerRequest{
+				Seller:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MobileListingsBySeller(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mobile listings")
+	return cmd
+}
 
 
+func GetBlockedUsersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-users [address]",
+		Short: "Get the addresses a user has blocked",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockedUsersRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BlockedUsers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked users")
+	return cmd
+}
@@ -1,25 +1,690 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
+	"freemasonry.cc/blockchain/cmd/config"
 	"freemasonry.cc/blockchain/x/chat/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
-		Short:                      "erc20 subcommands",
+		Short:                      "chat subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
 
 	txCmd.AddCommand(
-
-
+		NewRegisterCmd(),
+		NewMortgageCmd(),
+		NewSetChatFeeCmd(),
+		NewSendGiftCmd(),
+		NewAddressBookSaveCmd(),
+		NewGetRewardsCmd(),
+		NewMobileTransferCmd(),
+		NewRedeemCmd(),
+		NewApplyMobileCmd(),
+		NewReleaseMobileCmd(),
+		NewPayChatCmd(),
+		NewAcceptChatCmd(),
+		NewListMobileCmd(),
+		NewCancelListingCmd(),
+		NewBuyMobileCmd(),
+		NewSendToMobileCmd(),
+		NewBlockUserCmd(),
+		NewUnblockUserCmd(),
+		NewChangeGatewayCmd(),
 	)
 	return txCmd
 }
+
+
+func NewRegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [node-address] [mobile-prefix] [mortgage-amount]",
+		Short: "Register a chat user, mortgage coins to a gateway node and obtain a mobile number",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegister(cliCtx.GetFromAddress().String(), args[0], args[1], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewMortgageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mortgage [node-address] [mortgage-amount]",
+		Short: "Add mortgage coins for a registered chat user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMortgage(cliCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewSetChatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-chat-fee [fee]",
+		Short: "Set the fee other users pay to chat with you",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChatFee(cliCtx.GetFromAddress().String(), fee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewSendGiftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-gift [node-address] [to-address] [gift-id] [gift-amount] [gift-value]",
+		Short: "Send a gift to another chat user",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			giftId, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gift id %s", args[2])
+			}
+
+			giftAmount, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gift amount %s", args[3])
+			}
+
+			giftValue, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendGift(cliCtx.GetFromAddress().String(), args[1], giftId, giftAmount, giftValue)
+			msg.NodeAddress = args[0]
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewAddressBookSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-book-save [mobile]...",
+		Short: "Save the address book of the sender",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddressBookSave(cliCtx.GetFromAddress().String(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewGetRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-rewards",
+		Short: "Claim the pending chat rewards of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGetRewards(cliCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewMobileTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mobile-transfer [to-address|to-mobile] [mobile]",
+		Short: "Transfer a mobile number owned by the sender to another chat user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddress, err := ResolveAddressOrMobile(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMobileTransfer(cliCtx.GetFromAddress().String(), toAddress, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewRedeemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [amount]",
+		Short: "Redeem mortgage coins, paid back once the redeem period has passed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeem(cliCtx.GetFromAddress().String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewApplyMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-mobile [node-address] [mobile-prefix]",
+		Short: "Obtain an additional mobile number from a gateway number segment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApplyMobile(cliCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewReleaseMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-mobile [mobile]",
+		Short: "Release a mobile number owned by the sender back to its gateway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseMobile(cliCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewPayChatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-chat [to-address|to-mobile] [fee]",
+		Short: "Open a paid chat session, escrowing the recipient's chat fee until they accept",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			toAddress, err := ResolveAddressOrMobile(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayChat(cliCtx.GetFromAddress().String(), toAddress, fee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewAcceptChatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-chat [sender-address]",
+		Short: "Accept a paid chat session and receive the escrowed chat fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptChat(cliCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewListMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-mobile [mobile] [price] [expire-height]",
+		Short: "List a held mobile number for sale, optionally until the given block height",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var expireHeight int64
+			if len(args) > 2 {
+				expireHeight, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgListMobile(cliCtx.GetFromAddress().String(), args[0], price, expireHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewCancelListingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-listing [mobile]",
+		Short: "Withdraw a mobile number from sale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelListing(cliCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewBuyMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-mobile [mobile] [price]",
+		Short: "Buy a listed mobile number, paying the seller its listing price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyMobile(cliCtx.GetFromAddress().String(), args[0], price)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewSendToMobileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-mobile [mobile] [amount]",
+		Short: "Send coins to the chat user holding a mobile number",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendToMobile(cliCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewBlockUserCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-user [address|mobile]",
+		Short: "Block an account from sending gifts, mobile numbers, coins or paid chats to the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockedAddress, err := ResolveAddressOrMobile(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBlockUser(cliCtx.GetFromAddress().String(), blockedAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewUnblockUserCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-user [address|mobile]",
+		Short: "Remove an account from the sender's block list",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockedAddress, err := ResolveAddressOrMobile(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnblockUser(cliCtx.GetFromAddress().String(), blockedAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewChangeGatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-gateway [gateway-address] [mobile-prefix] [port-mobile]",
+		Short: "Rebind the sender to another gateway, porting existing numbers or reissuing them from the prefix",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portMobile := false
+			if len(args) > 2 {
+				portMobile, err = strconv.ParseBool(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChangeGateway(cliCtx.GetFromAddress().String(), args[0], args[1], portMobile)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewSetGiftProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-gift [gifts-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or update entries of the chat gift catalog",
+		Long: `Submit a proposal to add or update entries of the chat gift catalog along with an initial deposit.
+The gifts file is a JSON array, e.g.:
+[{"id":1,"name":"rose","price":{"denom":"` + config.BaseDenom + `","amount":"1000000000000000000"},"enabled":true}]`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-gift gifts.json --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			gifts, err := ParseGifts(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetGiftProposal(title, description, gifts)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "1"+config.BaseDenom, "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
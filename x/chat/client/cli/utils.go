@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"io/ioutil"
 	"path/filepath"
 
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -23,3 +28,33 @@ func ParseMetadata(cdc codec.JSONCodec, metadataFile string) (banktypes.Metadata
 
 	return metadata, nil
 }
+
+
+func ParseGifts(giftsFile string) ([]types.Gift, error) {
+	gifts := make([]types.Gift, 0)
+
+	contents, err := ioutil.ReadFile(filepath.Clean(giftsFile))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(contents, &gifts); err != nil {
+		return nil, err
+	}
+
+	return gifts, nil
+}
+
+
+func ResolveAddressOrMobile(clientCtx client.Context, addressOrMobile string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(addressOrMobile); err == nil {
+		return addressOrMobile, nil
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ResolveMobile(context.Background(), &types.QueryResolveMobileRequest{Mobile: addressOrMobile})
+	if err != nil {
+		return "", err
+	}
+	return res.Address, nil
+}
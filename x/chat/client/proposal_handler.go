@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"freemasonry.cc/blockchain/x/chat/client/cli"
+	"freemasonry.cc/blockchain/x/chat/client/rest"
+)
+
+var (
+	SetGiftProposalHandler = govclient.NewProposalHandler(cli.NewSetGiftProposalCmd, rest.SetGiftProposalRESTHandler)
+)
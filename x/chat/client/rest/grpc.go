@@ -1,8 +1,10 @@
 package rest
 
 import (
+	"context"
 	"errors"
 	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/chat/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -33,3 +35,14 @@ func grpcQueryBalance(cliCtx *client.Context, address sdk.AccAddress, denom stri
 
 	return coin, nil
 }
+
+
+func grpcQueryUserInfo(cliCtx *client.Context, address string) (userInfo types.UserInfo, err error) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainRest).WithField("addr", address)
+	res, err := types.NewQueryClient(*cliCtx).UserInfo(context.Background(), &types.QueryUserInfoRequest{Address: address})
+	if err != nil {
+		log.WithError(err).Error("UserInfo")
+		return userInfo, errors.New(QueryChainInforError)
+	}
+	return res.UserInfo, nil
+}
@@ -84,3 +84,148 @@ func MobileTransferHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.
 
 	return nil
 }
+
+func RedeemHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+
+	log := core.BuildLog(core.GetFuncName(), core.LmChainRest)
+	var msgRedeem types.MsgRedeem
+	err := util.Json.Unmarshal(msgBytes, &msgRedeem)
+	if err != nil {
+		log.WithError(err).Error("Unmarshal")
+		return err
+	}
+
+	err = msgRedeem.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	userInfo, err := grpcQueryUserInfo(ctx, msgRedeem.FromAddress)
+	if err != nil {
+		return err
+	}
+
+
+	if userInfo.CanRedemAmount.Denom != msgRedeem.Amount.Denom || userInfo.CanRedemAmount.IsLT(msgRedeem.Amount) {
+		log.Error("redeem amount exceeds mortgage | ", userInfo.CanRedemAmount.String())
+		return types.ErrRedeemAmount
+	}
+	return nil
+}
+
+func ApplyMobileHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func ReleaseMobileHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func PayChatHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+
+	log := core.BuildLog(core.GetFuncName(), core.LmChainRest)
+	var msgPayChat types.MsgPayChat
+	err := util.Json.Unmarshal(msgBytes, &msgPayChat)
+	if err != nil {
+		log.WithError(err).Error("Unmarshal")
+		return err
+	}
+
+	accFromAddress, err := sdk.AccAddressFromBech32(msgPayChat.FromAddress)
+	if err != nil {
+		return err
+	}
+
+
+	balStatus, errStr := judgeBalance(ctx, accFromAddress, msgPayChat.Fee.Amount.ToDec(), msgPayChat.Fee.Denom)
+	if !balStatus {
+		log.Error("judgeBalance fail | ", errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+func AcceptChatHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func ListMobileHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func CancelListingHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func BlockUserHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func UnblockUserHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func ChangeGatewayHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+	return nil
+}
+
+func BuyMobileHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+
+	log := core.BuildLog(core.GetFuncName(), core.LmChainRest)
+	var msgBuyMobile types.MsgBuyMobile
+	err := util.Json.Unmarshal(msgBytes, &msgBuyMobile)
+	if err != nil {
+		log.WithError(err).Error("Unmarshal")
+		return err
+	}
+
+	accFromAddress, err := sdk.AccAddressFromBech32(msgBuyMobile.FromAddress)
+	if err != nil {
+		return err
+	}
+
+
+	balStatus, errStr := judgeBalance(ctx, accFromAddress, msgBuyMobile.Price.Amount.ToDec(), msgBuyMobile.Price.Denom)
+	if !balStatus {
+		log.Error("judgeBalance fail | ", errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+
+func SendToMobileHandlerFn(msgBytes []byte, ctx *client.Context, fee legacytx.StdFee, memo string) error {
+
+
+	log := core.BuildLog(core.GetFuncName(), core.LmChainRest)
+	var msgSendToMobile types.MsgSendToMobile
+	err := util.Json.Unmarshal(msgBytes, &msgSendToMobile)
+	if err != nil {
+		log.WithError(err).Error("Unmarshal")
+		return err
+	}
+
+	accFromAddress, err := sdk.AccAddressFromBech32(msgSendToMobile.FromAddress)
+	if err != nil {
+		return err
+	}
+
+
+	balStatus, errStr := judgeBalance(ctx, accFromAddress, msgSendToMobile.Amount.Amount.ToDec(), msgSendToMobile.Amount.Denom)
+	if !balStatus {
+		log.Error("judgeBalance fail | ", errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
@@ -31,6 +31,18 @@ func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
 	txHandles.Add(types.TypeMsgAddressBookSave, AddressBookSaveHandlerFn)
 	txHandles.Add(types.TypeMsgGetRewards, GetRewardsHandlerFn)
 	txHandles.Add(types.TypeMsgMobileTransfer, MobileTransferHandlerFn)
+	txHandles.Add(types.TypeMsgRedeem, RedeemHandlerFn)
+	txHandles.Add(types.TypeMsgApplyMobile, ApplyMobileHandlerFn)
+	txHandles.Add(types.TypeMsgReleaseMobile, ReleaseMobileHandlerFn)
+	txHandles.Add(types.TypeMsgPayChat, PayChatHandlerFn)
+	txHandles.Add(types.TypeMsgAcceptChat, AcceptChatHandlerFn)
+	txHandles.Add(types.TypeMsgListMobile, ListMobileHandlerFn)
+	txHandles.Add(types.TypeMsgCancelListing, CancelListingHandlerFn)
+	txHandles.Add(types.TypeMsgBuyMobile, BuyMobileHandlerFn)
+	txHandles.Add(types.TypeMsgSendToMobile, SendToMobileHandlerFn)
+	txHandles.Add(types.TypeMsgBlockUser, BlockUserHandlerFn)
+	txHandles.Add(types.TypeMsgUnblockUser, UnblockUserHandlerFn)
+	txHandles.Add(types.TypeMsgChangeGateway, ChangeGatewayHandlerFn)
 }
 
 
@@ -15,13 +15,115 @@ func InitGenesis(
 	accountKeeper authkeeper.AccountKeeper,
 	data types.GenesisState,
 ) {
+	k.SetParams(ctx, data.Params)
 
+	for _, userInfo := range data.UserInfos {
+		if err := k.SetRegisterInfo(ctx, userInfo); err != nil {
+			panic(err)
+		}
+	}
+
+	if !data.ChatRewardIndex.Index.IsNil() {
+		if err := k.SetChatRewardIndex(ctx, data.ChatRewardIndex); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.RewardSnapshots {
+		if err := k.SetRewardSnapshot(ctx, record.FromAddress, record.Snapshot); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.RedeemQueue {
+		if err := k.SetRedeemEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, addressBook := range data.AddressBooks {
+		if err := k.SetAddressBook(ctx, addressBook); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, session := range data.ChatSessions {
+		if err := k.SetChatSession(ctx, session); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, gift := range data.Gifts {
+		if err := k.SetGift(ctx, gift); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, listing := range data.MobileListings {
+		if err := k.SetMobileListing(ctx, listing); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, blockList := range data.BlockLists {
+		for _, blockedAddress := range blockList.Blocked {
+			k.SetBlockedUser(ctx, blockList.FromAddress, blockedAddress)
+		}
+	}
 }
 
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	userInfos, err := k.GetAllRegisterInfo(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	chatRewardIndex, err := k.GetChatRewardIndex(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rewardSnapshots, err := k.GetAllRewardSnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	redeemQueue, err := k.GetRedeemQueue(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	addressBooks, err := k.GetAllAddressBook(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	chatSessions, err := k.GetAllChatSessions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gifts, err := k.GetAllGifts(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	mobileListings, err := k.GetAllMobileListings(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		
-		
+		Params:          k.GetParams(ctx),
+		UserInfos:       userInfos,
+		RedeemQueue:     redeemQueue,
+		AddressBooks:    addressBooks,
+		ChatSessions:    chatSessions,
+		Gifts:           gifts,
+		ChatRewardIndex: chatRewardIndex,
+		RewardSnapshots: rewardSnapshots,
+		MobileListings:  mobileListings,
+		BlockLists:      k.GetAllBlockLists(ctx),
 	}
 }
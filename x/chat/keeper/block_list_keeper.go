@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) IsBlocked(ctx sdk.Context, fromAddress, blockedAddress string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBlockedUserKey(fromAddress, blockedAddress))
+}
+
+func (k Keeper) SetBlockedUser(ctx sdk.Context, fromAddress, blockedAddress string) {
+	ctx.KVStore(k.storeKey).Set(types.GetBlockedUserKey(fromAddress, blockedAddress), []byte{})
+}
+
+func (k Keeper) deleteBlockedUser(ctx sdk.Context, fromAddress, blockedAddress string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetBlockedUserKey(fromAddress, blockedAddress))
+}
+
+
+func (k Keeper) GetBlockedUsers(ctx sdk.Context, fromAddress string) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetBlockedUserPrefix(fromAddress)
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+
+	blocked := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		blocked = append(blocked, string(iterator.Key()[len(prefixKey):]))
+	}
+	return blocked
+}
+
+func (k Keeper) GetAllBlockLists(ctx sdk.Context) []types.BlockList {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixBlockedUser)
+	defer iterator.Close()
+
+	blockLists := make([]types.BlockList, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		fromAddress, blockedAddress := types.ParseBlockedUserKey(iterator.Key())
+		if len(blockLists) == 0 || blockLists[len(blockLists)-1].FromAddress != fromAddress {
+			blockLists = append(blockLists, types.BlockList{FromAddress: fromAddress})
+		}
+		last := &blockLists[len(blockLists)-1]
+		last.Blocked = append(last.Blocked, blockedAddress)
+	}
+	return blockLists
+}
+
+
+func (k Keeper) checkNotBlocked(ctx sdk.Context, toAddress, fromAddress string) error {
+	if k.IsBlocked(ctx, toAddress, fromAddress) {
+		return types.ErrUserBlocked
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockUser(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	carol := sdk.AccAddress([]byte("carol_______________"))
+
+	params := k.GetParams(ctx)
+	params.MaxBlockedUsers = 1
+	k.SetParams(ctx, params)
+
+	_, err := k.BlockUser(goCtx, types.NewMsgBlockUser(alice.String(), bob.String()))
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String()}))
+	_, err = k.BlockUser(goCtx, types.NewMsgBlockUser(alice.String(), bob.String()))
+	require.NoError(t, err)
+	_, err = k.BlockUser(goCtx, types.NewMsgBlockUser(alice.String(), bob.String()))
+	require.ErrorIs(t, err, types.ErrUserAlreadyBlocked)
+	_, err = k.BlockUser(goCtx, types.NewMsgBlockUser(alice.String(), carol.String()))
+	require.ErrorIs(t, err, types.ErrMaxBlockedUsers)
+
+	require.True(t, k.IsBlocked(ctx, alice.String(), bob.String()))
+	require.False(t, k.IsBlocked(ctx, bob.String(), alice.String()))
+
+	res, err := k.BlockedUsers(goCtx, &types.QueryBlockedUsersRequest{Address: alice.String(), Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Equal(t, []string{bob.String()}, res.Blocked)
+	require.Equal(t, []types.BlockList{{FromAddress: alice.String(), Blocked: []string{bob.String()}}}, k.GetAllBlockLists(ctx))
+
+	_, err = k.UnblockUser(goCtx, types.NewMsgUnblockUser(alice.String(), carol.String()))
+	require.ErrorIs(t, err, types.ErrUserNotBlocked)
+	_, err = k.UnblockUser(goCtx, types.NewMsgUnblockUser(alice.String(), bob.String()))
+	require.NoError(t, err)
+	require.False(t, k.IsBlocked(ctx, alice.String(), bob.String()))
+	require.Empty(t, k.GetAllBlockLists(ctx))
+}
+
+func TestBlockedSenderRejected(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	gateway := sdk.ValAddress([]byte("gateway_____________"))
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: gateway.String(), NumberIndex: "1001", NumberEnd: []string{"100100001", "100100002"}},
+	}))
+	fee := sdk.NewCoin(config.BaseDenom, sdk.NewInt(10))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String(), Mobile: []string{"100100001"}, ChatFee: fee}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: bob.String(), Mobile: []string{"100100002"}}))
+	gift := types.Gift{Id: 1, Name: "rose", Price: fee, Enabled: true}
+	require.NoError(t, k.SetGift(ctx, gift))
+	bankKeeper.balances[bob.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	_, err := k.BlockUser(goCtx, types.NewMsgBlockUser(alice.String(), bob.String()))
+	require.NoError(t, err)
+
+	_, err = k.SendGift(goCtx, types.NewMsgSendGift(bob.String(), alice.String(), gift.Id, 1, gift.Price))
+	require.ErrorIs(t, err, types.ErrUserBlocked)
+	_, err = k.MobileTransfer(goCtx, types.NewMsgMobileTransfer(bob.String(), alice.String(), "100100002"))
+	require.ErrorIs(t, err, types.ErrUserBlocked)
+	_, err = k.PayChat(goCtx, types.NewMsgPayChat(bob.String(), alice.String(), fee))
+	require.ErrorIs(t, err, types.ErrUserBlocked)
+	_, err = k.SendToMobile(goCtx, types.NewMsgSendToMobile(bob.String(), "100100001", fee))
+	require.ErrorIs(t, err, types.ErrUserBlocked)
+
+	_, err = k.MobileTransfer(goCtx, types.NewMsgMobileTransfer(alice.String(), bob.String(), "100100001"))
+	require.NoError(t, err)
+
+	_, err = k.UnblockUser(goCtx, types.NewMsgUnblockUser(alice.String(), bob.String()))
+	require.NoError(t, err)
+	_, err = k.PayChat(goCtx, types.NewMsgPayChat(bob.String(), alice.String(), fee))
+	require.NoError(t, err)
+}
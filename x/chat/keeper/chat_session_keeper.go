@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+)
+
+func (k Keeper) SetChatSession(ctx sdk.Context, session types.ChatSession) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&session)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetChatSessionKey(session.FromAddress, session.ToAddress), bz)
+	store.Set(types.GetChatSessionByToKey(session.ToAddress, session.FromAddress), []byte{})
+	store.Set(types.GetChatSessionQueueKey(session.ExpireHeight, session.FromAddress, session.ToAddress), []byte{})
+	return nil
+}
+
+func (k Keeper) GetChatSession(ctx sdk.Context, fromAddress, toAddress string) (types.ChatSession, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetChatSessionKey(fromAddress, toAddress))
+	if bz == nil {
+		return types.ChatSession{}, types.ErrChatSessionNotFound
+	}
+
+	var session types.ChatSession
+	err := k.cdc.Unmarshal(bz, &session)
+	if err != nil {
+		return types.ChatSession{}, err
+	}
+	return session, nil
+}
+
+func (k Keeper) HasChatSession(ctx sdk.Context, fromAddress, toAddress string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetChatSessionKey(fromAddress, toAddress))
+}
+
+func (k Keeper) deleteChatSession(ctx sdk.Context, session types.ChatSession) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetChatSessionKey(session.FromAddress, session.ToAddress))
+	store.Delete(types.GetChatSessionByToKey(session.ToAddress, session.FromAddress))
+	store.Delete(types.GetChatSessionQueueKey(session.ExpireHeight, session.FromAddress, session.ToAddress))
+}
+
+
+func (k Keeper) GetChatSessionsBySender(ctx sdk.Context, fromAddress string) ([]types.ChatSession, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetChatSessionPrefix(fromAddress))
+	defer iterator.Close()
+
+	sessions := make([]types.ChatSession, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var session types.ChatSession
+		err := k.cdc.Unmarshal(iterator.Value(), &session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+
+func (k Keeper) GetChatSessionsByRecipient(ctx sdk.Context, toAddress string) ([]types.ChatSession, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetChatSessionByToPrefix(toAddress)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	sessions := make([]types.ChatSession, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		session, err := k.GetChatSession(ctx, string(iterator.Key()[len(prefix):]), toAddress)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (k Keeper) GetAllChatSessions(ctx sdk.Context) ([]types.ChatSession, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixChatSession)
+	defer iterator.Close()
+
+	sessions := make([]types.ChatSession, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var session types.ChatSession
+		err := k.cdc.Unmarshal(iterator.Value(), &session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+
+func (k Keeper) CompleteExpiredChatSessions(ctx sdk.Context) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainKeeper)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixChatSessionQueue, types.GetChatSessionQueuePrefix(ctx.BlockHeight()+1))
+
+	expiredKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		fromAddress, toAddress := types.ParseChatSessionQueueKey(key)
+		session, err := k.GetChatSession(ctx, fromAddress, toAddress)
+		if err != nil {
+			log.WithError(err).WithField("from", fromAddress).Error("GetChatSession")
+			store.Delete(key)
+			continue
+		}
+
+		err = k.refundChatSession(ctx, session)
+		if err != nil {
+			log.WithError(err).WithField("from", session.FromAddress).Error("refundChatSession")
+			continue
+		}
+		k.deleteChatSession(ctx, session)
+	}
+}
+
+func (k Keeper) refundChatSession(ctx sdk.Context, session types.ChatSession) error {
+	accFromAddress, err := sdk.AccAddressFromBech32(session.FromAddress)
+	if err != nil {
+		return types.ErrAddressFormat
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accFromAddress, sdk.NewCoins(session.Fee))
+	if err != nil {
+		return types.ErrTransfer
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChatSessionExpire,
+			sdk.NewAttribute(types.ChatSessionEventTypeFromAddress, session.FromAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeToAddress, session.ToAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeAmount, session.Fee.Amount.String()),
+			sdk.NewAttribute(types.ChatSessionEventTypeDenom, session.Fee.Denom),
+			sdk.NewAttribute(types.ChatSessionEventTypeExpireHeight, strconv.FormatInt(session.ExpireHeight, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupChatSessionKeeper(t *testing.T) (Keeper, sdk.Context, poolBankKeeper, sdk.AccAddress, sdk.AccAddress) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+
+	params := k.GetParams(ctx)
+	params.ChatSessionPeriod = 10
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String()}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: bob.String(), ChatFee: sdk.NewCoin(config.BaseDenom, sdk.NewInt(50))}))
+	bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(200)))
+
+	return k, ctx.WithBlockHeight(5), bankKeeper, alice, bob
+}
+
+func TestPayAndAcceptChat(t *testing.T) {
+	k, ctx, bankKeeper, alice, bob := setupChatSessionKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	fee := sdk.NewCoin(config.BaseDenom, sdk.NewInt(50))
+
+	_, err := k.PayChat(goCtx, types.NewMsgPayChat(bob.String(), alice.String(), fee))
+	require.ErrorIs(t, err, types.ErrChatFree)
+	_, err = k.PayChat(goCtx, types.NewMsgPayChat(alice.String(), bob.String(), sdk.NewCoin(config.BaseDenom, sdk.NewInt(40))))
+	require.ErrorIs(t, err, types.ErrChatFee)
+
+	_, err = k.PayChat(goCtx, types.NewMsgPayChat(alice.String(), bob.String(), fee))
+	require.NoError(t, err)
+	_, err = k.PayChat(goCtx, types.NewMsgPayChat(alice.String(), bob.String(), fee))
+	require.ErrorIs(t, err, types.ErrChatSessionExists)
+
+	sessions, err := k.GetChatSessionsByRecipient(ctx, bob.String())
+	require.NoError(t, err)
+	require.Equal(t, []types.ChatSession{{FromAddress: alice.String(), ToAddress: bob.String(), Fee: fee, CreationHeight: 5, ExpireHeight: 15}}, sessions)
+	require.Equal(t, sdk.NewInt(150), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+	bankKeeper.balances[moduleAddress] = sdk.NewCoins()
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+	bankKeeper.balances[moduleAddress] = sdk.NewCoins(fee)
+
+	_, err = k.AcceptChat(goCtx, types.NewMsgAcceptChat(alice.String(), bob.String()))
+	require.ErrorIs(t, err, types.ErrChatSessionNotFound)
+	_, err = k.AcceptChat(goCtx, types.NewMsgAcceptChat(bob.String(), alice.String()))
+	require.NoError(t, err)
+
+	require.False(t, k.HasChatSession(ctx, alice.String(), bob.String()))
+	require.Equal(t, sdk.NewInt(50), bankKeeper.balances[bob.String()].AmountOf(config.BaseDenom))
+	require.True(t, bankKeeper.balances[moduleAddress].IsZero())
+
+	k.CompleteExpiredChatSessions(ctx.WithBlockHeight(15))
+	require.Equal(t, sdk.NewInt(150), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestChatSessionExpiryRefund(t *testing.T) {
+	k, ctx, bankKeeper, alice, bob := setupChatSessionKeeper(t)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	fee := sdk.NewCoin(config.BaseDenom, sdk.NewInt(50))
+
+	_, err := k.PayChat(sdk.WrapSDKContext(ctx), types.NewMsgPayChat(alice.String(), bob.String(), fee))
+	require.NoError(t, err)
+
+	k.CompleteExpiredChatSessions(ctx.WithBlockHeight(14))
+	require.True(t, k.HasChatSession(ctx, alice.String(), bob.String()))
+	require.Equal(t, sdk.NewInt(150), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+
+	expireCtx := ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	k.CompleteExpiredChatSessions(expireCtx)
+	require.False(t, k.HasChatSession(ctx, alice.String(), bob.String()))
+	require.Equal(t, sdk.NewInt(200), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	require.True(t, bankKeeper.balances[moduleAddress].IsZero())
+	require.Equal(t, types.EventTypeChatSessionExpire, expireCtx.EventManager().Events()[0].Type)
+
+	_, err = k.AcceptChat(sdk.WrapSDKContext(expireCtx), types.NewMsgAcceptChat(bob.String(), alice.String()))
+	require.ErrorIs(t, err, types.ErrChatSessionNotFound)
+	require.True(t, bankKeeper.balances[bob.String()].IsZero())
+
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) SetGift(ctx sdk.Context, gift types.Gift) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&gift)
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetGiftKey(gift.Id), bz)
+	return nil
+}
+
+func (k Keeper) GetGift(ctx sdk.Context, id int64) (types.Gift, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGiftKey(id))
+	if bz == nil {
+		return types.Gift{}, types.ErrGiftNotFound
+	}
+
+	var gift types.Gift
+	err := k.cdc.Unmarshal(bz, &gift)
+	if err != nil {
+		return types.Gift{}, err
+	}
+	return gift, nil
+}
+
+func (k Keeper) GetAllGifts(ctx sdk.Context) ([]types.Gift, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixGift)
+	defer iterator.Close()
+
+	gifts := make([]types.Gift, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var gift types.Gift
+		err := k.cdc.Unmarshal(iterator.Value(), &gift)
+		if err != nil {
+			return nil, err
+		}
+		gifts = append(gifts, gift)
+	}
+	return gifts, nil
+}
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"context"
+
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) UserInfo(goCtx context.Context, req *types.QueryUserInfoRequest) (*types.QueryUserInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfo, err := k.GetRegisterInfo(ctx, req.Address)
+	if err != nil {
+		return nil, types.ErrUserNotFound
+	}
+
+	return &types.QueryUserInfoResponse{UserInfo: userInfo}, nil
+}
+
+func (k Keeper) UserByMobile(goCtx context.Context, req *types.QueryUserByMobileRequest) (*types.QueryUserByMobileResponse, error) {
+	if req == nil || req.Mobile == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, ok := k.GetMobileOwner(ctx, req.Mobile)
+	if !ok {
+		return nil, types.ErrUserNotFound
+	}
+
+	userInfo, err := k.GetRegisterInfo(ctx, owner)
+	if err != nil {
+		return nil, types.ErrUserNotFound
+	}
+
+	return &types.QueryUserByMobileResponse{UserInfo: userInfo}, nil
+}
+
+func (k Keeper) ResolveMobile(goCtx context.Context, req *types.QueryResolveMobileRequest) (*types.QueryResolveMobileResponse, error) {
+	if req == nil || req.Mobile == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := k.ResolveMobileOwner(ctx, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResolveMobileResponse{Address: owner.String()}, nil
+}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfo, err := k.GetRegisterInfo(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, types.ErrUserNotFound.Error())
+	}
+
+	reward, err := k.CaculateChatReward(ctx, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := k.GetRewardSnapshot(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingRewardsResponse{
+		Rewards:           reward,
+		LastReceiveHeight: snapshot.LastClaimHeight,
+	}, nil
+}
+
+func (k Keeper) AddressBook(goCtx context.Context, req *types.QueryAddressBookRequest) (*types.QueryAddressBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addressBook, err := k.GetAddressBook(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressBookResponse{AddressBook: addressBook}, nil
+}
+
+func (k Keeper) ChatSessions(goCtx context.Context, req *types.QueryChatSessionsRequest) (*types.QueryChatSessionsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sent, err := k.GetChatSessionsBySender(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.GetChatSessionsByRecipient(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChatSessionsResponse{Sent: sent, Received: received}, nil
+}
+
+func (k Keeper) Gifts(goCtx context.Context, req *types.QueryGiftsRequest) (*types.QueryGiftsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gifts, err := k.GetAllGifts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGiftsResponse{Gifts: gifts}, nil
+}
+
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfos, err := k.GetAllRegisterInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := k.GetRewardPoolBalance(ctx)
+	outstanding := sdk.ZeroInt()
+	staked := sdk.ZeroInt()
+	for _, userInfo := range userInfos {
+		reward, err := k.CaculateChatReward(ctx, userInfo)
+		if err != nil {
+			return nil, err
+		}
+		outstanding = outstanding.Add(reward.Amount)
+		if !userInfo.CanRedemAmount.Amount.IsNil() {
+			staked = staked.Add(userInfo.CanRedemAmount.Amount)
+		}
+	}
+
+	ratio, err := types.GetChatRewardRatio(k.GetParams(ctx).ChatRewardLog, ctx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	rewardPerCycle := staked.ToDec().Mul(ratio).TruncateInt()
+
+	runwayCycles := int64(-1)
+	runwayBlocks := int64(-1)
+	if rewardPerCycle.IsPositive() {
+		available := balance.Amount.Sub(outstanding)
+		if available.IsNegative() {
+			available = sdk.ZeroInt()
+		}
+		cycles := available.Quo(rewardPerCycle)
+		bonusCycle := k.commKeeper.GetParams(ctx).BonusCycle
+		if cycles.IsInt64() {
+			runwayCycles = cycles.Int64()
+			blocks := cycles.MulRaw(bonusCycle)
+			if blocks.IsInt64() {
+				runwayBlocks = blocks.Int64()
+			}
+		}
+	}
+
+	return &types.QueryRewardPoolResponse{
+		Balance:        balance,
+		Outstanding:    sdk.NewCoin(balance.Denom, outstanding),
+		RewardPerCycle: sdk.NewCoin(balance.Denom, rewardPerCycle),
+		RunwayCycles:   runwayCycles,
+		RunwayBlocks:   runwayBlocks,
+	}, nil
+}
+
+func (k Keeper) MobileListings(goCtx context.Context, req *types.QueryMobileListingsRequest) (*types.QueryMobileListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMobileListingKey(req.Prefix))
+	listings := make([]types.MobileListing, 0)
+	pageRes, err := query.Paginate(listingStore, req.Pagination, func(key []byte, value []byte) error {
+		var listing types.MobileListing
+		if err := k.cdc.Unmarshal(value, &listing); err != nil {
+			return err
+		}
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMobileListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MobileListingsBySeller(goCtx context.Context, req *types.QueryMobileListingsBySellerRequest) (*types.QueryMobileListingsBySellerResponse, error) {
+	if req == nil || req.Seller == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sellerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetListingBySellerPrefix(req.Seller))
+	listings := make([]types.MobileListing, 0)
+	pageRes, err := query.Paginate(sellerStore, req.Pagination, func(key []byte, value []byte) error {
+		listing, err := k.GetMobileListing(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMobileListingsBySellerResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BlockedUsers(goCtx context.Context, req *types.QueryBlockedUsersRequest) (*types.QueryBlockedUsersResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blockedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBlockedUserPrefix(req.Address))
+	blocked := make([]string, 0)
+	pageRes, err := query.Paginate(blockedStore, req.Pagination, func(key []byte, value []byte) error {
+		blocked = append(blocked, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockedUsersResponse{Blocked: blocked, Pagination: pageRes}, nil
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "mobile-issued", MobileIssuedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "gateway-user-count", GatewayUserCountInvariant(k))
+}
+
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = MobileIssuedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return GatewayUserCountInvariant(k)(ctx)
+	}
+}
+
+
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		userInfos, err := k.GetAllRegisterInfo(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", err.Error()), true
+		}
+		redeemQueue, err := k.GetRedeemQueue(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", err.Error()), true
+		}
+		chatSessions, err := k.GetAllChatSessions(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance", err.Error()), true
+		}
+
+		canRedem := sdk.NewCoins()
+		for _, userInfo := range userInfos {
+			if !userInfo.CanRedemAmount.Amount.IsNil() {
+				canRedem = canRedem.Add(userInfo.CanRedemAmount)
+			}
+		}
+		redeeming := sdk.NewCoins()
+		for _, entry := range redeemQueue {
+			redeeming = redeeming.Add(entry.Amount)
+		}
+		escrowed := sdk.NewCoins()
+		for _, session := range chatSessions {
+			escrowed = escrowed.Add(session.Fee)
+		}
+
+		expected := canRedem.Add(redeeming...).Add(escrowed...)
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf(
+			"\tmodule balance: %s\n\tsum of can redeem amounts: %s\n\tpending redeems: %s\n\tescrowed chat fees: %s\n",
+			balance, canRedem, redeeming, escrowed,
+		)), broken
+	}
+}
+
+
+func MobileIssuedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		userInfos, err := k.GetAllRegisterInfo(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "mobile-issued", err.Error()), true
+		}
+		gatewayNums, err := k.commKeeper.GetGatewayNumList(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "mobile-issued", err.Error()), true
+		}
+
+		issued := make(map[string]int)
+		for _, gatewayNum := range gatewayNums {
+			for _, mobile := range gatewayNum.NumberEnd {
+				issued[mobile]++
+			}
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, userInfo := range userInfos {
+			for _, mobile := range userInfo.Mobile {
+				if issued[mobile] != 1 {
+					count++
+					msg += fmt.Sprintf("\tmobile %s of %s is issued by %d gateway numbers\n", mobile, userInfo.FromAddress, issued[mobile])
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "mobile-issued", fmt.Sprintf(
+			"found %d mobiles not issued by exactly one gateway number\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+
+func GatewayUserCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		userInfos, err := k.GetAllRegisterInfo(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "gateway-user-count", err.Error()), true
+		}
+
+		expected := make(map[string]uint64)
+		for _, userInfo := range userInfos {
+			if isActiveUser(userInfo) {
+				expected[userInfo.NodeAddress]++
+			}
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, userCount := range k.commKeeper.GetAllGatewayUserCount(ctx) {
+			if userCount.Count != expected[userCount.GatewayAddress] {
+				count++
+				msg += fmt.Sprintf("\tgateway %s counts %d active users, expected %d\n", userCount.GatewayAddress, userCount.Count, expected[userCount.GatewayAddress])
+			}
+			delete(expected, userCount.GatewayAddress)
+		}
+		gateways := make([]string, 0, len(expected))
+		for gateway := range expected {
+			gateways = append(gateways, gateway)
+		}
+		sort.Strings(gateways)
+		for _, gateway := range gateways {
+			count++
+			msg += fmt.Sprintf("\tgateway %s counts 0 active users, expected %d\n", gateway, expected[gateway])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "gateway-user-count", fmt.Sprintf(
+			"found %d gateways with a wrong active user count\n%s", count, msg,
+		)), count != 0
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{
+		FromAddress:    "dex1a",
+		CanRedemAmount: sdk.NewCoin(config.BaseDenom, sdk.NewInt(100)),
+	}))
+	require.NoError(t, k.SetRedeemEntry(ctx, types.RedeemEntry{
+		FromAddress:      "dex1a",
+		Amount:           sdk.NewCoin(config.BaseDenom, sdk.NewInt(50)),
+		CompletionHeight: 10,
+	}))
+
+	bankKeeper.balances[moduleAddress] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(149)))
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+
+	bankKeeper.balances[moduleAddress] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(150)))
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestMobileIssuedInvariant(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: "dex1a", Mobile: []string{"100100001"}}))
+	_, broken := MobileIssuedInvariant(k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: "dexvaloper1a", NumberIndex: "1001", NumberEnd: []string{"100100001"}},
+	}))
+	_, broken = MobileIssuedInvariant(k)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: "dexvaloper1b", NumberIndex: "1002", NumberEnd: []string{"100100001"}},
+	}))
+	_, broken = MobileIssuedInvariant(k)(ctx)
+	require.True(t, broken)
+}
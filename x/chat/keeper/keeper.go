@@ -58,217 +58,190 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) KVHelper(ctx sdk.Context) storeHelper {
+func (k Keeper) GetRegisterInfo(ctx sdk.Context, fromAddress string) (userinfo types.UserInfo, err error) {
+
 	store := ctx.KVStore(k.storeKey)
-	return storeHelper{
-		store,
+	bz := store.Get(types.GetRegisterInfoKey(fromAddress))
+	if bz == nil {
+		return types.UserInfo{}, errors.New("USER-INFO-NOT-FOUND")
 	}
-}
 
-func (k Keeper) GetRegisterInfo(ctx sdk.Context, fromAddress string) (userinfo types.UserInfo, err error) {
+	err = k.cdc.Unmarshal(bz, &userinfo)
+	if err != nil {
+		return types.UserInfo{}, err
+	}
+
+	return userinfo, nil
+}
 
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixRegisterInfo + fromAddress
-	if store.Has(key) {
+func (k Keeper) GetAllRegisterInfo(ctx sdk.Context) ([]types.UserInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRegisterInfo)
+	defer iterator.Close()
 
-		err := store.GetUnmarshal(key, &userinfo)
+	userInfos := make([]types.UserInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var userInfo types.UserInfo
+		err := k.cdc.Unmarshal(iterator.Value(), &userInfo)
 		if err != nil {
-			return types.UserInfo{}, err
+			return nil, err
 		}
-
-		return userinfo, nil
-
-	} else {
-
-		return types.UserInfo{}, errors.New("USER-INFO-NOT-FOUND")
-
+		userInfos = append(userInfos, userInfo)
 	}
+	return userInfos, nil
 }
 
 func (k Keeper) SetRegisterInfo(ctx sdk.Context, userInfo types.UserInfo) error {
 
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixRegisterInfo + userInfo.FromAddress
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&userInfo)
+	if err != nil {
+		return err
+	}
 
-	err := store.Set(key, userInfo)
+	oldMobile := make([]string, 0)
+	if oldUserInfo, err := k.GetRegisterInfo(ctx, userInfo.FromAddress); err == nil {
+		oldMobile = oldUserInfo.Mobile
+	}
+	err = k.updateMobileOwners(ctx, userInfo.FromAddress, oldMobile, userInfo.Mobile)
 	if err != nil {
 		return err
 	}
 
+	store.Set(types.GetRegisterInfoKey(userInfo.FromAddress), bz)
 	return nil
 }
 
 
+func isActiveUser(userInfo types.UserInfo) bool {
+	return userInfo.NodeAddress != "" && !userInfo.CanRedemAmount.Amount.IsNil() && userInfo.CanRedemAmount.IsPositive()
+}
+
+
+func (k Keeper) updateGatewayUserCount(ctx sdk.Context, oldUserInfo, userInfo types.UserInfo) {
+	oldGateway, gateway := "", ""
+	if isActiveUser(oldUserInfo) {
+		oldGateway = oldUserInfo.NodeAddress
+	}
+	if isActiveUser(userInfo) {
+		gateway = userInfo.NodeAddress
+	}
+	k.commKeeper.MoveGatewayUser(ctx, oldGateway, gateway)
+}
+
 
 func (k Keeper) MortgageSendCoin(ctx sdk.Context, transferType, toAddress, fromAddress, nodeAddress string, mortgageAmount sdk.Coin) (*types.MortgageInfo, error) {
 	log := core.BuildLog(core.GetStructFuncName(k), core.LmChainKeeper)
 	
 	chatParams := k.GetParams(ctx)
 
-	log.Info("CommunityAddress:", chatParams.CommunityAddress)
-	log.Info("EcologicalAddress:", chatParams.EcologicalAddress)
+	log.Info("MortgageSplit:", chatParams.MortgageSplit)
 	log.Info("MinMortgageCoin:", chatParams.MinMortgageCoin)
 
 	if mortgageAmount.Amount.Sub(chatParams.MinMortgageCoin.Amount).LT(sdk.NewInt(0)) {
 		return nil, types.ErrMortgageAmount
 	}
 
-	mortgageMoney := mortgageAmount.Amount
-	mortgageMoneyDec := mortgageMoney.ToDec()
-
-	
-	mortgageNodeDec := mortgageMoneyDec.Mul(core.MortgageRatioDecNode)
-	
-	mortgageBurnDec := mortgageMoneyDec.Mul(core.MortgageRatioDecBurn)
-	
-	mortgageCommunityDec := mortgageMoneyDec.Mul(core.MortgageRatioDecCommunity)
-	
-	mortgageEcologicalDec := mortgageMoneyDec.Mul(core.MortgageRatioDecEcological)
-	
-	mortgagePosDec := mortgageMoneyDec.Mul(core.MortgageRatioDecPos)
-	
-	mortgageRemainDec := mortgageMoneyDec.Mul(core.MortgageRatioDecRemain)
-	
-	accPosAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
-	
-	accFromAddress, err := sdk.AccAddressFromBech32(fromAddress)
+	mortgageinfo, err := k.splitSendCoin(ctx, chatParams.MortgageSplit, transferType, toAddress, fromAddress, nodeAddress, mortgageAmount)
 	if err != nil {
-		return nil, types.ErrAddressFormat
+		return nil, err
 	}
 
-	
-	valAddr, err := sdk.ValAddressFromBech32(nodeAddress)
-	if err != nil {
-		return nil, types.ErrAddressFormat
-	}
-
-	accNodeAddress := sdk.AccAddress(valAddr)
-	if err != nil {
-		return nil, types.ErrAddressFormat
-	}
+	log.Info("canRemainCoin:", mortgageinfo.MortgageRemain)
+	log.Info("mortgageDevideInfo:", len(mortgageinfo.MortgageDevideInfo))
 
-	
-	accCommunityAddress, err := sdk.AccAddressFromBech32(chatParams.CommunityAddress)
-	if err != nil {
-		return nil, types.ErrAddressFormat
-	}
-	
-	accEcologicalAddress, err := sdk.AccAddressFromBech32(chatParams.EcologicalAddress)
-	if err != nil {
-		return nil, types.ErrAddressFormat
-	}
-	
-	
-	toNodeCoin := sdk.NewCoin(config.BaseDenom, mortgageNodeDec.TruncateInt())
-	toNodeCoins := sdk.NewCoins(toNodeCoin)
-	err = k.bankKeeper.SendCoins(ctx, accFromAddress, accNodeAddress, toNodeCoins)
-	if err != nil {
-		return nil, types.ErrTransfer
-	}
+	return mortgageinfo, nil
+}
 
-	
-	toCommunityCoin := sdk.NewCoin(config.BaseDenom, mortgageCommunityDec.TruncateInt())
-	toCommunityCoins := sdk.NewCoins(toCommunityCoin)
-	err = k.bankKeeper.SendCoins(ctx, accFromAddress, accCommunityAddress, toCommunityCoins)
-	if err != nil {
-		return nil, types.ErrTransfer
-	}
 
-	
-	toEcologicalCoin := sdk.NewCoin(config.BaseDenom, mortgageEcologicalDec.TruncateInt())
-	toEcologicalCoins := sdk.NewCoins(toEcologicalCoin)
-	err = k.bankKeeper.SendCoins(ctx, accFromAddress, accEcologicalAddress, toEcologicalCoins)
-	if err != nil {
-		return nil, types.ErrTransfer
-	}
+func (k Keeper) splitSendCoin(ctx sdk.Context, split []types.MortgageRecipient, transferType, toAddress, fromAddress, nodeAddress string, amount sdk.Coin) (*types.MortgageInfo, error) {
+	mortgageMoneyDec := amount.Amount.ToDec()
 
 	
-	toPosCoin := sdk.NewCoin(config.BaseDenom, mortgagePosDec.TruncateInt())
-	toPosCoins := sdk.NewCoins(toPosCoin)
-	err = k.bankKeeper.SendCoins(ctx, accFromAddress, accPosAddress, toPosCoins)
+	accFromAddress, err := sdk.AccAddressFromBech32(fromAddress)
 	if err != nil {
-		return nil, types.ErrTransfer
+		return nil, types.ErrAddressFormat
 	}
 
 	
-	burnCoin := sdk.NewCoin(config.BaseDenom, mortgageBurnDec.TruncateInt())
-	burnCoins := sdk.NewCoins(burnCoin)
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accFromAddress, types.ModuleBurnName, burnCoins)
-	if err != nil {
-		return nil, types.ErrTransfer
-	}
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleBurnName, burnCoins)
+	valAddr, err := sdk.ValAddressFromBech32(nodeAddress)
 	if err != nil {
-		return nil, types.ErrTransfer
+		return nil, types.ErrAddressFormat
 	}
+	accNodeAddress := sdk.AccAddress(valAddr)
 
-	canRemainCoin := sdk.NewCoin(config.BaseDenom, mortgageRemainDec.TruncateInt())
-	canRemainCoins := sdk.NewCoins(canRemainCoin)
-	if transferType == types.TransferTypeToModule {
-		
-		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accFromAddress, toAddress, canRemainCoins)
-		if err != nil {
-			return nil, types.ErrTransfer
-		}
-	} else if transferType == types.TransferTypeToAccount {
-		
+	var mortgageinfo types.MortgageInfo
+	mortgageinfo.MortgageDevideInfo = make([]types.MortgageDevideInfo, 0)
 
-		accToAddress, err := sdk.AccAddressFromBech32(toAddress)
-		if err != nil {
-			return nil, types.ErrAddressFormat
-		}
+	for _, recipient := range split {
+		coin := sdk.NewCoin(config.BaseDenom, mortgageMoneyDec.Mul(recipient.Ratio).TruncateInt())
+		coins := sdk.NewCoins(coin)
 
-		err = k.bankKeeper.SendCoins(ctx, accFromAddress, accToAddress, canRemainCoins)
-		if err != nil {
-			return nil, types.ErrTransfer
+		devideInfo := types.MortgageDevideInfo{
+			MortgageName:   recipient.Name,
+			MortgageAmount: coin.String(),
 		}
-	}
 
-	
-	var mortgageinfo types.MortgageInfo
-	mortgageinfo.MortgageDevideInfo = make([]types.MortgageDevideInfo, 0)
-
-	log.Info("canRemainCoin:", canRemainCoin)
-	log.Info("mortgageDevideInfo:", len(mortgageinfo.MortgageDevideInfo))
-	mortgageinfo.MortgageRemain = canRemainCoin
+		switch recipient.RecipientType {
+		case types.MortgageRecipientRemain:
+			if transferType == types.TransferTypeToModule {
+				
+				err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accFromAddress, toAddress, coins)
+				if err != nil {
+					return nil, types.ErrTransfer
+				}
+				devideInfo.MortgageAddress = authtypes.NewModuleAddress(toAddress).String()
+			} else if transferType == types.TransferTypeToAccount {
+				accToAddress, err := sdk.AccAddressFromBech32(toAddress)
+				if err != nil {
+					return nil, types.ErrAddressFormat
+				}
 
-	
-	if transferType == types.TransferTypeToModule {
-		toAddress = core.ContractChat.String()
-	}
+				err = k.bankKeeper.SendCoins(ctx, accFromAddress, accToAddress, coins)
+				if err != nil {
+					return nil, types.ErrTransfer
+				}
+				devideInfo.MortgageAddress = toAddress
+			}
+			devideInfo.ShowBalance = true
+			mortgageinfo.MortgageRemain = coin
 
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: toAddress,
-		MortgageAmount:  canRemainCoin.String(),
-		ShowBalance:     true,
-	})
+		case types.MortgageRecipientBurn:
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accFromAddress, types.ModuleBurnName, coins)
+			if err != nil {
+				return nil, types.ErrTransfer
+			}
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleBurnName, coins)
+			if err != nil {
+				return nil, types.ErrTransfer
+			}
+			devideInfo.MortgageAddress = core.ContractChatBurn.String()
+
+		default:
+			var accToAddress sdk.AccAddress
+			switch recipient.RecipientType {
+			case types.MortgageRecipientNode:
+				accToAddress = accNodeAddress
+			case types.MortgageRecipientModule:
+				accToAddress = authtypes.NewModuleAddress(recipient.Address)
+			case types.MortgageRecipientAddress:
+				accToAddress, err = sdk.AccAddressFromBech32(recipient.Address)
+				if err != nil {
+					return nil, types.ErrAddressFormat
+				}
+			default:
+				return nil, types.ErrMortgageSplit
+			}
 
-	
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: chatParams.CommunityAddress,
-		MortgageAmount:  toCommunityCoin.String(),
-	})
+			err = k.bankKeeper.SendCoins(ctx, accFromAddress, accToAddress, coins)
+			if err != nil {
+				return nil, types.ErrTransfer
+			}
+			devideInfo.MortgageAddress = accToAddress.String()
+		}
 
-	
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: accNodeAddress.String(),
-		MortgageAmount:  toNodeCoin.String(),
-	})
-	
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: chatParams.EcologicalAddress,
-		MortgageAmount:  toEcologicalCoin.String(),
-	})
-	
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: accPosAddress.String(),
-		MortgageAmount:  toPosCoin.String(),
-	})
-	
-	mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, types.MortgageDevideInfo{
-		MortgageAddress: core.ContractChatBurn.String(),
-		MortgageAmount:  burnCoin.String(),
-	})
+		mortgageinfo.MortgageDevideInfo = append(mortgageinfo.MortgageDevideInfo, devideInfo)
+	}
 
 	return &mortgageinfo, nil
 }
@@ -295,22 +268,33 @@ func (k Keeper) RegisterMobile(ctx sdk.Context, nodeAddress, fromAddress, mobile
 		return mobile, types.ErrGateway
 	}
 
-	
-	mobileSuffixInt := len(GatewayNumInfo.NumberEnd)
-
-	log.Info("len(GatewayNumInfo.NumberEnd):", mobileSuffixInt)
-
-	if mobileSuffixInt >= types.MobileSuffixMax {
-		return mobile, types.ErrGetMobile
+	if !k.commKeeper.IsGatewayActive(ctx, nodeAddress) {
+		return mobile, types.ErrGatewayInactive
 	}
 
 	
-	mobileSuffixString := strconv.Itoa(mobileSuffixInt)
+	issued := make(map[string]bool, len(GatewayNumInfo.NumberEnd))
+	for _, numberEnd := range GatewayNumInfo.NumberEnd {
+		issued[numberEnd] = true
+	}
+
+	log.Info("len(GatewayNumInfo.NumberEnd):", len(GatewayNumInfo.NumberEnd))
 
 	
-	mobileSuffixString = strings.Repeat("0", types.MobileSuffixLength-len(mobileSuffixString)) + mobileSuffixString
+	mobileSuffixInt := 0
+	for ; mobileSuffixInt < types.MobileSuffixMax; mobileSuffixInt++ {
+		mobileSuffixString := strconv.Itoa(mobileSuffixInt)
+		
+		mobileSuffixString = strings.Repeat("0", types.MobileSuffixLength-len(mobileSuffixString)) + mobileSuffixString
+		mobile = mobilePrefix + mobileSuffixString
+		if _, owned := k.GetMobileOwner(ctx, mobile); !issued[mobile] && !owned {
+			break
+		}
+	}
 
-	mobile = mobilePrefix + mobileSuffixString
+	if mobileSuffixInt >= types.MobileSuffixMax {
+		return "", types.ErrGetMobile
+	}
 
 	
 
@@ -327,187 +311,83 @@ func (k Keeper) RegisterMobile(ctx sdk.Context, nodeAddress, fromAddress, mobile
 }
 
 
-/*
-
-*/
-func (k Keeper) CaculateChatReward(ctx sdk.Context, fromAddress string, chatRewardChangeLog []types.ChatReward) (reward sdk.Coin, err error) {
-
-	
-	mortgageLog, err := k.GetMortgageLog(ctx, fromAddress)
-	if err != nil {
-		return reward, err
+func (k Keeper) ReleaseGatewayMobile(ctx sdk.Context, mobile string) error {
+	if len(mobile) <= types.MobileSuffixLength {
+		return types.ErrReleaseMobile
 	}
 
-	
-	commParams := k.commKeeper.GetParams(ctx)
-
-	HeightPerDay := commParams.BonusCycle
-
-	
-	LastGetInfo, err := k.GetLastGetHeight(ctx, fromAddress)
+	GatewayNumInfo, err := k.commKeeper.GetGatewayNumByMobile(ctx, mobile)
 	if err != nil {
-		return reward, err
+		return types.ErrReleaseMobile
 	}
 
-	
-	NowHeight := ctx.BlockHeight()
-
-	newAmountDec := LastGetInfo.Value.Amount.ToDec()
-	
-	
-	for SendBonusGeight := GetCommonMultipleGt(LastGetInfo.Height, HeightPerDay); SendBonusGeight < NowHeight; SendBonusGeight += HeightPerDay {
-
-		
-		var ratio sdk.Dec
-		for changeIndex, chatReward := range chatRewardChangeLog {
-
-			
-			if SendBonusGeight >= chatReward.Height {
-				if HasIndex(changeIndex+1, chatRewardChangeLog) { 
-					if SendBonusGeight >= chatRewardChangeLog[changeIndex+1].Height { 
-						continue
-					} else {
-						ratio, err = sdk.NewDecFromStr(chatReward.Value) 
-						if err != nil {
-							return reward, types.ErrGetBonus
-						}
-					}
-				} else { 
-					ratio, err = sdk.NewDecFromStr(chatReward.Value)
-					if err != nil {
-						return reward, types.ErrGetBonus
-					}
-				}
-			}
-
-		}
-
-		
-		mortgageAmount := sdk.NewCoin(mortgageLog[0].MortgageValue.Denom, sdk.ZeroInt()) 
-		for _, addLog := range mortgageLog {
-			if addLog.Height < SendBonusGeight {
-				mortgageAmount = addLog.MortgageValue
-			}
-		}
-
-		
-		newAmountDec = newAmountDec.Add(mortgageAmount.Amount.ToDec().Mul(ratio))
-	}
-
-	return sdk.NewCoin(LastGetInfo.Value.Denom, newAmountDec.TruncateInt()), nil
-}
-
-
-func GetCommonMultipleGt(a, b int64) int64 {
-	return (a/b + 1) * b
-}
-
-
-func HasIndex(index int, data []types.ChatReward) bool {
-	return len(data) > index
-}
-
-func (k Keeper) GetLastGetHeight(ctx sdk.Context, fromAddress string) (types.LastReceiveLog, error) {
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixLastGetRewardLog + fromAddress
-
-	log := types.LastReceiveLog{
-		Height: 1,
-		Value:  sdk.NewCoin(config.BaseDenom, sdk.NewInt(0)),
+	if GatewayNumInfo == nil {
+		return types.ErrReleaseMobile
 	}
 
-	if store.Has(key) {
-
-		err := store.GetUnmarshal(key, &log)
-		if err != nil {
-			return log, types.ErrGetLastReveiveHeight
+	numberEnd := make([]string, 0, len(GatewayNumInfo.NumberEnd))
+	for _, val := range GatewayNumInfo.NumberEnd {
+		if val != mobile {
+			numberEnd = append(numberEnd, val)
 		}
-		return log, nil
+	}
+	if len(numberEnd) == len(GatewayNumInfo.NumberEnd) {
+		return types.ErrReleaseMobile
 	}
 
-	return log, nil
-}
-
-func (k Keeper) SetLastGetHeight(ctx sdk.Context, fromAddress string, height int64, mortgage sdk.Coin) error {
-
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixLastGetRewardLog + fromAddress
-
-	err := store.Set(key, types.LastReceiveLog{
-		Height: height,
-		Value:  mortgage,
-	})
-
+	GatewayNumInfo.NumberEnd = numberEnd
+	err = k.commKeeper.SetGatewayNum(ctx, []types2.GatewayNumIndex{*GatewayNumInfo})
 	if err != nil {
-		return types.ErrSetLastReveiveHeight
+		return types.ErrMobileSetError
 	}
 
 	return nil
 }
 
-func (k Keeper) SetMortgageLog(ctx sdk.Context, fromAddress string, mortgageNew sdk.Coin) error {
-
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixMortgageAddLog + fromAddress
-
-	logNew := types.MortgageAddLog{
-		Height:        ctx.BlockHeight(),
-		MortgageValue: mortgageNew,
-	}
-	log := make([]types.MortgageAddLog, 0)
-
-	if store.Has(key) {
-
-		err := store.GetUnmarshal(key, &log)
-		if err != nil {
-			return types.ErrSetMortgageLog
-		}
-
-		
-		mortgageAddLogCheck := k.CheckMortgageAddLog(ctx, log, logNew)
-		if !mortgageAddLogCheck {
-			return types.ErrSetMortgageLog
-		}
 
+func (k Keeper) GetAddressBook(ctx sdk.Context, fromAddress string) (types.AddressBook, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAddressBookKey(fromAddress))
+	if bz == nil {
+		return types.AddressBook{FromAddress: fromAddress}, nil
 	}
 
-	log = append(log, logNew)
-	err := store.Set(key, log)
+	var addressBook types.AddressBook
+	err := k.cdc.Unmarshal(bz, &addressBook)
 	if err != nil {
-		return types.ErrSetMortgageLog
+		return types.AddressBook{}, err
 	}
-
-	return nil
+	return addressBook, nil
 }
 
-func (k Keeper) GetMortgageLog(ctx sdk.Context, fromAddress string) ([]types.MortgageAddLog, error) {
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixMortgageAddLog + fromAddress
+func (k Keeper) GetAllAddressBook(ctx sdk.Context) ([]types.AddressBook, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAddressBook)
+	defer iterator.Close()
 
-	log := make([]types.MortgageAddLog, 0)
-	if store.Has(key) {
-		err := store.GetUnmarshal(key, &log)
+	addressBooks := make([]types.AddressBook, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var addressBook types.AddressBook
+		err := k.cdc.Unmarshal(iterator.Value(), &addressBook)
 		if err != nil {
-			return log, types.ErrGetMortgageLog
+			return nil, err
 		}
-	} else {
-		return log, types.ErrUserNotFound
+		addressBooks = append(addressBooks, addressBook)
 	}
-	return log, nil
+	return addressBooks, nil
 }
 
-func (k Keeper) CheckMortgageAddLog(ctx sdk.Context, log []types.MortgageAddLog, logNew types.MortgageAddLog) bool {
-
-	lastMortgageInfo := log[len(log)-1]
-	
-	if lastMortgageInfo.Height <= logNew.Height {
-		return false
+func (k Keeper) SetAddressBook(ctx sdk.Context, addressBook types.AddressBook) error {
+	store := ctx.KVStore(k.storeKey)
+	if len(addressBook.AddressBook) == 0 {
+		store.Delete(types.GetAddressBookKey(addressBook.FromAddress))
+		return nil
 	}
 
-	
-	if lastMortgageInfo.MortgageValue.Amount.LT(logNew.MortgageValue.Amount) {
-		return false
+	bz, err := k.cdc.Marshal(&addressBook)
+	if err != nil {
+		return err
 	}
-
-	return true
+	store.Set(types.GetAddressBookKey(addressBook.FromAddress), bz)
+	return nil
 }
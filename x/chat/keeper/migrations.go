@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	v3 "freemasonry.cc/blockchain/x/chat/migrations/v3"
+	v4 "freemasonry.cc/blockchain/x/chat/migrations/v4"
+	v5 "freemasonry.cc/blockchain/x/chat/migrations/v5"
+	v6 "freemasonry.cc/blockchain/x/chat/migrations/v6"
+	v7 "freemasonry.cc/blockchain/x/chat/migrations/v7"
+	v8 "freemasonry.cc/blockchain/x/chat/migrations/v8"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+type Migrator struct {
+	keeper Keeper
+}
+
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	err := v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramstore, m.keeper.cdc)
+	if err != nil {
+		return err
+	}
+	err = v4.MigrateParams(ctx, m.keeper.paramstore)
+	if err != nil {
+		return err
+	}
+	err = m.migrateIssuedMobiles(ctx)
+	if err != nil {
+		return err
+	}
+	err = m.migrateChatRewardIndex(ctx)
+	if err != nil {
+		return err
+	}
+	err = v7.MigrateParams(ctx, m.keeper.paramstore)
+	if err != nil {
+		return err
+	}
+	err = v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+	if err != nil {
+		return err
+	}
+	return m.migrateGatewayUserCounts(ctx)
+}
+
+
+func (m Migrator) migrateIssuedMobiles(ctx sdk.Context) error {
+	gatewayNums, err := m.keeper.commKeeper.GetGatewayNumList(ctx)
+	if err != nil {
+		return err
+	}
+
+	issuedMobiles := make(map[string]bool)
+	for _, gatewayNum := range gatewayNums {
+		for _, mobile := range gatewayNum.NumberEnd {
+			issuedMobiles[mobile] = true
+		}
+	}
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, issuedMobiles)
+}
+
+
+func (m Migrator) migrateChatRewardIndex(ctx sdk.Context) error {
+	chatRewardLog := types.DefaultParams().ChatRewardLog
+	m.keeper.paramstore.GetIfExists(ctx, types.KeyChatRewardLog, &chatRewardLog)
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, chatRewardLog, m.keeper.commKeeper.GetBonusCycle(ctx))
+}
+
+
+func (m Migrator) migrateGatewayUserCounts(ctx sdk.Context) error {
+	userInfos, err := m.keeper.GetAllRegisterInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	gateways := make([]string, 0)
+	userCounts := make(map[string]uint64)
+	for _, userInfo := range userInfos {
+		if !isActiveUser(userInfo) {
+			continue
+		}
+		if _, ok := userCounts[userInfo.NodeAddress]; !ok {
+			gateways = append(gateways, userInfo.NodeAddress)
+		}
+		userCounts[userInfo.NodeAddress]++
+	}
+	for _, gateway := range gateways {
+		m.keeper.commKeeper.SetGatewayUserCount(ctx, gateway, userCounts[gateway])
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+)
+
+func (k Keeper) SetMobileListing(ctx sdk.Context, listing types.MobileListing) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&listing)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetMobileListingKey(listing.Mobile), bz)
+	store.Set(types.GetListingBySellerKey(listing.Seller, listing.Mobile), []byte{})
+	if listing.ExpireHeight > 0 {
+		store.Set(types.GetListingQueueKey(listing.ExpireHeight, listing.Mobile), []byte{})
+	}
+	return nil
+}
+
+func (k Keeper) GetMobileListing(ctx sdk.Context, mobile string) (types.MobileListing, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMobileListingKey(mobile))
+	if bz == nil {
+		return types.MobileListing{}, types.ErrListingNotFound
+	}
+
+	var listing types.MobileListing
+	err := k.cdc.Unmarshal(bz, &listing)
+	if err != nil {
+		return types.MobileListing{}, err
+	}
+	return listing, nil
+}
+
+func (k Keeper) HasMobileListing(ctx sdk.Context, mobile string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetMobileListingKey(mobile))
+}
+
+func (k Keeper) deleteMobileListing(ctx sdk.Context, listing types.MobileListing) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMobileListingKey(listing.Mobile))
+	store.Delete(types.GetListingBySellerKey(listing.Seller, listing.Mobile))
+	if listing.ExpireHeight > 0 {
+		store.Delete(types.GetListingQueueKey(listing.ExpireHeight, listing.Mobile))
+	}
+}
+
+func (k Keeper) GetAllMobileListings(ctx sdk.Context) ([]types.MobileListing, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMobileListing)
+	defer iterator.Close()
+
+	listings := make([]types.MobileListing, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.MobileListing
+		err := k.cdc.Unmarshal(iterator.Value(), &listing)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, listing)
+	}
+	return listings, nil
+}
+
+
+func (k Keeper) SellMobile(ctx sdk.Context, listing types.MobileListing, buyer string) (sdk.Coin, error) {
+	sellerInfo, err := k.GetRegisterInfo(ctx, listing.Seller)
+	if err != nil {
+		return sdk.Coin{}, types.ErrUserNotFound
+	}
+
+	buyerInfo, err := k.GetRegisterInfo(ctx, buyer)
+	if err != nil {
+		return sdk.Coin{}, types.ErrUserNotFound
+	}
+
+	chatParams := k.GetParams(ctx)
+	if uint64(len(buyerInfo.Mobile)) >= chatParams.MaxPhoneNumber {
+		return sdk.Coin{}, types.ErrMaxPhoneNumber
+	}
+
+	sellerMobile := make([]string, 0, len(sellerInfo.Mobile))
+	for _, mobile := range sellerInfo.Mobile {
+		if mobile != listing.Mobile {
+			sellerMobile = append(sellerMobile, mobile)
+		}
+	}
+	if len(sellerMobile) == len(sellerInfo.Mobile) {
+		return sdk.Coin{}, types.ErrUserNotHaveMobile
+	}
+
+	accBuyer, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return sdk.Coin{}, types.ErrAddressFormat
+	}
+	accSeller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return sdk.Coin{}, types.ErrAddressFormat
+	}
+
+	fee := sdk.NewCoin(listing.Price.Denom, listing.Price.Amount.ToDec().Mul(chatParams.MobileMarketFee).TruncateInt())
+	if fee.IsPositive() {
+		_, err = k.splitSendCoin(ctx, chatParams.MortgageSplit, types.TransferTypeToModule, types.RewardPoolName, buyer, sellerInfo.NodeAddress, fee)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, accBuyer, accSeller, sdk.NewCoins(listing.Price.Sub(fee)))
+	if err != nil {
+		return sdk.Coin{}, types.ErrTransfer
+	}
+
+	sellerInfo.Mobile = sellerMobile
+	err = k.SetRegisterInfo(ctx, sellerInfo)
+	if err != nil {
+		return sdk.Coin{}, types.ErrUserUpdate
+	}
+
+	buyerInfo.Mobile = append(buyerInfo.Mobile, listing.Mobile)
+	err = k.SetRegisterInfo(ctx, buyerInfo)
+	if err != nil {
+		return sdk.Coin{}, types.ErrUserUpdate
+	}
+
+	k.deleteMobileListing(ctx, listing)
+	return fee, nil
+}
+
+
+func (k Keeper) CompleteExpiredMobileListings(ctx sdk.Context) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainKeeper)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixListingQueue, types.GetListingQueuePrefix(ctx.BlockHeight()+1))
+
+	expiredKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		mobile := types.ParseListingQueueKey(key)
+		listing, err := k.GetMobileListing(ctx, mobile)
+		if err != nil {
+			log.WithError(err).WithField("mobile", mobile).Error("GetMobileListing")
+			store.Delete(key)
+			continue
+		}
+
+		k.deleteMobileListing(ctx, listing)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeListingExpire,
+				sdk.NewAttribute(types.ListingEventTypeMobile, listing.Mobile),
+				sdk.NewAttribute(types.ListingEventTypeSeller, listing.Seller),
+				sdk.NewAttribute(types.ListingEventTypeExpireHeight, strconv.FormatInt(listing.ExpireHeight, 10)),
+			),
+		)
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMobileMarket(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	ctx = ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	seller := sdk.AccAddress([]byte("seller______________"))
+	buyer := sdk.AccAddress([]byte("buyer_______________"))
+	node := sdk.ValAddress([]byte("node________________"))
+
+	params := k.GetParams(ctx)
+	params.MobileMarketFee = sdk.NewDecWithPrec(10, 2)
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: seller.String(), NodeAddress: node.String(), Mobile: []string{"100100001", "100100002"}}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: buyer.String(), NodeAddress: node.String()}))
+	bankKeeper.balances[buyer.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1500)))
+
+	price := sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000))
+	_, err := k.ListMobile(goCtx, types.NewMsgListMobile(buyer.String(), "100100001", price, 0))
+	require.ErrorIs(t, err, types.ErrUserNotHaveMobile)
+	_, err = k.ListMobile(goCtx, types.NewMsgListMobile(seller.String(), "100100001", price, 10))
+	require.ErrorIs(t, err, types.ErrListingExpired)
+	_, err = k.ListMobile(goCtx, types.NewMsgListMobile(seller.String(), "100100001", price, 0))
+	require.NoError(t, err)
+	_, err = k.ListMobile(goCtx, types.NewMsgListMobile(seller.String(), "100100001", price, 0))
+	require.ErrorIs(t, err, types.ErrMobileListed)
+
+	_, err = k.MobileTransfer(goCtx, types.NewMsgMobileTransfer(seller.String(), buyer.String(), "100100001"))
+	require.ErrorIs(t, err, types.ErrMobileListed)
+	_, err = k.ReleaseMobile(goCtx, types.NewMsgReleaseMobile(seller.String(), "100100001"))
+	require.ErrorIs(t, err, types.ErrMobileListed)
+
+	res, err := k.MobileListings(goCtx, &types.QueryMobileListingsRequest{Prefix: "1001"})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 1)
+	res, err = k.MobileListings(goCtx, &types.QueryMobileListingsRequest{Prefix: "1002"})
+	require.NoError(t, err)
+	require.Empty(t, res.Listings)
+	sellerRes, err := k.MobileListingsBySeller(goCtx, &types.QueryMobileListingsBySellerRequest{Seller: seller.String(), Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Equal(t, "100100001", sellerRes.Listings[0].Mobile)
+
+	_, err = k.BuyMobile(goCtx, types.NewMsgBuyMobile(buyer.String(), "100100001", sdk.NewCoin(config.BaseDenom, sdk.NewInt(999))))
+	require.ErrorIs(t, err, types.ErrListingPrice)
+	_, err = k.BuyMobile(goCtx, types.NewMsgBuyMobile(seller.String(), "100100001", price))
+	require.ErrorIs(t, err, types.ErrBuyOwnMobile)
+	_, err = k.BuyMobile(goCtx, types.NewMsgBuyMobile(buyer.String(), "100100001", price))
+	require.NoError(t, err)
+
+	sellerInfo, err := k.GetRegisterInfo(ctx, seller.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{"100100002"}, sellerInfo.Mobile)
+	buyerInfo, err := k.GetRegisterInfo(ctx, buyer.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{"100100001"}, buyerInfo.Mobile)
+	require.False(t, k.HasMobileListing(ctx, "100100001"))
+
+	require.Equal(t, sdk.NewInt(500), bankKeeper.balances[buyer.String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(900), bankKeeper.balances[seller.String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(85), k.GetRewardPoolBalance(ctx).Amount)
+	require.Equal(t, sdk.NewInt(5), bankKeeper.balances[sdk.AccAddress(node).String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(3), bankKeeper.balances[authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()].AmountOf(config.BaseDenom))
+
+	_, err = k.CancelListing(goCtx, types.NewMsgCancelListing(seller.String(), "100100001"))
+	require.ErrorIs(t, err, types.ErrListingNotFound)
+}
+
+func TestCompleteExpiredMobileListings(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	price := sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000))
+
+	require.NoError(t, k.SetMobileListing(ctx, types.MobileListing{Mobile: "100100001", Seller: "dex1a", Price: price, ExpireHeight: 5}))
+	require.NoError(t, k.SetMobileListing(ctx, types.MobileListing{Mobile: "100100002", Seller: "dex1a", Price: price, ExpireHeight: 6}))
+	require.NoError(t, k.SetMobileListing(ctx, types.MobileListing{Mobile: "100100003", Seller: "dex1a", Price: price}))
+
+	k.CompleteExpiredMobileListings(ctx.WithBlockHeight(4))
+	listings, err := k.GetAllMobileListings(ctx)
+	require.NoError(t, err)
+	require.Len(t, listings, 3)
+
+	k.CompleteExpiredMobileListings(ctx.WithBlockHeight(5))
+	require.False(t, k.HasMobileListing(ctx, "100100001"))
+	require.True(t, k.HasMobileListing(ctx, "100100002"))
+
+	k.CompleteExpiredMobileListings(ctx.WithBlockHeight(100))
+	listings, err = k.GetAllMobileListings(ctx)
+	require.NoError(t, err)
+	require.Len(t, listings, 1)
+	require.Equal(t, "100100003", listings[0].Mobile)
+}
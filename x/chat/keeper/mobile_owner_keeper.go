@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) GetMobileOwner(ctx sdk.Context, mobile string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMobileOwnerKey(mobile))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+
+func (k Keeper) updateMobileOwners(ctx sdk.Context, owner string, oldMobile, newMobile []string) error {
+	store := ctx.KVStore(k.storeKey)
+
+	keep := make(map[string]bool, len(newMobile))
+	for _, mobile := range newMobile {
+		keep[mobile] = true
+	}
+
+	for _, mobile := range oldMobile {
+		if keep[mobile] {
+			continue
+		}
+		if current, ok := k.GetMobileOwner(ctx, mobile); ok && current == owner {
+			store.Delete(types.GetMobileOwnerKey(mobile))
+		}
+	}
+
+	for _, mobile := range newMobile {
+		current, ok := k.GetMobileOwner(ctx, mobile)
+		if ok && current != owner {
+			return sdkerrors.Wrapf(types.ErrMobileSetError, "mobile %s already held by %s", mobile, current)
+		}
+		store.Set(types.GetMobileOwnerKey(mobile), []byte(owner))
+	}
+	return nil
+}
+
+
+func (k Keeper) ResolveMobileOwner(ctx sdk.Context, mobile string) (sdk.AccAddress, error) {
+	if len(mobile) <= types.MobileSuffixLength {
+		return nil, sdkerrors.Wrapf(types.ErrMobileNotAssigned, "invalid mobile %s", mobile)
+	}
+
+	owner, ok := k.GetMobileOwner(ctx, mobile)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrMobileNotAssigned, "mobile %s", mobile)
+	}
+
+	gatewayNum, err := k.commKeeper.GetGatewayNumByMobile(ctx, mobile)
+	if err != nil {
+		return nil, err
+	}
+	if gatewayNum == nil || gatewayNum.Status != 0 || gatewayNum.GatewayAddress == "" {
+		return nil, sdkerrors.Wrapf(types.ErrMobileHeldByGateway, "mobile %s", mobile)
+	}
+
+	accOwner, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, types.ErrAddressFormat
+	}
+	return accOwner, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMobileOwnerIndex(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	gateway := sdk.ValAddress([]byte("gateway_____________"))
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: gateway.String(), NumberIndex: "1001", NumberEnd: []string{"100100001", "100100002"}},
+	}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String(), Mobile: []string{"100100001", "100100002"}}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: bob.String()}))
+
+	owner, found := k.GetMobileOwner(ctx, "100100001")
+	require.True(t, found)
+	require.Equal(t, alice.String(), owner)
+
+	err := k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: bob.String(), Mobile: []string{"100100001"}})
+	require.ErrorIs(t, err, types.ErrMobileSetError)
+
+	_, err = k.MobileTransfer(goCtx, types.NewMsgMobileTransfer(alice.String(), bob.String(), "100100001"))
+	require.NoError(t, err)
+	res, err := k.ResolveMobile(goCtx, &types.QueryResolveMobileRequest{Mobile: "100100001"})
+	require.NoError(t, err)
+	require.Equal(t, bob.String(), res.Address)
+	userRes, err := k.UserByMobile(goCtx, &types.QueryUserByMobileRequest{Mobile: "100100001"})
+	require.NoError(t, err)
+	require.Equal(t, bob.String(), userRes.UserInfo.FromAddress)
+
+	_, err = k.ReleaseMobile(goCtx, types.NewMsgReleaseMobile(alice.String(), "100100002"))
+	require.NoError(t, err)
+	_, found = k.GetMobileOwner(ctx, "100100002")
+	require.False(t, found)
+	_, err = k.ResolveMobile(goCtx, &types.QueryResolveMobileRequest{Mobile: "100100002"})
+	require.ErrorIs(t, err, types.ErrMobileNotAssigned)
+}
+
+func TestSendToMobile(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	sender := sdk.AccAddress([]byte("sender______________"))
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+	gateway := sdk.ValAddress([]byte("gateway_____________"))
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: gateway.String(), NumberIndex: "1001", NumberEnd: []string{"100100001"}},
+		{GatewayAddress: "", NumberIndex: "1002", NumberEnd: []string{"100200001"}, Status: 2},
+	}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: receiver.String(), Mobile: []string{"100100001", "100200001"}}))
+	bankKeeper.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	amount := sdk.NewCoin(config.BaseDenom, sdk.NewInt(400))
+	_, err := k.SendToMobile(goCtx, types.NewMsgSendToMobile(sender.String(), "100100009", amount))
+	require.ErrorIs(t, err, types.ErrMobileNotAssigned)
+	_, err = k.SendToMobile(goCtx, types.NewMsgSendToMobile(sender.String(), "100200001", amount))
+	require.ErrorIs(t, err, types.ErrMobileHeldByGateway)
+	_, err = k.SendToMobile(goCtx, types.NewMsgSendToMobile(sender.String(), "100100001", sdk.NewCoin(config.BaseDenom, sdk.NewInt(2000))))
+	require.ErrorIs(t, err, types.ErrTransfer)
+
+	_, err = k.SendToMobile(goCtx, types.NewMsgSendToMobile(sender.String(), "100100001", amount))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(600), bankKeeper.GetBalance(ctx, sender, config.BaseDenom).Amount)
+	require.Equal(t, sdk.NewInt(400), bankKeeper.GetBalance(ctx, receiver, config.BaseDenom).Amount)
+}
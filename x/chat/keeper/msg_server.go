@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"freemasonry.cc/blockchain/x/chat/types"
+	types2 "freemasonry.cc/blockchain/x/comm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strconv"
 )
@@ -41,12 +42,26 @@ func (k Keeper) MobileTransfer(goCtx context.Context, msg *types.MsgMobileTransf
 		return &types.MsgEmptyResponse{}, types.ErrUserNotHaveMobile
 	}
 
+	if k.HasMobileListing(ctx, msg.Mobile) {
+		return &types.MsgEmptyResponse{}, types.ErrMobileListed
+	}
+
 	
 	toUserInfo, err := k.GetRegisterInfo(ctx, msg.GetToAddress())
 	if err != nil {
 		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
 	}
 
+	err = k.checkNotBlocked(ctx, msg.ToAddress, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	
+	if uint64(len(toUserInfo.Mobile)) >= k.GetParams(ctx).MaxPhoneNumber {
+		return &types.MsgEmptyResponse{}, types.ErrMaxPhoneNumber
+	}
+
 	
 	userInfoNew := make([]string, 0)
 	for _, mobile := range userInfo.Mobile {
@@ -67,47 +82,133 @@ func (k Keeper) MobileTransfer(goCtx context.Context, msg *types.MsgMobileTransf
 		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
 	}
 
-	return nil, nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgMobileTransfer,
+			sdk.NewAttribute(types.MobileEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.MobileEventTypeToAddress, msg.ToAddress),
+			sdk.NewAttribute(types.MobileEventTypeMobile, msg.Mobile),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
 }
 
 
-func (k Keeper) GetRewards(goCtx context.Context, msg *types.MsgGetRewards) (*types.MsgEmptyResponse, error) {
+func (k Keeper) ApplyMobile(goCtx context.Context, msg *types.MsgApplyMobile) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
 	
+	if uint64(len(userInfo.Mobile)) >= k.GetParams(ctx).MaxPhoneNumber {
+		return &types.MsgEmptyResponse{}, types.ErrMaxPhoneNumber
+	}
+
+	mobile, err := k.RegisterMobile(ctx, msg.NodeAddress, msg.FromAddress, msg.MobilePrefix)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
 
+	userInfo.Mobile = append(userInfo.Mobile, mobile)
+	err = k.SetRegisterInfo(ctx, userInfo)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgApplyMobile,
+			sdk.NewAttribute(types.MobileEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.MobileEventTypeNodeAddress, msg.NodeAddress),
+			sdk.NewAttribute(types.MobileEventTypeMobile, mobile),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k Keeper) ReleaseMobile(goCtx context.Context, msg *types.MsgReleaseMobile) (*types.MsgEmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	
-	chatParams := k.GetParams(ctx)
+	userInfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	userMobile := make([]string, 0, len(userInfo.Mobile))
+	for _, mobile := range userInfo.Mobile {
+		if mobile != msg.Mobile {
+			userMobile = append(userMobile, mobile)
+		}
+	}
+	if len(userMobile) == len(userInfo.Mobile) {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotHaveMobile
+	}
+
+	if k.HasMobileListing(ctx, msg.Mobile) {
+		return &types.MsgEmptyResponse{}, types.ErrMobileListed
+	}
 
 	
-	reward, err := k.CaculateChatReward(ctx, msg.FromAddress, chatParams.ChatRewardLog)
+	err = k.ReleaseGatewayMobile(ctx, msg.Mobile)
 	if err != nil {
-		return nil, err
+		return &types.MsgEmptyResponse{}, err
 	}
 
-	
-	userinfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	userInfo.Mobile = userMobile
+	err = k.SetRegisterInfo(ctx, userInfo)
 	if err != nil {
-		return nil, err
+		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
 	}
 
-	CanRedemAmountAdd := reward.Sub(userinfo.CanRedemAmount)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgReleaseMobile,
+			sdk.NewAttribute(types.MobileEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.MobileEventTypeMobile, msg.Mobile),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
 
-	userinfo.CanRedemAmount = reward
 
-	err = k.SetRegisterInfo(ctx, userinfo)
+func (k Keeper) GetRewards(goCtx context.Context, msg *types.MsgGetRewards) (*types.MsgEmptyResponse, error) {
+	
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	
+	userinfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	
-	err = k.SetMortgageLog(ctx, msg.FromAddress, reward)
+	reward, err := k.ClaimChatReward(ctx, userinfo)
 	if err != nil {
-		return &types.MsgEmptyResponse{}, err
+		return nil, err
 	}
 
 	
-	k.SetLastGetHeight(ctx, msg.FromAddress, ctx.BlockHeight(), userinfo.CanRedemAmount)
+	err = k.ReserveChatReward(ctx, reward)
+	if err != nil {
+		return nil, err
+	}
+
+	oldUserInfo := userinfo
+	userinfo.CanRedemAmount = userinfo.CanRedemAmount.Add(reward)
+
+	err = k.SetRegisterInfo(ctx, userinfo)
+	if err != nil {
+		return nil, err
+	}
+	k.updateGatewayUserCount(ctx, oldUserInfo, userinfo)
 
 	
 	ctx.EventManager().EmitEvents(
@@ -116,7 +217,7 @@ func (k Keeper) GetRewards(goCtx context.Context, msg *types.MsgGetRewards) (*ty
 				types.TypeMsgGetRewards,
 				sdk.NewAttribute(types.GetRewardEventTypeFromAddress, msg.FromAddress),
 				sdk.NewAttribute(types.GetRewardEventTypeMortgageAmountNew, userinfo.CanRedemAmount.Amount.String()),
-				sdk.NewAttribute(types.GetRewardEventTypeMortgageAmountAdd, CanRedemAmountAdd.Amount.String()),
+				sdk.NewAttribute(types.GetRewardEventTypeMortgageAmountAdd, reward.Amount.String()),
 				sdk.NewAttribute(types.GetRewardEventTypeDenom, userinfo.CanRedemAmount.Denom),
 			),
 		},
@@ -124,19 +225,497 @@ func (k Keeper) GetRewards(goCtx context.Context, msg *types.MsgGetRewards) (*ty
 	return &types.MsgEmptyResponse{}, nil
 }
 
-func (k Keeper) AddressBookSave(goCtx context.Context, msg *types.MsgAddressBookSave) (*types.MsgEmptyResponse, error) {
+func (k Keeper) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.MsgEmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	userInfo, err := k.GetRegisterInfo(ctx, msg.GetFromAddress())
+
+	userInfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	chatParams := k.GetParams(ctx)
+
+	
+	err = k.SettleChatReward(ctx, userInfo)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	if userInfo.CanRedemAmount.Denom != msg.Amount.Denom || userInfo.CanRedemAmount.IsLT(msg.Amount) {
+		return &types.MsgEmptyResponse{}, types.ErrRedeemAmount
+	}
+
+	oldUserInfo := userInfo
+	userInfo.CanRedemAmount = userInfo.CanRedemAmount.Sub(msg.Amount)
+	err = k.SetRegisterInfo(ctx, userInfo)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
+	}
+	k.updateGatewayUserCount(ctx, oldUserInfo, userInfo)
+
+	entry := types.RedeemEntry{
+		FromAddress:      msg.FromAddress,
+		Amount:           msg.Amount,
+		CreationHeight:   ctx.BlockHeight(),
+		CompletionHeight: ctx.BlockHeight() + chatParams.RedeemPeriod,
+	}
+	err = k.SetRedeemEntry(ctx, entry)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgRedeem,
+			sdk.NewAttribute(types.RedeemEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.RedeemEventTypeAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.RedeemEventTypeDenom, msg.Amount.Denom),
+			sdk.NewAttribute(types.RedeemEventTypeCompletionHeight, strconv.FormatInt(entry.CompletionHeight, 10)),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) PayChat(goCtx context.Context, msg *types.MsgPayChat) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toUserInfo, err := k.GetRegisterInfo(ctx, msg.ToAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	err = k.checkNotBlocked(ctx, msg.ToAddress, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	
+	if toUserInfo.ChatFee.Denom == "" || !toUserInfo.ChatFee.IsPositive() {
+		return &types.MsgEmptyResponse{}, types.ErrChatFree
+	}
+
+	
+	if toUserInfo.ChatFee.Denom != msg.Fee.Denom || !toUserInfo.ChatFee.Amount.Equal(msg.Fee.Amount) {
+		return &types.MsgEmptyResponse{}, types.ErrChatFee
+	}
+
+	if k.HasChatSession(ctx, msg.FromAddress, msg.ToAddress) {
+		return &types.MsgEmptyResponse{}, types.ErrChatSessionExists
+	}
+
+	accFromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrAddressFormat
+	}
+
+	
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accFromAddress, types.ModuleName, sdk.NewCoins(msg.Fee))
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrTransfer
+	}
+
+	session := types.ChatSession{
+		FromAddress:    msg.FromAddress,
+		ToAddress:      msg.ToAddress,
+		Fee:            msg.Fee,
+		CreationHeight: ctx.BlockHeight(),
+		ExpireHeight:   ctx.BlockHeight() + k.GetParams(ctx).ChatSessionPeriod,
+	}
+	err = k.SetChatSession(ctx, session)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgPayChat,
+			sdk.NewAttribute(types.ChatSessionEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeToAddress, msg.ToAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeAmount, msg.Fee.Amount.String()),
+			sdk.NewAttribute(types.ChatSessionEventTypeDenom, msg.Fee.Denom),
+			sdk.NewAttribute(types.ChatSessionEventTypeExpireHeight, strconv.FormatInt(session.ExpireHeight, 10)),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k Keeper) AcceptChat(goCtx context.Context, msg *types.MsgAcceptChat) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	session, err := k.GetChatSession(ctx, msg.SenderAddress, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	accFromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrAddressFormat
+	}
+
+	
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accFromAddress, sdk.NewCoins(session.Fee))
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrTransfer
+	}
+
+	k.deleteChatSession(ctx, session)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgAcceptChat,
+			sdk.NewAttribute(types.ChatSessionEventTypeFromAddress, session.FromAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeToAddress, session.ToAddress),
+			sdk.NewAttribute(types.ChatSessionEventTypeAmount, session.Fee.Amount.String()),
+			sdk.NewAttribute(types.ChatSessionEventTypeDenom, session.Fee.Denom),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) ListMobile(goCtx context.Context, msg *types.MsgListMobile) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	isHave := false
+	for _, mobile := range userInfo.Mobile {
+		if mobile == msg.Mobile {
+			isHave = true
+			break
+		}
+	}
+	if !isHave {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotHaveMobile
+	}
+
+	if k.HasMobileListing(ctx, msg.Mobile) {
+		return &types.MsgEmptyResponse{}, types.ErrMobileListed
+	}
+
+	
+	if msg.ExpireHeight != 0 && msg.ExpireHeight <= ctx.BlockHeight() {
+		return &types.MsgEmptyResponse{}, types.ErrListingExpired
+	}
+
+	listing := types.MobileListing{
+		Mobile:         msg.Mobile,
+		Seller:         msg.FromAddress,
+		Price:          msg.Price,
+		CreationHeight: ctx.BlockHeight(),
+		ExpireHeight:   msg.ExpireHeight,
+	}
+	err = k.SetMobileListing(ctx, listing)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgListMobile,
+			sdk.NewAttribute(types.ListingEventTypeMobile, msg.Mobile),
+			sdk.NewAttribute(types.ListingEventTypeSeller, msg.FromAddress),
+			sdk.NewAttribute(types.ListingEventTypeAmount, msg.Price.Amount.String()),
+			sdk.NewAttribute(types.ListingEventTypeDenom, msg.Price.Denom),
+			sdk.NewAttribute(types.ListingEventTypeExpireHeight, strconv.FormatInt(msg.ExpireHeight, 10)),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) CancelListing(goCtx context.Context, msg *types.MsgCancelListing) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.GetMobileListing(ctx, msg.Mobile)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	if listing.Seller != msg.FromAddress {
+		return &types.MsgEmptyResponse{}, types.ErrListingNotFound
+	}
+
+	k.deleteMobileListing(ctx, listing)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgCancelListing,
+			sdk.NewAttribute(types.ListingEventTypeMobile, listing.Mobile),
+			sdk.NewAttribute(types.ListingEventTypeSeller, listing.Seller),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) BuyMobile(goCtx context.Context, msg *types.MsgBuyMobile) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.GetMobileListing(ctx, msg.Mobile)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	if listing.ExpireHeight != 0 && listing.ExpireHeight <= ctx.BlockHeight() {
+		return &types.MsgEmptyResponse{}, types.ErrListingExpired
+	}
+
+	if listing.Seller == msg.FromAddress {
+		return &types.MsgEmptyResponse{}, types.ErrBuyOwnMobile
+	}
+
+	
+	if listing.Price.Denom != msg.Price.Denom || !listing.Price.Amount.Equal(msg.Price.Amount) {
+		return &types.MsgEmptyResponse{}, types.ErrListingPrice
+	}
+
+	fee, err := k.SellMobile(ctx, listing, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgBuyMobile,
+			sdk.NewAttribute(types.ListingEventTypeMobile, listing.Mobile),
+			sdk.NewAttribute(types.ListingEventTypeSeller, listing.Seller),
+			sdk.NewAttribute(types.ListingEventTypeBuyer, msg.FromAddress),
+			sdk.NewAttribute(types.ListingEventTypeAmount, listing.Price.Amount.String()),
+			sdk.NewAttribute(types.ListingEventTypeDenom, listing.Price.Denom),
+			sdk.NewAttribute(types.ListingEventTypeFee, fee.Amount.String()),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) SendToMobile(goCtx context.Context, msg *types.MsgSendToMobile) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accTo, err := k.ResolveMobileOwner(ctx, msg.Mobile)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	err = k.checkNotBlocked(ctx, accTo.String(), msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	accFrom, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrAddressFormat
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, accFrom, accTo, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrTransfer
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendToMobile,
+			sdk.NewAttribute(types.SendToMobileEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.SendToMobileEventTypeToAddress, accTo.String()),
+			sdk.NewAttribute(types.SendToMobileEventTypeMobile, msg.Mobile),
+			sdk.NewAttribute(types.SendToMobileEventTypeAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) BlockUser(goCtx context.Context, msg *types.MsgBlockUser) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := k.GetRegisterInfo(ctx, msg.FromAddress)
 	if err != nil {
 		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
 	}
 
-	userInfo.Mobile = msg.AddressBook
+	if k.IsBlocked(ctx, msg.FromAddress, msg.BlockedAddress) {
+		return &types.MsgEmptyResponse{}, types.ErrUserAlreadyBlocked
+	}
+
+	if uint64(len(k.GetBlockedUsers(ctx, msg.FromAddress))) >= k.GetParams(ctx).MaxBlockedUsers {
+		return &types.MsgEmptyResponse{}, types.ErrMaxBlockedUsers
+	}
+
+	k.SetBlockedUser(ctx, msg.FromAddress, msg.BlockedAddress)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgBlockUser,
+			sdk.NewAttribute(types.BlockEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.BlockEventTypeBlockedAddress, msg.BlockedAddress),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) UnblockUser(goCtx context.Context, msg *types.MsgUnblockUser) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsBlocked(ctx, msg.FromAddress, msg.BlockedAddress) {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotBlocked
+	}
+
+	k.deleteBlockedUser(ctx, msg.FromAddress, msg.BlockedAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgUnblockUser,
+			sdk.NewAttribute(types.BlockEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.BlockEventTypeBlockedAddress, msg.BlockedAddress),
+		),
+	)
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) ChangeGateway(goCtx context.Context, msg *types.MsgChangeGateway) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userInfo, err := k.GetRegisterInfo(ctx, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	if userInfo.NodeAddress == msg.NodeAddress {
+		return &types.MsgEmptyResponse{}, types.ErrGatewayUnchanged
+	}
+
+	target, _, err := k.commKeeper.GetGatewayNum(ctx, msg.MobilePrefix)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+	if target == nil || target.GatewayAddress != msg.NodeAddress || target.Status != 0 {
+		return &types.MsgEmptyResponse{}, types.ErrGateway
+	}
+	if !k.commKeeper.IsGatewayActive(ctx, msg.NodeAddress) {
+		return &types.MsgEmptyResponse{}, types.ErrGatewayInactive
+	}
+
+	for _, mobile := range userInfo.Mobile {
+		if k.HasMobileListing(ctx, mobile) {
+			return &types.MsgEmptyResponse{}, types.ErrMobileListed
+		}
+	}
+
+	chatParams := k.GetParams(ctx)
+	fee := sdk.NewCoin(chatParams.MobilePortFee.Denom, sdk.ZeroInt())
+	newMobile := make([]string, 0, len(userInfo.Mobile))
+	if msg.PortMobile {
+		ported := make([]string, 0, len(userInfo.Mobile))
+		for _, mobile := range userInfo.Mobile {
+			hosting, err := k.commKeeper.GetGatewayNumByMobile(ctx, mobile)
+			if err != nil {
+				return &types.MsgEmptyResponse{}, err
+			}
+			if hosting == nil {
+				return &types.MsgEmptyResponse{}, types.ErrReleaseMobile
+			}
+			if hosting.NumberIndex != target.NumberIndex {
+				ported = append(ported, mobile)
+			}
+		}
+
+		if len(target.NumberEnd)+len(ported) > types.MobileSuffixMax {
+			return &types.MsgEmptyResponse{}, types.ErrGetMobile
+		}
+
+		fee.Amount = chatParams.MobilePortFee.Amount.MulRaw(int64(len(ported)))
+		if fee.IsPositive() {
+			_, err = k.splitSendCoin(ctx, chatParams.MortgageSplit, types.TransferTypeToModule, types.RewardPoolName, msg.FromAddress, msg.NodeAddress, fee)
+			if err != nil {
+				return &types.MsgEmptyResponse{}, err
+			}
+		}
+
+		for _, mobile := range ported {
+			err = k.ReleaseGatewayMobile(ctx, mobile)
+			if err != nil {
+				return &types.MsgEmptyResponse{}, err
+			}
+			target.NumberEnd = append(target.NumberEnd, mobile)
+		}
+		err = k.commKeeper.SetGatewayNum(ctx, []types2.GatewayNumIndex{*target})
+		if err != nil {
+			return &types.MsgEmptyResponse{}, types.ErrMobileSetError
+		}
+		newMobile = append(newMobile, userInfo.Mobile...)
+	} else {
+		for _, mobile := range userInfo.Mobile {
+			err = k.ReleaseGatewayMobile(ctx, mobile)
+			if err != nil {
+				return &types.MsgEmptyResponse{}, err
+			}
+			mobile, err = k.RegisterMobile(ctx, msg.NodeAddress, msg.FromAddress, msg.MobilePrefix)
+			if err != nil {
+				return &types.MsgEmptyResponse{}, err
+			}
+			newMobile = append(newMobile, mobile)
+		}
+	}
+
+	oldUserInfo := userInfo
+	oldNodeAddress := userInfo.NodeAddress
+	oldMobile := userInfo.Mobile
+	userInfo.NodeAddress = msg.NodeAddress
+	userInfo.Mobile = newMobile
 	err = k.SetRegisterInfo(ctx, userInfo)
 	if err != nil {
 		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
 	}
+	k.updateGatewayUserCount(ctx, oldUserInfo, userInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeMsgChangeGateway,
+			sdk.NewAttribute(types.ChangeGatewayEventTypeFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.ChangeGatewayEventTypeOldNodeAddress, oldNodeAddress),
+			sdk.NewAttribute(types.ChangeGatewayEventTypeNodeAddress, msg.NodeAddress),
+			sdk.NewAttribute(types.ChangeGatewayEventTypePortMobile, strconv.FormatBool(msg.PortMobile)),
+			sdk.NewAttribute(types.ChangeGatewayEventTypeFee, fee.String()),
+		),
+	)
+	for i, mobile := range newMobile {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChangeGatewayMobile,
+				sdk.NewAttribute(types.ChangeGatewayEventTypeFromAddress, msg.FromAddress),
+				sdk.NewAttribute(types.ChangeGatewayEventTypeNodeAddress, msg.NodeAddress),
+				sdk.NewAttribute(types.ChangeGatewayEventTypeOldMobile, oldMobile[i]),
+				sdk.NewAttribute(types.ChangeGatewayEventTypeMobile, mobile),
+			),
+		)
+	}
+
+	return &types.MsgEmptyResponse{}, nil
+}
+
+func (k Keeper) AddressBookSave(goCtx context.Context, msg *types.MsgAddressBookSave) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, err := k.GetRegisterInfo(ctx, msg.GetFromAddress())
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrUserNotFound
+	}
+
+	err = k.SetAddressBook(ctx, types.AddressBook{
+		FromAddress: msg.FromAddress,
+		AddressBook: msg.AddressBook,
+	})
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrAddressBook
+	}
 
 	return &types.MsgEmptyResponse{}, nil
 }
@@ -145,7 +724,27 @@ func (k Keeper) SendGift(goCtx context.Context, msg *types.MsgSendGift) (*types.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	
-	giftValueAll := sdk.NewCoin(msg.GiftValue.Denom, msg.GiftValue.Amount.Mul(sdk.NewInt(msg.GiftAmount)))
+	gift, err := k.GetGift(ctx, msg.GiftId)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	if !gift.Enabled {
+		return &types.MsgEmptyResponse{}, types.ErrGiftDisabled
+	}
+
+	
+	if gift.Price.Denom != msg.GiftValue.Denom || !gift.Price.Amount.Equal(msg.GiftValue.Amount) {
+		return &types.MsgEmptyResponse{}, types.ErrGiftPrice
+	}
+
+	err = k.checkNotBlocked(ctx, msg.ToAddress, msg.FromAddress)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	
+	giftValueAll := sdk.NewCoin(gift.Price.Denom, gift.Price.Amount.Mul(sdk.NewInt(msg.GiftAmount)))
 
 	
 	mortgateInfo, err := k.MortgageSendCoin(ctx, types.TransferTypeToAccount, msg.ToAddress, msg.FromAddress, msg.NodeAddress, giftValueAll)
@@ -173,8 +772,9 @@ func (k Keeper) SendGift(goCtx context.Context, msg *types.MsgSendGift) (*types.
 				sdk.NewAttribute(types.SendGiftEventTypeToAddress, msg.ToAddress),
 				sdk.NewAttribute(types.SendGiftEventTypeGateAddress, msg.NodeAddress),
 				sdk.NewAttribute(types.SendGiftEventTypeGiftId, strconv.FormatInt(msg.GiftId, 10)),
-				sdk.NewAttribute(types.SendGiftEventTypeGiftValue, msg.GiftValue.Amount.String()),
-				sdk.NewAttribute(types.SendGiftEventTypeGiftDenom, msg.GiftValue.Denom),
+				sdk.NewAttribute(types.SendGiftEventTypeGiftName, gift.Name),
+				sdk.NewAttribute(types.SendGiftEventTypeGiftValue, gift.Price.Amount.String()),
+				sdk.NewAttribute(types.SendGiftEventTypeGiftDenom, gift.Price.Denom),
 				sdk.NewAttribute(types.SendGiftEventTypeGiftAmount, strconv.FormatInt(msg.GiftAmount, 10)),
 				sdk.NewAttribute(types.SendGiftEventTypeGiftValueAll, giftValueAll.Amount.String()),
 				sdk.NewAttribute(types.SendGiftEventTypeGiftReceive, mortgateInfo.MortgageRemain.Amount.String()),
@@ -184,7 +784,7 @@ func (k Keeper) SendGift(goCtx context.Context, msg *types.MsgSendGift) (*types.
 				sdk.NewAttribute(types.MortgageEventTypeType, types.EventTypeDevideSendGift),
 				sdk.NewAttribute(types.MortgageEventTypeFromAddress, msg.FromAddress),
 				sdk.NewAttribute(types.MortgageEventTypeMortgageInfo, string(mortgageInfoJson)),
-				sdk.NewAttribute(types.MortgageEventTypeDenom, msg.GiftValue.Denom),
+				sdk.NewAttribute(types.MortgageEventTypeDenom, gift.Price.Denom),
 				sdk.NewAttribute(types.MortgageEventTypeMortgageAmount, giftValueAll.Amount.String()),
 				sdk.NewAttribute(types.MortgageEventTypeFromBalance, fromBalance.String()),
 				sdk.NewAttribute(types.MortgateEventTypeMortgageRemain, mortgateInfo.MortgageRemain.Amount.String()),
@@ -242,18 +842,20 @@ func (k Keeper) MortGage(goCtx context.Context, msg *types.MsgMortgage) (*types.
 	}
 
 	
-	userInfo.CanRedemAmount = userInfo.CanRedemAmount.Add(mortgateInfo.MortgageRemain)
-	userInfo.MortgageAmount = userInfo.MortgageAmount.Add(msg.MortgageAmount)
-	err = k.SetRegisterInfo(ctx, userInfo)
+	err = k.SettleChatReward(ctx, userInfo)
 	if err != nil {
-		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
+		return &types.MsgEmptyResponse{}, err
 	}
 
 	
-	err = k.SetMortgageLog(ctx, msg.FromAddress, userInfo.CanRedemAmount)
+	oldUserInfo := userInfo
+	userInfo.CanRedemAmount = userInfo.CanRedemAmount.Add(mortgateInfo.MortgageRemain)
+	userInfo.MortgageAmount = userInfo.MortgageAmount.Add(msg.MortgageAmount)
+	err = k.SetRegisterInfo(ctx, userInfo)
 	if err != nil {
-		return &types.MsgEmptyResponse{}, err
+		return &types.MsgEmptyResponse{}, types.ErrUserUpdate
 	}
+	k.updateGatewayUserCount(ctx, oldUserInfo, userInfo)
 
 	mortgageInfoJson, err := json.Marshal(mortgateInfo.MortgageDevideInfo)
 	if err != nil {
@@ -323,9 +925,18 @@ func (k Keeper) Register(goCtx context.Context, msg *types.MsgRegister) (*types.
 	if err != nil {
 		return &types.MsgEmptyResponse{}, types.ErrRegister
 	}
+	k.updateGatewayUserCount(ctx, types.UserInfo{}, userInfo)
 
 	
-	err = k.SetMortgageLog(ctx, msg.FromAddress, userInfo.CanRedemAmount)
+	rewardIndex, err := k.GetChatRewardIndex(ctx)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, types.ErrGetBonus
+	}
+	err = k.SetRewardSnapshot(ctx, msg.FromAddress, types.RewardSnapshot{
+		Index:           rewardIndex.Index,
+		Pending:         sdk.ZeroDec(),
+		LastClaimHeight: ctx.BlockHeight(),
+	})
 	if err != nil {
 		return &types.MsgEmptyResponse{}, err
 	}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayUserCountTracksActiveUsers(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	node := sdk.ValAddress([]byte("node________________"))
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(config.BaseDenom, sdk.NewInt(amount))
+	}
+
+	params := k.GetParams(ctx)
+	params.MinMortgageCoin = coin(100)
+	k.SetParams(ctx, params)
+
+	for _, userInfo := range []types.UserInfo{
+		{FromAddress: alice.String(), NodeAddress: node.String(), MortgageAmount: coin(100), CanRedemAmount: coin(100)},
+		{FromAddress: bob.String(), NodeAddress: node.String(), MortgageAmount: coin(100), CanRedemAmount: coin(0)},
+	} {
+		require.NoError(t, k.SetRegisterInfo(ctx, userInfo))
+		k.updateGatewayUserCount(ctx, types.UserInfo{}, userInfo)
+	}
+	require.Equal(t, uint64(1), k.commKeeper.GetGatewayUserCount(ctx, node.String()))
+
+	bankKeeper.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(coin(100))
+	_, err := k.Redeem(goCtx, types.NewMsgRedeem(alice.String(), coin(100)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.commKeeper.GetGatewayUserCount(ctx, node.String()))
+
+	bankKeeper.balances[bob.String()] = sdk.NewCoins(coin(100))
+	_, err = k.MortGage(goCtx, types.NewMsgMortgage(bob.String(), node.String(), coin(100)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.commKeeper.GetGatewayUserCount(ctx, node.String()))
+
+	_, err = k.Redeem(goCtx, types.NewMsgRedeem(alice.String(), coin(0)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.commKeeper.GetGatewayUserCount(ctx, node.String()))
+
+	_, broken := GatewayUserCountInvariant(k)(ctx)
+	require.False(t, broken)
+	k.commKeeper.SetGatewayUserCount(ctx, node.String(), 2)
+	_, broken = GatewayUserCountInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestChangeGatewayPortMobile(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	oldNode := sdk.ValAddress([]byte("old_node____________"))
+	newNode := sdk.ValAddress([]byte("new_node____________"))
+
+	params := k.GetParams(ctx)
+	params.MinMortgageCoin = sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000))
+	params.MobilePortFee = sdk.NewCoin(config.BaseDenom, sdk.NewInt(50))
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: oldNode.String(), NumberIndex: "1001", NumberEnd: []string{"100100001", "100100002"}, Status: 1},
+		{GatewayAddress: newNode.String(), NumberIndex: "2002", NumberEnd: []string{"200200000"}},
+	}))
+	aliceInfo := types.UserInfo{FromAddress: alice.String(), NodeAddress: oldNode.String(), Mobile: []string{"100100001", "100100002"}, CanRedemAmount: sdk.NewCoin(config.BaseDenom, sdk.NewInt(100))}
+	require.NoError(t, k.SetRegisterInfo(ctx, aliceInfo))
+	k.updateGatewayUserCount(ctx, types.UserInfo{}, aliceInfo)
+	bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	_, err := k.ChangeGateway(goCtx, types.NewMsgChangeGateway(alice.String(), oldNode.String(), "1001", true))
+	require.ErrorIs(t, err, types.ErrGatewayUnchanged)
+	_, err = k.ChangeGateway(goCtx, types.NewMsgChangeGateway(alice.String(), newNode.String(), "1001", true))
+	require.ErrorIs(t, err, types.ErrGateway)
+
+	_, err = k.ChangeGateway(goCtx, types.NewMsgChangeGateway(alice.String(), newNode.String(), "2002", true))
+	require.NoError(t, err)
+
+	userInfo, err := k.GetRegisterInfo(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, newNode.String(), userInfo.NodeAddress)
+	require.Equal(t, []string{"100100001", "100100002"}, userInfo.Mobile)
+	require.Equal(t, uint64(0), k.commKeeper.GetGatewayUserCount(ctx, oldNode.String()))
+	require.Equal(t, uint64(1), k.commKeeper.GetGatewayUserCount(ctx, newNode.String()))
+
+	oldNum, _, err := k.commKeeper.GetGatewayNum(ctx, "1001")
+	require.NoError(t, err)
+	require.Empty(t, oldNum.NumberEnd)
+	newNum, _, err := k.commKeeper.GetGatewayNum(ctx, "2002")
+	require.NoError(t, err)
+	require.Equal(t, []string{"200200000", "100100001", "100100002"}, newNum.NumberEnd)
+
+	hosting, err := k.commKeeper.GetGatewayNumByMobile(ctx, "100100001")
+	require.NoError(t, err)
+	require.Equal(t, "2002", hosting.NumberIndex)
+	owner, err := k.ResolveMobileOwner(ctx, "100100001")
+	require.NoError(t, err)
+	require.Equal(t, alice, owner)
+
+	require.Equal(t, sdk.NewInt(900), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(85), k.GetRewardPoolBalance(ctx).Amount)
+	require.Equal(t, sdk.NewInt(5), bankKeeper.balances[sdk.AccAddress(newNode).String()].AmountOf(config.BaseDenom))
+
+	mobileEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeChangeGatewayMobile {
+			mobileEvents++
+		}
+	}
+	require.Equal(t, 2, mobileEvents)
+
+	_, err = k.ReleaseMobile(goCtx, types.NewMsgReleaseMobile(alice.String(), "100100002"))
+	require.NoError(t, err)
+	newNum, _, err = k.commKeeper.GetGatewayNum(ctx, "2002")
+	require.NoError(t, err)
+	require.Equal(t, []string{"200200000", "100100001"}, newNum.NumberEnd)
+}
+
+func TestChangeGatewayReissueMobile(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	oldNode := sdk.ValAddress([]byte("old_node____________"))
+	newNode := sdk.ValAddress([]byte("new_node____________"))
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: oldNode.String(), NumberIndex: "1001", NumberEnd: []string{"100100001"}},
+		{GatewayAddress: newNode.String(), NumberIndex: "2002", NumberEnd: []string{"200200001"}},
+	}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String(), NodeAddress: oldNode.String(), Mobile: []string{"100100001"}}))
+	bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	_, err := k.ChangeGateway(goCtx, types.NewMsgChangeGateway(alice.String(), newNode.String(), "2002", false))
+	require.NoError(t, err)
+
+	userInfo, err := k.GetRegisterInfo(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, newNode.String(), userInfo.NodeAddress)
+	require.Equal(t, []string{"200200000"}, userInfo.Mobile)
+	require.Equal(t, sdk.NewInt(1000), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+
+	oldNum, _, err := k.commKeeper.GetGatewayNum(ctx, "1001")
+	require.NoError(t, err)
+	require.Empty(t, oldNum.NumberEnd)
+	_, found := k.GetMobileOwner(ctx, "100100001")
+	require.False(t, found)
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: bob.String(), NodeAddress: newNode.String()}))
+	_, err = k.ApplyMobile(goCtx, types.NewMsgApplyMobile(bob.String(), newNode.String(), "2002"))
+	require.NoError(t, err)
+	bobInfo, err := k.GetRegisterInfo(ctx, bob.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{"200200002"}, bobInfo.Mobile)
+}
+
+func TestApplyMobileInactiveGateway(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	node := sdk.ValAddress([]byte("node________________"))
+
+	require.NoError(t, k.commKeeper.SetGatewayNum(ctx, []commtypes.GatewayNumIndex{
+		{GatewayAddress: node.String(), NumberIndex: "1001"},
+	}))
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String(), NodeAddress: node.String()}))
+	k.commKeeper.SetGatewayLiveness(ctx, commtypes.GatewayLiveness{GatewayAddress: node.String(), Inactive: true, InactiveHeight: ctx.BlockHeight()})
+
+	_, err := k.ApplyMobile(goCtx, types.NewMsgApplyMobile(alice.String(), node.String(), "1001"))
+	require.ErrorIs(t, err, types.ErrGatewayInactive)
+
+	k.commKeeper.SetGatewayLiveness(ctx, commtypes.GatewayLiveness{GatewayAddress: node.String()})
+	_, err = k.ApplyMobile(goCtx, types.NewMsgApplyMobile(alice.String(), node.String(), "1001"))
+	require.NoError(t, err)
+	userInfo, err := k.GetRegisterInfo(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{"100100000"}, userInfo.Mobile)
+}
+
+func TestSendGiftRejections(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	goCtx := sdk.WrapSDKContext(ctx)
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	node := sdk.ValAddress([]byte("node________________"))
+	price := sdk.NewCoin(config.BaseDenom, sdk.NewInt(100))
+
+	params := k.GetParams(ctx)
+	params.MinMortgageCoin = sdk.NewCoin(config.BaseDenom, sdk.NewInt(1))
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SetGift(ctx, types.Gift{Id: 1, Name: "rose", Price: price, Enabled: true}))
+	require.NoError(t, k.SetGift(ctx, types.Gift{Id: 2, Name: "car", Price: price}))
+	bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	sendGift := func(giftId int64, giftValue sdk.Coin) error {
+		msg := types.NewMsgSendGift(alice.String(), bob.String(), giftId, 2, giftValue)
+		msg.NodeAddress = node.String()
+		_, err := k.SendGift(goCtx, msg)
+		return err
+	}
+
+	require.ErrorIs(t, sendGift(9, price), types.ErrGiftNotFound)
+	require.ErrorIs(t, sendGift(2, price), types.ErrGiftDisabled)
+	require.ErrorIs(t, sendGift(1, sdk.NewCoin(config.BaseDenom, sdk.NewInt(99))), types.ErrGiftPrice)
+	require.ErrorIs(t, sendGift(1, sdk.NewCoin("other", sdk.NewInt(100))), types.ErrGiftPrice)
+	require.Equal(t, sdk.NewInt(1000), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+
+	require.NoError(t, sendGift(1, price))
+	require.Equal(t, sdk.NewInt(800), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(170), bankKeeper.balances[bob.String()].AmountOf(config.BaseDenom))
+}
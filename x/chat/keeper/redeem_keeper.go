@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+)
+
+func (k Keeper) SetRedeemEntry(ctx sdk.Context, entry types.RedeemEntry) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRedeemQueueKey(entry.CompletionHeight, entry.FromAddress)
+
+	bz := store.Get(key)
+	if bz != nil {
+		var old types.RedeemEntry
+		err := k.cdc.Unmarshal(bz, &old)
+		if err != nil || old.Amount.Denom != entry.Amount.Denom {
+			return types.ErrSetRedeemQueue
+		}
+		entry.Amount = entry.Amount.Add(old.Amount)
+	}
+
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return types.ErrSetRedeemQueue
+	}
+	store.Set(key, bz)
+	store.Set(types.GetRedeemByFromKey(entry.FromAddress, entry.CompletionHeight), []byte{})
+
+	return nil
+}
+
+
+func (k Keeper) GetRedeemQueue(ctx sdk.Context) ([]types.RedeemEntry, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRedeemQueue)
+	defer iterator.Close()
+
+	queue := make([]types.RedeemEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.RedeemEntry
+		err := k.cdc.Unmarshal(iterator.Value(), &entry)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, entry)
+	}
+	return queue, nil
+}
+
+
+func (k Keeper) GetRedeemEntries(ctx sdk.Context, fromAddress string) ([]types.RedeemEntry, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRedeemByFromPrefix(fromAddress))
+	defer iterator.Close()
+
+	entries := make([]types.RedeemEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		_, completionHeight := types.ParseRedeemByFromKey(iterator.Key())
+		bz := store.Get(types.GetRedeemQueueKey(completionHeight, fromAddress))
+		if bz == nil {
+			continue
+		}
+		var entry types.RedeemEntry
+		err := k.cdc.Unmarshal(bz, &entry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+
+func (k Keeper) CompleteMatureRedeems(ctx sdk.Context) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainKeeper)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixRedeemQueue, types.GetRedeemQueuePrefix(ctx.BlockHeight()+1))
+
+	matureKeys := make([][]byte, 0)
+	matureEntries := make([]types.RedeemEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.RedeemEntry
+		err := k.cdc.Unmarshal(iterator.Value(), &entry)
+		if err != nil {
+			log.WithError(err).Error("Unmarshal")
+			continue
+		}
+		matureKeys = append(matureKeys, append([]byte{}, iterator.Key()...))
+		matureEntries = append(matureEntries, entry)
+	}
+	iterator.Close()
+
+	for i, entry := range matureEntries {
+		err := k.completeRedeem(ctx, entry)
+		if err != nil {
+			log.WithError(err).WithField("from", entry.FromAddress).Error("completeRedeem")
+			continue
+		}
+		store.Delete(matureKeys[i])
+		store.Delete(types.GetRedeemByFromKey(entry.FromAddress, entry.CompletionHeight))
+	}
+}
+
+func (k Keeper) completeRedeem(ctx sdk.Context, entry types.RedeemEntry) error {
+	accFromAddress, err := sdk.AccAddressFromBech32(entry.FromAddress)
+	if err != nil {
+		return types.ErrAddressFormat
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accFromAddress, sdk.NewCoins(entry.Amount))
+	if err != nil {
+		return types.ErrTransfer
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemComplete,
+			sdk.NewAttribute(types.RedeemEventTypeFromAddress, entry.FromAddress),
+			sdk.NewAttribute(types.RedeemEventTypeAmount, entry.Amount.Amount.String()),
+			sdk.NewAttribute(types.RedeemEventTypeDenom, entry.Amount.Denom),
+			sdk.NewAttribute(types.RedeemEventTypeCompletionHeight, strconv.FormatInt(entry.CompletionHeight, 10)),
+		),
+	)
+
+	return nil
+}
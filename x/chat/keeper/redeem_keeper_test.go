@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedeemQueue(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(config.BaseDenom, sdk.NewInt(amount))
+	}
+	redeem := func(ctx sdk.Context, amount int64) error {
+		_, err := k.Redeem(sdk.WrapSDKContext(ctx), types.NewMsgRedeem(alice.String(), coin(amount)))
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	params.RedeemPeriod = 20
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SetRegisterInfo(ctx, types.UserInfo{FromAddress: alice.String(), CanRedemAmount: coin(1000)}))
+	bankKeeper.balances[moduleAddress] = sdk.NewCoins(coin(1050))
+
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, redeem(ctx, 300))
+	require.NoError(t, redeem(ctx, 200))
+	require.ErrorIs(t, redeem(ctx, 600), types.ErrRedeemAmount)
+
+	entries, err := k.GetRedeemEntries(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, []types.RedeemEntry{{FromAddress: alice.String(), Amount: coin(500), CreationHeight: 5, CompletionHeight: 25}}, entries)
+	userInfo, err := k.GetRegisterInfo(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, coin(500), userInfo.CanRedemAmount)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, redeem(ctx, 100))
+	bobEntry := types.RedeemEntry{FromAddress: bob.String(), Amount: coin(50), CreationHeight: 10, CompletionHeight: 40}
+	require.NoError(t, k.SetRedeemEntry(ctx, bobEntry))
+	queue, err := k.GetRedeemQueue(ctx)
+	require.NoError(t, err)
+	require.Len(t, queue, 3)
+	entries, err = k.GetRedeemEntries(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, []types.RedeemEntry{
+		{FromAddress: alice.String(), Amount: coin(500), CreationHeight: 5, CompletionHeight: 25},
+		{FromAddress: alice.String(), Amount: coin(100), CreationHeight: 10, CompletionHeight: 30},
+	}, entries)
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.CompleteMatureRedeems(ctx.WithBlockHeight(24))
+	require.True(t, bankKeeper.balances[alice.String()].IsZero())
+
+	k.CompleteMatureRedeems(ctx.WithBlockHeight(25))
+	require.Equal(t, sdk.NewInt(500), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	queue, err = k.GetRedeemQueue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.RedeemEntry{{FromAddress: alice.String(), Amount: coin(100), CreationHeight: 10, CompletionHeight: 30}, bobEntry}, queue)
+	entries, err = k.GetRedeemEntries(ctx, alice.String())
+	require.NoError(t, err)
+	require.Equal(t, queue[:1], entries)
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	completeCtx := ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	k.CompleteMatureRedeems(completeCtx)
+	require.Equal(t, sdk.NewInt(600), bankKeeper.balances[alice.String()].AmountOf(config.BaseDenom))
+	require.Equal(t, sdk.NewInt(450), bankKeeper.balances[moduleAddress].AmountOf(config.BaseDenom))
+	queue, err = k.GetRedeemQueue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.RedeemEntry{bobEntry}, queue)
+	entries, err = k.GetRedeemEntries(ctx, alice.String())
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetRedeemByFromPrefix(alice.String()))
+	require.False(t, iterator.Valid())
+	iterator.Close()
+	entries, err = k.GetRedeemEntries(ctx, bob.String())
+	require.NoError(t, err)
+	require.Equal(t, []types.RedeemEntry{bobEntry}, entries)
+	require.Equal(t, types.EventTypeRedeemComplete, completeCtx.EventManager().Events()[0].Type)
+}
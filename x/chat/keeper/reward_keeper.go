@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k Keeper) GetChatRewardIndex(ctx sdk.Context) (types.ChatRewardIndex, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyChatRewardIndex)
+	if bz == nil {
+		return types.ChatRewardIndex{Index: sdk.ZeroDec()}, nil
+	}
+
+	var index types.ChatRewardIndex
+	err := k.cdc.Unmarshal(bz, &index)
+	if err != nil {
+		return types.ChatRewardIndex{}, err
+	}
+	return index, nil
+}
+
+func (k Keeper) SetChatRewardIndex(ctx sdk.Context, index types.ChatRewardIndex) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&index)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyChatRewardIndex, bz)
+	return nil
+}
+
+
+func (k Keeper) UpdateChatRewardIndex(ctx sdk.Context) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainKeeper)
+
+	bonusCycle := k.commKeeper.GetParams(ctx).BonusCycle
+	height := ctx.BlockHeight()
+	if bonusCycle <= 0 || height%bonusCycle != 0 {
+		return
+	}
+
+	ratio, err := types.GetChatRewardRatio(k.GetParams(ctx).ChatRewardLog, height)
+	if err != nil {
+		log.WithError(err).Error("GetChatRewardRatio")
+		return
+	}
+
+	index, err := k.GetChatRewardIndex(ctx)
+	if err != nil {
+		log.WithError(err).Error("GetChatRewardIndex")
+		return
+	}
+	if index.Height >= height {
+		return
+	}
+
+	index.Index = index.Index.Add(ratio)
+	index.Height = height
+	err = k.SetChatRewardIndex(ctx, index)
+	if err != nil {
+		log.WithError(err).Error("SetChatRewardIndex")
+	}
+}
+
+func (k Keeper) GetRewardSnapshot(ctx sdk.Context, fromAddress string) (types.RewardSnapshot, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardSnapshotKey(fromAddress))
+	if bz == nil {
+		index, err := k.GetChatRewardIndex(ctx)
+		if err != nil {
+			return types.RewardSnapshot{}, err
+		}
+		return types.RewardSnapshot{Index: index.Index, Pending: sdk.ZeroDec()}, nil
+	}
+
+	var snapshot types.RewardSnapshot
+	err := k.cdc.Unmarshal(bz, &snapshot)
+	if err != nil {
+		return types.RewardSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+func (k Keeper) GetAllRewardSnapshots(ctx sdk.Context) ([]types.RewardSnapshotRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRewardSnapshot)
+	defer iterator.Close()
+
+	records := make([]types.RewardSnapshotRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RewardSnapshotRecord{
+			FromAddress: string(iterator.Key()[len(types.KeyPrefixRewardSnapshot):]),
+		}
+		err := k.cdc.Unmarshal(iterator.Value(), &record.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (k Keeper) SetRewardSnapshot(ctx sdk.Context, fromAddress string, snapshot types.RewardSnapshot) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&snapshot)
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetRewardSnapshotKey(fromAddress), bz)
+	return nil
+}
+
+
+func (k Keeper) accrueChatReward(ctx sdk.Context, fromAddress string, canRedemAmount sdk.Coin) (types.RewardSnapshot, error) {
+	snapshot, err := k.GetRewardSnapshot(ctx, fromAddress)
+	if err != nil {
+		return snapshot, err
+	}
+
+	index, err := k.GetChatRewardIndex(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	if !canRedemAmount.Amount.IsNil() {
+		snapshot.Pending = snapshot.Pending.Add(canRedemAmount.Amount.ToDec().Mul(index.Index.Sub(snapshot.Index)))
+	}
+	snapshot.Index = index.Index
+	return snapshot, nil
+}
+
+
+func (k Keeper) CaculateChatReward(ctx sdk.Context, userInfo types.UserInfo) (sdk.Coin, error) {
+	snapshot, err := k.accrueChatReward(ctx, userInfo.FromAddress, userInfo.CanRedemAmount)
+	if err != nil {
+		return sdk.Coin{}, types.ErrGetBonus
+	}
+	return sdk.NewCoin(config.BaseDenom, snapshot.Pending.TruncateInt()), nil
+}
+
+
+func (k Keeper) SettleChatReward(ctx sdk.Context, userInfo types.UserInfo) error {
+	snapshot, err := k.accrueChatReward(ctx, userInfo.FromAddress, userInfo.CanRedemAmount)
+	if err != nil {
+		return types.ErrGetBonus
+	}
+	return k.SetRewardSnapshot(ctx, userInfo.FromAddress, snapshot)
+}
+
+
+func (k Keeper) ClaimChatReward(ctx sdk.Context, userInfo types.UserInfo) (sdk.Coin, error) {
+	snapshot, err := k.accrueChatReward(ctx, userInfo.FromAddress, userInfo.CanRedemAmount)
+	if err != nil {
+		return sdk.Coin{}, types.ErrGetBonus
+	}
+
+	reward := sdk.NewCoin(config.BaseDenom, snapshot.Pending.TruncateInt())
+	snapshot.Pending = sdk.ZeroDec()
+	snapshot.LastClaimHeight = ctx.BlockHeight()
+	err = k.SetRewardSnapshot(ctx, userInfo.FromAddress, snapshot)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return reward, nil
+}
+
+
+func (k Keeper) GetRewardPoolBalance(ctx sdk.Context) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.RewardPoolName), config.BaseDenom)
+}
+
+
+func (k Keeper) FundRewardPool(ctx sdk.Context) {
+	log := core.BuildLog(core.GetFuncName(), core.LmChainKeeper)
+
+	feeShare := k.GetParams(ctx).RewardPoolFeeShare
+	if feeShare.IsNil() || !feeShare.IsPositive() {
+		return
+	}
+
+	fees := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName), config.BaseDenom)
+	amount := sdk.NewCoin(config.BaseDenom, fees.Amount.ToDec().Mul(feeShare).TruncateInt())
+	if !amount.IsPositive() {
+		return
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.RewardPoolName, sdk.NewCoins(amount))
+	if err != nil {
+		log.WithError(err).Error("SendCoinsFromModuleToModule")
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.RewardPoolEventTypeAmount, amount.Amount.String()),
+			sdk.NewAttribute(types.RewardPoolEventTypeDenom, amount.Denom),
+		),
+	)
+}
+
+
+func (k Keeper) ReserveChatReward(ctx sdk.Context, reward sdk.Coin) error {
+	if !reward.IsPositive() {
+		return nil
+	}
+
+	if k.GetRewardPoolBalance(ctx).IsLT(reward) {
+		return types.ErrRewardPoolEmpty
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardPoolName, types.ModuleName, sdk.NewCoins(reward))
+	if err != nil {
+		return types.ErrTransfer
+	}
+	return nil
+}
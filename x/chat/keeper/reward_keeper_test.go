@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"math/rand"
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	v6 "freemasonry.cc/blockchain/x/chat/migrations/v6"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commkeeper "freemasonry.cc/blockchain/x/comm/keeper"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type poolAccountKeeper struct {
+	types.AccountKeeper
+}
+
+func (poolAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+type poolBankKeeper struct {
+	types.BankKeeper
+	balances map[string]sdk.Coins
+}
+
+func (b poolBankKeeper) GetBalance(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b poolBankKeeper) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b poolBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.transfer(authtypes.NewModuleAddress(senderModule).String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (b poolBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.transfer(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (b poolBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.transfer(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (b poolBankKeeper) SendCoins(_ sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.transfer(fromAddr.String(), toAddr.String(), amt)
+}
+
+func (b poolBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	module := authtypes.NewModuleAddress(moduleName).String()
+	balance, negative := b.balances[module].SafeSub(amt)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[module] = balance
+	return nil
+}
+
+func (b poolBankKeeper) transfer(sender, recipient string, amt sdk.Coins) error {
+	balance, negative := b.balances[sender].SafeSub(amt)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[sender] = balance
+	b.balances[recipient] = b.balances[recipient].Add(amt...)
+	return nil
+}
+
+func setupRewardKeeper(t *testing.T, bonusCycle int64, chatRewardLog []types.ChatReward) (Keeper, sdk.Context) {
+	config.SetBech32Prefixes(sdk.GetConfig())
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	commStoreKey := sdk.NewKVStoreKey(commtypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(commStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	amino := codec.NewLegacyAmino()
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	commKeeper := commkeeper.NewKeeper(commStoreKey, cdc, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, commtypes.ModuleName), nil, nil, &stakingkeeper.Keeper{})
+	commParams := commtypes.DefaultParams()
+	commParams.BonusCycle = bonusCycle
+	commKeeper.SetParams(ctx, commParams)
+
+	bankKeeper := poolBankKeeper{balances: make(map[string]sdk.Coins)}
+	k := NewKeeper(storeKey, cdc, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, types.ModuleName), poolAccountKeeper{}, bankKeeper, commKeeper)
+	params := types.DefaultParams()
+	params.ChatRewardLog = chatRewardLog
+	k.SetParams(ctx, params)
+
+	return k, ctx
+}
+
+func TestChatRewardIndexMatchesLegacy(t *testing.T) {
+	const bonusCycle = 10
+	chatRewardLog := []types.ChatReward{
+		{Height: 1, Value: "0.001"},
+		{Height: 95, Value: "0.0025"},
+		{Height: 230, Value: "0.0004"},
+	}
+	k, ctx := setupRewardKeeper(t, bonusCycle, chatRewardLog)
+
+	r := rand.New(rand.NewSource(7))
+	addresses := []string{"dex1a", "dex1b", "dex1c"}
+	userInfos := make(map[string]types.UserInfo)
+	mortgageLogs := make(map[string][]types.MortgageAddLog)
+	lastReceives := make(map[string]types.LastReceiveLog)
+
+	for height := int64(1); height <= 400; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.UpdateChatRewardIndex(ctx)
+
+		for _, address := range addresses {
+			userInfo, registered := userInfos[address]
+			switch {
+			case !registered && r.Intn(20) == 0:
+				userInfo = types.UserInfo{
+					FromAddress:    address,
+					CanRedemAmount: sdk.NewCoin(config.BaseDenom, sdk.NewInt(r.Int63n(1000000)+1)),
+				}
+				require.NoError(t, k.SetRewardSnapshot(ctx, address, types.RewardSnapshot{
+					Index:   mustChatRewardIndex(t, k, ctx),
+					Pending: sdk.ZeroDec(),
+				}))
+				userInfos[address] = userInfo
+				mortgageLogs[address] = []types.MortgageAddLog{{Height: height, MortgageValue: userInfo.CanRedemAmount}}
+				lastReceives[address] = types.LastReceiveLog{Height: height}
+
+			case registered && r.Intn(8) == 0:
+				require.NoError(t, k.SettleChatReward(ctx, userInfo))
+				userInfo.CanRedemAmount = userInfo.CanRedemAmount.AddAmount(sdk.NewInt(r.Int63n(500000)))
+				userInfos[address] = userInfo
+				mortgageLogs[address] = append(mortgageLogs[address], types.MortgageAddLog{Height: height, MortgageValue: userInfo.CanRedemAmount})
+
+			case registered && r.Intn(15) == 0:
+				expected, err := v6.LegacyChatReward(mortgageLogs[address], lastReceives[address], chatRewardLog, bonusCycle, height+1)
+				require.NoError(t, err)
+
+				reward, err := k.ClaimChatReward(ctx, userInfo)
+				require.NoError(t, err)
+				require.Equal(t, expected.TruncateInt(), reward.Amount, "address %s height %d", address, height)
+
+				userInfo.CanRedemAmount = userInfo.CanRedemAmount.Add(reward)
+				userInfos[address] = userInfo
+				mortgageLogs[address] = append(mortgageLogs[address], types.MortgageAddLog{Height: height, MortgageValue: userInfo.CanRedemAmount})
+				lastReceives[address] = types.LastReceiveLog{Height: height, Value: userInfo.CanRedemAmount}
+			}
+		}
+	}
+}
+
+func TestClaimChatRewardAtCycleHeight(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	claimer := types.UserInfo{FromAddress: "dex1a", CanRedemAmount: sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000))}
+	settler := types.UserInfo{FromAddress: "dex1b", CanRedemAmount: sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000))}
+
+	ctx = ctx.WithBlockHeight(1)
+	for _, userInfo := range []types.UserInfo{claimer, settler} {
+		require.NoError(t, k.SetRewardSnapshot(ctx, userInfo.FromAddress, types.RewardSnapshot{Index: mustChatRewardIndex(t, k, ctx), Pending: sdk.ZeroDec()}))
+	}
+
+	for height := int64(2); height <= 30; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.UpdateChatRewardIndex(ctx)
+		if height%10 != 0 || height == 10 {
+			continue
+		}
+
+		require.NoError(t, k.SettleChatReward(ctx, settler))
+		settlerReward, err := k.ClaimChatReward(ctx, settler)
+		require.NoError(t, err)
+		claimerReward, err := k.ClaimChatReward(ctx, claimer)
+		require.NoError(t, err)
+		require.Equal(t, settlerReward, claimerReward, "height %d", height)
+
+		if height == 20 {
+			require.Equal(t, sdk.NewInt(20), claimerReward.Amount)
+		} else {
+			require.Equal(t, sdk.NewInt(10), claimerReward.Amount)
+		}
+	}
+}
+
+func mustChatRewardIndex(t *testing.T, k Keeper, ctx sdk.Context) sdk.Dec {
+	index, err := k.GetChatRewardIndex(ctx)
+	require.NoError(t, err)
+	return index.Index
+}
+
+func TestRewardPool(t *testing.T) {
+	k, ctx := setupRewardKeeper(t, 10, []types.ChatReward{{Height: 1, Value: "0.01"}})
+	bankKeeper := k.bankKeeper.(poolBankKeeper)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	bankKeeper.balances[feeCollector] = sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000)))
+
+	require.ErrorIs(t, k.ReserveChatReward(ctx, sdk.NewCoin(config.BaseDenom, sdk.NewInt(1))), types.ErrRewardPoolEmpty)
+	require.NoError(t, k.ReserveChatReward(ctx, sdk.NewCoin(config.BaseDenom, sdk.ZeroInt())))
+
+	k.FundRewardPool(ctx)
+	require.Equal(t, sdk.NewInt(100), k.GetRewardPoolBalance(ctx).Amount)
+	require.Equal(t, sdk.NewInt(900), bankKeeper.balances[feeCollector].AmountOf(config.BaseDenom))
+
+	require.NoError(t, k.ReserveChatReward(ctx, sdk.NewCoin(config.BaseDenom, sdk.NewInt(60))))
+	require.Equal(t, sdk.NewInt(40), k.GetRewardPoolBalance(ctx).Amount)
+	require.Equal(t, sdk.NewInt(60), bankKeeper.balances[authtypes.NewModuleAddress(types.ModuleName).String()].AmountOf(config.BaseDenom))
+	require.ErrorIs(t, k.ReserveChatReward(ctx, sdk.NewCoin(config.BaseDenom, sdk.NewInt(41))), types.ErrRewardPoolEmpty)
+
+	params := k.GetParams(ctx)
+	params.RewardPoolFeeShare = sdk.ZeroDec()
+	k.SetParams(ctx, params)
+	k.FundRewardPool(ctx)
+	require.Equal(t, sdk.NewInt(40), k.GetRewardPoolBalance(ctx).Amount)
+}
@@ -0,0 +1,97 @@
+package v3
+
+import (
+	"freemasonry.cc/blockchain/util"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	LegacyKeyPrefixRegisterInfo = "chat_register_info_"
+
+	LegacyKeyPrefixLastGetRewardLog = "chat_last_get_reward_log_"
+
+	LegacyKeyPrefixMortgageAddLog = "chat_mortgage_add_log_"
+)
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if !paramstore.Has(ctx, types.KeyRedeemPeriod) {
+		paramstore.Set(ctx, types.KeyRedeemPeriod, types.DefaultParams().RedeemPeriod)
+	}
+
+	err := migratePrefix(store, LegacyKeyPrefixRegisterInfo, func(_ string, value []byte) error {
+		var userInfo types.UserInfo
+		err := util.Json.Unmarshal(value, &userInfo)
+		if err != nil {
+			return err
+		}
+		bz, err := cdc.Marshal(&userInfo)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetRegisterInfoKey(userInfo.FromAddress), bz)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = migratePrefix(store, LegacyKeyPrefixLastGetRewardLog, func(fromAddress string, value []byte) error {
+		var log types.LastReceiveLog
+		err := util.Json.Unmarshal(value, &log)
+		if err != nil {
+			return err
+		}
+		bz, err := cdc.Marshal(&log)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetLastGetRewardLogKey(fromAddress), bz)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return migratePrefix(store, LegacyKeyPrefixMortgageAddLog, func(fromAddress string, value []byte) error {
+		var logs []types.MortgageAddLog
+		err := util.Json.Unmarshal(value, &logs)
+		if err != nil {
+			return err
+		}
+		for _, log := range logs {
+			bz, err := cdc.Marshal(&log)
+			if err != nil {
+				return err
+			}
+			store.Set(types.GetMortgageAddLogKey(fromAddress, log.Height), bz)
+		}
+		return nil
+	})
+}
+
+
+func migratePrefix(store sdk.KVStore, prefix string, migrate func(suffix string, value []byte) error) error {
+	iterator := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+		values = append(values, append([]byte{}, iterator.Value()...))
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		err := migrate(string(key[len(prefix):]), values[i])
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+	}
+	return nil
+}
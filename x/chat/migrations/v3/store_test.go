@@ -0,0 +1,97 @@
+package v3_test
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/util"
+	v3 "freemasonry.cc/blockchain/x/chat/migrations/v3"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+
+type legacyUserInfo struct {
+	FromAddress    string   `json:"from_address" yaml:"from_address"`
+	NodeAddress    string   `json:"node_address" yaml:"node_address"`
+	MortgageAmount sdk.Coin `json:"mortgage_amount" yaml:"mortgage_amount"`
+	CanRedemAmount sdk.Coin `json:"can_redem_amount" yaml:"can_redem_amount"`
+	Mobile         []string `json:"mobile" yaml:"mobile"`
+	ChatFee        sdk.Coin `json:"chat_fee" yaml:"chat_fee"`
+}
+
+type legacyLastReceiveLog struct {
+	Height int64    `json:"height"`
+	Value  sdk.Coin `json:"value"`
+}
+
+type legacyMortgageAddLog struct {
+	Height        int64    `json:"height"`
+	MortgageValue sdk.Coin `json:"mortgage_value"`
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	store := ctx.KVStore(storeKey)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	userInfo := legacyUserInfo{
+		FromAddress:    "dex1a",
+		NodeAddress:    "dexvaloper1a",
+		MortgageAmount: coin,
+		CanRedemAmount: coin,
+		Mobile:         []string{"100100001"},
+		ChatFee:        coin,
+	}
+	bz, err := util.Json.Marshal(userInfo)
+	require.NoError(t, err)
+	store.Set([]byte(v3.LegacyKeyPrefixRegisterInfo+"dex1a"), bz)
+
+	bz, err = util.Json.Marshal(legacyLastReceiveLog{Height: 7, Value: coin})
+	require.NoError(t, err)
+	store.Set([]byte(v3.LegacyKeyPrefixLastGetRewardLog+"dex1a"), bz)
+
+	mortgageLogs := []legacyMortgageAddLog{{Height: 3, MortgageValue: coin}, {Height: 9, MortgageValue: coin.Add(coin)}}
+	bz, err = util.Json.Marshal(mortgageLogs)
+	require.NoError(t, err)
+	store.Set([]byte(v3.LegacyKeyPrefixMortgageAddLog+"dex1a"), bz)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, paramstore, cdc))
+
+	require.False(t, store.Has([]byte(v3.LegacyKeyPrefixRegisterInfo+"dex1a")))
+	require.False(t, store.Has([]byte(v3.LegacyKeyPrefixLastGetRewardLog+"dex1a")))
+	require.False(t, store.Has([]byte(v3.LegacyKeyPrefixMortgageAddLog+"dex1a")))
+
+	var migratedUser types.UserInfo
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetRegisterInfoKey("dex1a")), &migratedUser))
+	require.Equal(t, types.UserInfo{
+		FromAddress:    userInfo.FromAddress,
+		NodeAddress:    userInfo.NodeAddress,
+		MortgageAmount: userInfo.MortgageAmount,
+		CanRedemAmount: userInfo.CanRedemAmount,
+		Mobile:         userInfo.Mobile,
+		ChatFee:        userInfo.ChatFee,
+	}, migratedUser)
+
+	var lastLog types.LastReceiveLog
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetLastGetRewardLogKey("dex1a")), &lastLog))
+	require.Equal(t, types.LastReceiveLog{Height: 7, Value: coin}, lastLog)
+
+	for _, expected := range mortgageLogs {
+		var log types.MortgageAddLog
+		require.NoError(t, cdc.Unmarshal(store.Get(types.GetMortgageAddLogKey("dex1a", expected.Height)), &log))
+		require.Equal(t, types.MortgageAddLog{Height: expected.Height, MortgageValue: expected.MortgageValue}, log)
+	}
+
+	var redeemPeriod int64
+	paramstore.Get(ctx, types.KeyRedeemPeriod, &redeemPeriod)
+	require.Equal(t, types.DefaultParams().RedeemPeriod, redeemPeriod)
+}
@@ -0,0 +1,31 @@
+package v4
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+
+func MigrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if paramstore.Has(ctx, types.KeyMortgageSplit) {
+		return nil
+	}
+
+	var communityAddress, ecologicalAddress string
+	paramstore.GetIfExists(ctx, types.KeyCommunityAddress, &communityAddress)
+	paramstore.GetIfExists(ctx, types.KeyEcologicalAddress, &ecologicalAddress)
+
+	mortgageSplit := types.DefaultParams().MortgageSplit
+	for i, recipient := range mortgageSplit {
+		switch {
+		case recipient.Name == "community" && communityAddress != "":
+			mortgageSplit[i].Address = communityAddress
+		case recipient.Name == "ecological" && ecologicalAddress != "":
+			mortgageSplit[i].Address = ecologicalAddress
+		}
+	}
+
+	paramstore.Set(ctx, types.KeyMortgageSplit, mortgageSplit)
+	return nil
+}
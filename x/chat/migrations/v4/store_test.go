@@ -0,0 +1,61 @@
+package v4_test
+
+import (
+	"testing"
+
+	v4 "freemasonry.cc/blockchain/x/chat/migrations/v4"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	paramstore.Set(ctx, types.KeyCommunityAddress, "dex1community")
+	paramstore.Set(ctx, types.KeyEcologicalAddress, "dex1ecological")
+
+	require.NoError(t, v4.MigrateParams(ctx, paramstore))
+
+	var mortgageSplit []types.MortgageRecipient
+	paramstore.Get(ctx, types.KeyMortgageSplit, &mortgageSplit)
+	require.Len(t, mortgageSplit, len(types.DefaultParams().MortgageSplit))
+
+	total := sdk.ZeroDec()
+	for _, recipient := range mortgageSplit {
+		total = total.Add(recipient.Ratio)
+		switch recipient.Name {
+		case "community":
+			require.Equal(t, "dex1community", recipient.Address)
+		case "ecological":
+			require.Equal(t, "dex1ecological", recipient.Address)
+		}
+	}
+	require.True(t, total.Equal(sdk.OneDec()))
+}
+
+func TestMigrateParamsMissingAddresses(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	paramstore.Set(ctx, types.KeyEcologicalAddress, "")
+
+	require.NotPanics(t, func() {
+		require.NoError(t, v4.MigrateParams(ctx, paramstore))
+	})
+
+	var mortgageSplit []types.MortgageRecipient
+	paramstore.Get(ctx, types.KeyMortgageSplit, &mortgageSplit)
+	require.Equal(t, types.DefaultParams().MortgageSplit, mortgageSplit)
+}
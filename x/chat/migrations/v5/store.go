@@ -0,0 +1,58 @@
+package v5
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, issuedMobiles map[string]bool) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRegisterInfo)
+	userInfos := make([]types.UserInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var userInfo types.UserInfo
+		err := cdc.Unmarshal(iterator.Value(), &userInfo)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+	iterator.Close()
+
+	for _, userInfo := range userInfos {
+		mobiles := make([]string, 0, len(userInfo.Mobile))
+		addressBook := make([]string, 0)
+		for _, mobile := range userInfo.Mobile {
+			if issuedMobiles[mobile] {
+				mobiles = append(mobiles, mobile)
+			} else {
+				addressBook = append(addressBook, mobile)
+			}
+		}
+		if len(addressBook) == 0 {
+			continue
+		}
+
+		userInfo.Mobile = mobiles
+		bz, err := cdc.Marshal(&userInfo)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetRegisterInfoKey(userInfo.FromAddress), bz)
+
+		bz, err = cdc.Marshal(&types.AddressBook{
+			FromAddress: userInfo.FromAddress,
+			AddressBook: addressBook,
+		})
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetAddressBookKey(userInfo.FromAddress), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package v5_test
+
+import (
+	"testing"
+
+	v5 "freemasonry.cc/blockchain/x/chat/migrations/v5"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	store := ctx.KVStore(storeKey)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	userInfos := []types.UserInfo{
+		{FromAddress: "dex1a", MortgageAmount: coin, CanRedemAmount: coin, ChatFee: coin, Mobile: []string{"100100001", "alice", "100100009"}},
+		{FromAddress: "dex1b", MortgageAmount: coin, CanRedemAmount: coin, ChatFee: coin, Mobile: []string{"100100002"}},
+	}
+	for _, userInfo := range userInfos {
+		userInfo := userInfo
+		bz, err := cdc.Marshal(&userInfo)
+		require.NoError(t, err)
+		store.Set(types.GetRegisterInfoKey(userInfo.FromAddress), bz)
+	}
+
+	issuedMobiles := map[string]bool{"100100001": true, "100100002": true}
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc, issuedMobiles))
+
+	var userInfo types.UserInfo
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetRegisterInfoKey("dex1a")), &userInfo))
+	require.Equal(t, []string{"100100001"}, userInfo.Mobile)
+
+	var addressBook types.AddressBook
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetAddressBookKey("dex1a")), &addressBook))
+	require.Equal(t, []string{"alice", "100100009"}, addressBook.AddressBook)
+
+	var otherUserInfo types.UserInfo
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetRegisterInfoKey("dex1b")), &otherUserInfo))
+	require.Equal(t, []string{"100100002"}, otherUserInfo.Mobile)
+	require.Nil(t, store.Get(types.GetAddressBookKey("dex1b")))
+}
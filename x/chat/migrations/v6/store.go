@@ -0,0 +1,122 @@
+package v6
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func LegacyChatReward(mortgageLog []types.MortgageAddLog, lastReceive types.LastReceiveLog, chatRewardLog []types.ChatReward, bonusCycle, height int64) (sdk.Dec, error) {
+	reward := sdk.ZeroDec()
+	if bonusCycle <= 0 {
+		return reward, nil
+	}
+
+	for cycleHeight := (lastReceive.Height/bonusCycle + 1) * bonusCycle; cycleHeight < height; cycleHeight += bonusCycle {
+		ratio, err := types.GetChatRewardRatio(chatRewardLog, cycleHeight)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		balance := sdk.ZeroInt()
+		for _, addLog := range mortgageLog {
+			if addLog.Height < cycleHeight {
+				balance = addLog.MortgageValue.Amount
+			}
+		}
+
+		reward = reward.Add(balance.ToDec().Mul(ratio))
+	}
+	return reward, nil
+}
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, chatRewardLog []types.ChatReward, bonusCycle int64) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRegisterInfo)
+	userInfos := make([]types.UserInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var userInfo types.UserInfo
+		err := cdc.Unmarshal(iterator.Value(), &userInfo)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+	iterator.Close()
+
+	for _, userInfo := range userInfos {
+		mortgageLog, err := getMortgageLog(store, cdc, userInfo.FromAddress)
+		if err != nil {
+			return err
+		}
+
+		lastReceive := types.LastReceiveLog{Height: 1}
+		lastClaimHeight := int64(0)
+		bz := store.Get(types.GetLastGetRewardLogKey(userInfo.FromAddress))
+		if bz != nil {
+			err = cdc.Unmarshal(bz, &lastReceive)
+			if err != nil {
+				return err
+			}
+			lastClaimHeight = lastReceive.Height
+		}
+
+		pending, err := LegacyChatReward(mortgageLog, lastReceive, chatRewardLog, bonusCycle, ctx.BlockHeight())
+		if err != nil {
+			return err
+		}
+
+		bz, err = cdc.Marshal(&types.RewardSnapshot{
+			Index:           sdk.ZeroDec(),
+			Pending:         pending,
+			LastClaimHeight: lastClaimHeight,
+		})
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetRewardSnapshotKey(userInfo.FromAddress), bz)
+	}
+
+	bz, err := cdc.Marshal(&types.ChatRewardIndex{Index: sdk.ZeroDec()})
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyChatRewardIndex, bz)
+
+	deletePrefix(store, types.KeyPrefixMortgageAddLog)
+	deletePrefix(store, types.KeyPrefixLastGetRewardLog)
+	return nil
+}
+
+func getMortgageLog(store sdk.KVStore, cdc codec.BinaryCodec, fromAddress string) ([]types.MortgageAddLog, error) {
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMortgageAddLogPrefix(fromAddress))
+	defer iterator.Close()
+
+	mortgageLog := make([]types.MortgageAddLog, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var log types.MortgageAddLog
+		err := cdc.Unmarshal(iterator.Value(), &log)
+		if err != nil {
+			return nil, err
+		}
+		mortgageLog = append(mortgageLog, log)
+	}
+	return mortgageLog, nil
+}
+
+func deletePrefix(store sdk.KVStore, prefix []byte) {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,66 @@
+package v6_test
+
+import (
+	"testing"
+
+	v6 "freemasonry.cc/blockchain/x/chat/migrations/v6"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey).WithBlockHeight(45)
+	store := ctx.KVStore(storeKey)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	for _, address := range []string{"dex1a", "dex1b"} {
+		bz, err := cdc.Marshal(&types.UserInfo{FromAddress: address, CanRedemAmount: coin})
+		require.NoError(t, err)
+		store.Set(types.GetRegisterInfoKey(address), bz)
+	}
+
+	mortgageLog := []types.MortgageAddLog{
+		{Height: 5, MortgageValue: coin},
+		{Height: 25, MortgageValue: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300))},
+	}
+	for _, log := range mortgageLog {
+		log := log
+		bz, err := cdc.Marshal(&log)
+		require.NoError(t, err)
+		store.Set(types.GetMortgageAddLogKey("dex1a", log.Height), bz)
+	}
+	bz, err := cdc.Marshal(&types.LastReceiveLog{Height: 12, Value: coin})
+	require.NoError(t, err)
+	store.Set(types.GetLastGetRewardLogKey("dex1a"), bz)
+
+	chatRewardLog := []types.ChatReward{
+		{Height: 1, Value: "0.1"},
+		{Height: 30, Value: "0.2"},
+	}
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc, chatRewardLog, 10))
+
+	var snapshot types.RewardSnapshot
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetRewardSnapshotKey("dex1a")), &snapshot))
+	require.Equal(t, sdk.NewDec(130), snapshot.Pending)
+	require.True(t, snapshot.Index.IsZero())
+	require.Equal(t, int64(12), snapshot.LastClaimHeight)
+
+	var otherSnapshot types.RewardSnapshot
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetRewardSnapshotKey("dex1b")), &otherSnapshot))
+	require.True(t, otherSnapshot.Pending.IsZero())
+	require.Equal(t, int64(0), otherSnapshot.LastClaimHeight)
+
+	var index types.ChatRewardIndex
+	require.NoError(t, cdc.Unmarshal(store.Get(types.KeyChatRewardIndex), &index))
+	require.True(t, index.Index.IsZero())
+
+	require.False(t, sdk.KVStorePrefixIterator(store, types.KeyPrefixMortgageAddLog).Valid())
+	require.False(t, sdk.KVStorePrefixIterator(store, types.KeyPrefixLastGetRewardLog).Valid())
+}
@@ -0,0 +1,28 @@
+package v7
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+
+func MigrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	if !paramstore.Has(ctx, types.KeyChatSessionPeriod) {
+		paramstore.Set(ctx, types.KeyChatSessionPeriod, params.ChatSessionPeriod)
+	}
+	if !paramstore.Has(ctx, types.KeyRewardPoolFeeShare) {
+		paramstore.Set(ctx, types.KeyRewardPoolFeeShare, params.RewardPoolFeeShare)
+	}
+	if !paramstore.Has(ctx, types.KeyMobileMarketFee) {
+		paramstore.Set(ctx, types.KeyMobileMarketFee, params.MobileMarketFee)
+	}
+	if !paramstore.Has(ctx, types.KeyMaxBlockedUsers) {
+		paramstore.Set(ctx, types.KeyMaxBlockedUsers, params.MaxBlockedUsers)
+	}
+	if !paramstore.Has(ctx, types.KeyMobilePortFee) {
+		paramstore.Set(ctx, types.KeyMobilePortFee, params.MobilePortFee)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package v7_test
+
+import (
+	"testing"
+
+	v7 "freemasonry.cc/blockchain/x/chat/migrations/v7"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	amino := codec.NewLegacyAmino()
+	paramstore := paramtypes.NewSubspace(cdc, amino, storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	require.NoError(t, v7.MigrateParams(ctx, paramstore))
+
+	defaults := types.DefaultParams()
+	testCases := []struct {
+		name     string
+		key      []byte
+		expected interface{}
+	}{
+		{"chat session period", types.KeyChatSessionPeriod, defaults.ChatSessionPeriod},
+		{"reward pool fee share", types.KeyRewardPoolFeeShare, defaults.RewardPoolFeeShare},
+		{"mobile market fee", types.KeyMobileMarketFee, defaults.MobileMarketFee},
+		{"max blocked users", types.KeyMaxBlockedUsers, defaults.MaxBlockedUsers},
+		{"mobile port fee", types.KeyMobilePortFee, defaults.MobilePortFee},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := amino.MarshalJSON(tc.expected)
+			require.NoError(t, err)
+			require.Equal(t, string(bz), string(paramstore.GetRaw(ctx, tc.key)))
+		})
+	}
+
+	paramstore.Set(ctx, types.KeyMaxBlockedUsers, uint64(7))
+	require.NoError(t, v7.MigrateParams(ctx, paramstore))
+	var maxBlockedUsers uint64
+	paramstore.Get(ctx, types.KeyMaxBlockedUsers, &maxBlockedUsers)
+	require.Equal(t, uint64(7), maxBlockedUsers)
+}
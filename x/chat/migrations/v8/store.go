@@ -0,0 +1,37 @@
+package v8
+
+import (
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRegisterInfo)
+	userInfos := make([]types.UserInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var userInfo types.UserInfo
+		err := cdc.Unmarshal(iterator.Value(), &userInfo)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		userInfos = append(userInfos, userInfo)
+	}
+	iterator.Close()
+
+	for _, userInfo := range userInfos {
+		for _, mobile := range userInfo.Mobile {
+			key := types.GetMobileOwnerKey(mobile)
+			if store.Has(key) {
+				continue
+			}
+			store.Set(key, []byte(userInfo.FromAddress))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v8_test
+
+import (
+	"testing"
+
+	v8 "freemasonry.cc/blockchain/x/chat/migrations/v8"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	store := ctx.KVStore(storeKey)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	userInfos := []types.UserInfo{
+		{FromAddress: "dex1a", MortgageAmount: coin, CanRedemAmount: coin, ChatFee: coin, Mobile: []string{"100100001", "100100003"}},
+		{FromAddress: "dex1b", MortgageAmount: coin, CanRedemAmount: coin, ChatFee: coin, Mobile: []string{"100100002"}},
+		{FromAddress: "dex1c", MortgageAmount: coin, CanRedemAmount: coin, ChatFee: coin},
+	}
+	for _, userInfo := range userInfos {
+		userInfo := userInfo
+		bz, err := cdc.Marshal(&userInfo)
+		require.NoError(t, err)
+		store.Set(types.GetRegisterInfoKey(userInfo.FromAddress), bz)
+	}
+
+	require.NoError(t, v8.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, []byte("dex1a"), store.Get(types.GetMobileOwnerKey("100100001")))
+	require.Equal(t, []byte("dex1a"), store.Get(types.GetMobileOwnerKey("100100003")))
+	require.Equal(t, []byte("dex1b"), store.Get(types.GetMobileOwnerKey("100100002")))
+	require.Nil(t, store.Get(types.GetMobileOwnerKey("100100004")))
+}
@@ -0,0 +1,45 @@
+package chat
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"freemasonry.cc/blockchain/x/chat/keeper"
+	"freemasonry.cc/blockchain/x/chat/types"
+)
+
+
+func NewChatProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetGiftProposal:
+			return handleSetGiftProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleSetGiftProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetGiftProposal) error {
+	for _, gift := range p.Gifts {
+		if err := k.SetGift(ctx, gift); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetGift,
+				sdk.NewAttribute(types.GiftEventTypeId, strconv.FormatInt(gift.Id, 10)),
+				sdk.NewAttribute(types.GiftEventTypeName, gift.Name),
+				sdk.NewAttribute(types.GiftEventTypePrice, gift.Price.String()),
+				sdk.NewAttribute(types.GiftEventTypeEnabled, strconv.FormatBool(gift.Enabled)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package chat_test
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat"
+	"freemasonry.cc/blockchain/x/chat/keeper"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commkeeper "freemasonry.cc/blockchain/x/comm/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetGiftProposalHandler(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName)
+	k := keeper.NewKeeper(storeKey, cdc, paramstore, nil, nil, commkeeper.Keeper{})
+
+	router := govtypes.NewRouter()
+	router.AddRoute(types.RouterKey, chat.NewChatProposalHandler(&k))
+	price := sdk.NewCoin(config.BaseDenom, sdk.NewInt(100))
+
+	content := types.NewSetGiftProposal("gifts", "add gifts", []types.Gift{
+		{Id: 1, Name: "rose", Price: price, Enabled: true},
+		{Id: 2, Name: "car", Price: price.AddAmount(sdk.NewInt(900)), Enabled: true},
+	})
+	require.NoError(t, content.ValidateBasic())
+	require.True(t, router.HasRoute(content.ProposalRoute()))
+	require.NoError(t, router.GetRoute(content.ProposalRoute())(ctx, content))
+
+	gifts, err := k.GetAllGifts(ctx)
+	require.NoError(t, err)
+	require.Len(t, gifts, 2)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeSetGift, events[0].Type)
+
+	content = types.NewSetGiftProposal("disable car", "retire the car gift", []types.Gift{
+		{Id: 2, Name: "car", Price: price.AddAmount(sdk.NewInt(900))},
+	})
+	require.NoError(t, router.GetRoute(content.ProposalRoute())(ctx, content))
+	gift, err := k.GetGift(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, gift.Enabled)
+	gift, err = k.GetGift(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, gift.Enabled)
+
+	err = router.GetRoute(types.RouterKey)(ctx, distrtypes.NewCommunityPoolSpendProposal("spend", "spend", sdk.AccAddress([]byte("recipient___________")), sdk.NewCoins(price)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRegisterInfo):
+			var userInfoA, userInfoB types.UserInfo
+
+			cdc.MustUnmarshal(kvA.Value, &userInfoA)
+			cdc.MustUnmarshal(kvB.Value, &userInfoB)
+
+			return fmt.Sprintf("%v\n%v", userInfoA, userInfoB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRedeemQueue):
+			var entryA, entryB types.RedeemEntry
+
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAddressBook):
+			var addressBookA, addressBookB types.AddressBook
+
+			cdc.MustUnmarshal(kvA.Value, &addressBookA)
+			cdc.MustUnmarshal(kvB.Value, &addressBookB)
+
+			return fmt.Sprintf("%v\n%v", addressBookA, addressBookB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixChatSession):
+			var sessionA, sessionB types.ChatSession
+
+			cdc.MustUnmarshal(kvA.Value, &sessionA)
+			cdc.MustUnmarshal(kvB.Value, &sessionB)
+
+			return fmt.Sprintf("%v\n%v", sessionA, sessionB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixChatSessionByTo):
+			toA, fromA := types.ParseChatSessionByToKey(kvA.Key)
+			toB, fromB := types.ParseChatSessionByToKey(kvB.Key)
+
+			return fmt.Sprintf("%s -> %s\n%s -> %s", fromA, toA, fromB, toB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixChatSessionQueue):
+			fromA, toA := types.ParseChatSessionQueueKey(kvA.Key)
+			fromB, toB := types.ParseChatSessionQueueKey(kvB.Key)
+
+			return fmt.Sprintf("%s -> %s\n%s -> %s", fromA, toA, fromB, toB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGift):
+			var giftA, giftB types.Gift
+
+			cdc.MustUnmarshal(kvA.Value, &giftA)
+			cdc.MustUnmarshal(kvB.Value, &giftB)
+
+			return fmt.Sprintf("%v\n%v", giftA, giftB)
+		case bytes.Equal(kvA.Key[:1], types.KeyChatRewardIndex):
+			var indexA, indexB types.ChatRewardIndex
+
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRewardSnapshot):
+			var snapshotA, snapshotB types.RewardSnapshot
+
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMobileListing):
+			var listingA, listingB types.MobileListing
+
+			cdc.MustUnmarshal(kvA.Value, &listingA)
+			cdc.MustUnmarshal(kvB.Value, &listingB)
+
+			return fmt.Sprintf("%v\n%v", listingA, listingB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixListingBySeller):
+			sellerA, mobileA := types.ParseListingBySellerKey(kvA.Key)
+			sellerB, mobileB := types.ParseListingBySellerKey(kvB.Key)
+
+			return fmt.Sprintf("%s: %s\n%s: %s", sellerA, mobileA, sellerB, mobileB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixListingQueue):
+			return fmt.Sprintf("%s\n%s", types.ParseListingQueueKey(kvA.Key), types.ParseListingQueueKey(kvB.Key))
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBlockedUser):
+			fromA, blockedA := types.ParseBlockedUserKey(kvA.Key)
+			fromB, blockedB := types.ParseBlockedUserKey(kvB.Key)
+
+			return fmt.Sprintf("%s: %s\n%s: %s", fromA, blockedA, fromB, blockedB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMobileOwner):
+			return fmt.Sprintf("%s: %s\n%s: %s", kvA.Key[1:], kvA.Value, kvB.Key[1:], kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRedeemByFrom):
+			fromA, heightA := types.ParseRedeemByFromKey(kvA.Key)
+			fromB, heightB := types.ParseRedeemByFromKey(kvB.Key)
+
+			return fmt.Sprintf("%s: %d\n%s: %d", fromA, heightA, fromB, heightB)
+		default:
+			panic(fmt.Sprintf("invalid chat key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
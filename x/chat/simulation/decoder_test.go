@@ -0,0 +1,75 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"freemasonry.cc/blockchain/x/chat/simulation"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	fromAddress := "from"
+	toAddress := "to"
+	userInfo := types.UserInfo{FromAddress: fromAddress, Mobile: []string{"10000000001"}}
+	entry := types.RedeemEntry{FromAddress: fromAddress, Amount: sdk.NewInt64Coin("att", 1), CompletionHeight: 10}
+	session := types.ChatSession{FromAddress: fromAddress, ToAddress: toAddress, Fee: sdk.NewInt64Coin("att", 1), ExpireHeight: 10}
+	gift := types.Gift{Id: 1, Name: "rose", Price: sdk.NewInt64Coin("att", 1), Enabled: true}
+	listing := types.MobileListing{Mobile: "10000000001", Seller: fromAddress, Price: sdk.NewInt64Coin("att", 1), ExpireHeight: 10}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetRegisterInfoKey(fromAddress), Value: cdc.MustMarshal(&userInfo)},
+			{Key: types.GetRedeemQueueKey(10, fromAddress), Value: cdc.MustMarshal(&entry)},
+			{Key: types.GetChatSessionKey(fromAddress, toAddress), Value: cdc.MustMarshal(&session)},
+			{Key: types.GetChatSessionByToKey(toAddress, fromAddress), Value: []byte{}},
+			{Key: types.GetChatSessionQueueKey(10, fromAddress, toAddress), Value: []byte{}},
+			{Key: types.GetGiftKey(gift.Id), Value: cdc.MustMarshal(&gift)},
+			{Key: types.GetMobileListingKey(listing.Mobile), Value: cdc.MustMarshal(&listing)},
+			{Key: types.GetListingBySellerKey(fromAddress, listing.Mobile), Value: []byte{}},
+			{Key: types.GetListingQueueKey(10, listing.Mobile), Value: []byte{}},
+			{Key: types.GetMobileOwnerKey(listing.Mobile), Value: []byte(fromAddress)},
+			{Key: types.GetBlockedUserKey(fromAddress, toAddress), Value: []byte{}},
+			{Key: types.GetRedeemByFromKey(fromAddress, 10), Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"UserInfo", fmt.Sprintf("%v\n%v", userInfo, userInfo)},
+		{"RedeemEntry", fmt.Sprintf("%v\n%v", entry, entry)},
+		{"ChatSession", fmt.Sprintf("%v\n%v", session, session)},
+		{"ChatSessionByTo", fmt.Sprintf("%s -> %s\n%s -> %s", fromAddress, toAddress, fromAddress, toAddress)},
+		{"ChatSessionQueue", fmt.Sprintf("%s -> %s\n%s -> %s", fromAddress, toAddress, fromAddress, toAddress)},
+		{"Gift", fmt.Sprintf("%v\n%v", gift, gift)},
+		{"MobileListing", fmt.Sprintf("%v\n%v", listing, listing)},
+		{"ListingBySeller", fmt.Sprintf("%s: %s\n%s: %s", fromAddress, listing.Mobile, fromAddress, listing.Mobile)},
+		{"ListingQueue", fmt.Sprintf("%s\n%s", listing.Mobile, listing.Mobile)},
+		{"MobileOwner", fmt.Sprintf("%s: %s\n%s: %s", listing.Mobile, fromAddress, listing.Mobile, fromAddress)},
+		{"BlockedUser", fmt.Sprintf("%s: %s\n%s: %s", fromAddress, toAddress, fromAddress, toAddress)},
+		{"RedeemByFrom", fmt.Sprintf("%s: %d\n%s: %d", fromAddress, 10, fromAddress, 10)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+
+const (
+	minMortgageCoin    = "min_mortgage_coin"
+	chatRewardLog      = "chat_reward_log"
+	maxPhoneNumber     = "max_phone_number"
+	redeemPeriod       = "redeem_period"
+	chatSessionPeriod  = "chat_session_period"
+	rewardPoolFeeShare = "reward_pool_fee_share"
+	mobileMarketFee    = "mobile_market_fee"
+	maxBlockedUsers    = "max_blocked_users"
+	mobilePortFee      = "mobile_port_fee"
+	gifts              = "gifts"
+)
+
+
+func genMinMortgageCoin(r *rand.Rand, initialStake int64) sdk.Coin {
+	amount := initialStake / int64(simtypes.RandIntBetween(r, 10, 1000))
+	if amount <= 0 {
+		amount = 1
+	}
+	return sdk.NewCoin(config.BaseDenom, sdk.NewInt(amount))
+}
+
+
+func genRedeemPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 200))
+}
+
+
+func genChatSessionPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+
+func genMobilePortFee(r *rand.Rand, minMortgage sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(config.BaseDenom, minMortgage.Amount.MulRaw(int64(simtypes.RandIntBetween(r, 0, 4))))
+}
+
+
+func genGifts(r *rand.Rand, minMortgage sdk.Coin) []types.Gift {
+	giftList := make([]types.Gift, 0)
+	for i := 0; i < simtypes.RandIntBetween(r, 1, 5); i++ {
+		giftList = append(giftList, types.Gift{
+			Id:      int64(i + 1),
+			Name:    simtypes.RandStringOfLength(r, 10),
+			Price:   sdk.NewCoin(config.BaseDenom, minMortgage.Amount.MulRaw(int64(simtypes.RandIntBetween(r, 1, 10)))),
+			Enabled: r.Intn(4) != 0,
+		})
+	}
+	return giftList
+}
+
+
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		minMortgage  sdk.Coin
+		rewardLog    []types.ChatReward
+		maxPhone     uint64
+		redeem       int64
+		chatSession  int64
+		poolFeeShare sdk.Dec
+		marketFee    sdk.Dec
+		maxBlocked   uint64
+		portFee      sdk.Coin
+		giftList     []types.Gift
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minMortgageCoin, &minMortgage, simState.Rand,
+		func(r *rand.Rand) { minMortgage = genMinMortgageCoin(r, simState.InitialStake) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, chatRewardLog, &rewardLog, simState.Rand,
+		func(r *rand.Rand) { rewardLog = GenChatRewardLog(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxPhoneNumber, &maxPhone, simState.Rand,
+		func(r *rand.Rand) { maxPhone = GenMaxPhoneNumber(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, redeemPeriod, &redeem, simState.Rand,
+		func(r *rand.Rand) { redeem = genRedeemPeriod(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, chatSessionPeriod, &chatSession, simState.Rand,
+		func(r *rand.Rand) { chatSession = genChatSessionPeriod(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardPoolFeeShare, &poolFeeShare, simState.Rand,
+		func(r *rand.Rand) { poolFeeShare = GenRewardPoolFeeShare(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, mobileMarketFee, &marketFee, simState.Rand,
+		func(r *rand.Rand) { marketFee = GenMobileMarketFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxBlockedUsers, &maxBlocked, simState.Rand,
+		func(r *rand.Rand) { maxBlocked = GenMaxBlockedUsers(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, mobilePortFee, &portFee, simState.Rand,
+		func(r *rand.Rand) { portFee = genMobilePortFee(r, minMortgage) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, gifts, &giftList, simState.Rand,
+		func(r *rand.Rand) { giftList = genGifts(r, minMortgage) },
+	)
+
+	defaultParams := types.DefaultParams()
+	params := types.NewParams(
+		defaultParams.CommunityAddress,
+		defaultParams.EcologicalAddress,
+		minMortgage,
+		rewardLog,
+		maxPhone,
+		redeem,
+		defaultParams.MortgageSplit,
+		chatSession,
+		poolFeeShare,
+		marketFee,
+		maxBlocked,
+		portFee,
+	)
+
+	chatGenesis := types.DefaultGenesisState()
+	chatGenesis.Params = params
+	chatGenesis.Gifts = giftList
+
+	bz, err := json.MarshalIndent(&chatGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated chat parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(chatGenesis)
+}
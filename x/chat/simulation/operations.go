@@ -0,0 +1,808 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/keeper"
+	"freemasonry.cc/blockchain/x/chat/types"
+	commkeeper "freemasonry.cc/blockchain/x/comm/keeper"
+	commtypes "freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+
+const (
+	OpWeightMsgRegister        = "op_weight_msg_register"
+	OpWeightMsgMortgage        = "op_weight_msg_mortgage"
+	OpWeightMsgSetChatFee      = "op_weight_msg_set_chat_fee"
+	OpWeightMsgSendGift        = "op_weight_msg_send_gift"
+	OpWeightMsgAddressBookSave = "op_weight_msg_address_book_save"
+	OpWeightMsgGetRewards      = "op_weight_msg_get_rewards"
+	OpWeightMsgMobileTransfer  = "op_weight_msg_mobile_transfer"
+	OpWeightMsgRedeem          = "op_weight_msg_redeem"
+	OpWeightMsgApplyMobile     = "op_weight_msg_apply_mobile"
+	OpWeightMsgReleaseMobile   = "op_weight_msg_release_mobile"
+	OpWeightMsgPayChat         = "op_weight_msg_pay_chat"
+	OpWeightMsgAcceptChat      = "op_weight_msg_accept_chat"
+	OpWeightMsgListMobile      = "op_weight_msg_list_mobile"
+	OpWeightMsgCancelListing   = "op_weight_msg_cancel_listing"
+	OpWeightMsgBuyMobile       = "op_weight_msg_buy_mobile"
+	OpWeightMsgSendToMobile    = "op_weight_msg_send_to_mobile"
+	OpWeightMsgBlockUser       = "op_weight_msg_block_user"
+	OpWeightMsgUnblockUser     = "op_weight_msg_unblock_user"
+	OpWeightMsgChangeGateway   = "op_weight_msg_change_gateway"
+
+	DefaultWeightMsgRegister        = 80
+	DefaultWeightMsgMortgage        = 40
+	DefaultWeightMsgSetChatFee      = 30
+	DefaultWeightMsgSendGift        = 40
+	DefaultWeightMsgAddressBookSave = 20
+	DefaultWeightMsgGetRewards      = 30
+	DefaultWeightMsgMobileTransfer  = 20
+	DefaultWeightMsgRedeem          = 30
+	DefaultWeightMsgApplyMobile     = 30
+	DefaultWeightMsgReleaseMobile   = 15
+	DefaultWeightMsgPayChat         = 30
+	DefaultWeightMsgAcceptChat      = 30
+	DefaultWeightMsgListMobile      = 20
+	DefaultWeightMsgCancelListing   = 10
+	DefaultWeightMsgBuyMobile       = 20
+	DefaultWeightMsgSendToMobile    = 30
+	DefaultWeightMsgBlockUser       = 10
+	DefaultWeightMsgUnblockUser     = 5
+	DefaultWeightMsgChangeGateway   = 10
+)
+
+
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil,
+			func(_ *rand.Rand) {
+				w = defaultWeight
+			},
+		)
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRegister, DefaultWeightMsgRegister),
+			SimulateMsgRegister(ak, bk, ck, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMortgage, DefaultWeightMsgMortgage),
+			SimulateMsgMortgage(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetChatFee, DefaultWeightMsgSetChatFee),
+			SimulateMsgSetChatFee(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendGift, DefaultWeightMsgSendGift),
+			SimulateMsgSendGift(ak, bk, ck, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddressBookSave, DefaultWeightMsgAddressBookSave),
+			SimulateMsgAddressBookSave(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgGetRewards, DefaultWeightMsgGetRewards),
+			SimulateMsgGetRewards(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMobileTransfer, DefaultWeightMsgMobileTransfer),
+			SimulateMsgMobileTransfer(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRedeem, DefaultWeightMsgRedeem),
+			SimulateMsgRedeem(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgApplyMobile, DefaultWeightMsgApplyMobile),
+			SimulateMsgApplyMobile(ak, bk, ck, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgReleaseMobile, DefaultWeightMsgReleaseMobile),
+			SimulateMsgReleaseMobile(ak, bk, ck, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgPayChat, DefaultWeightMsgPayChat),
+			SimulateMsgPayChat(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAcceptChat, DefaultWeightMsgAcceptChat),
+			SimulateMsgAcceptChat(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgListMobile, DefaultWeightMsgListMobile),
+			SimulateMsgListMobile(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelListing, DefaultWeightMsgCancelListing),
+			SimulateMsgCancelListing(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBuyMobile, DefaultWeightMsgBuyMobile),
+			SimulateMsgBuyMobile(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendToMobile, DefaultWeightMsgSendToMobile),
+			SimulateMsgSendToMobile(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBlockUser, DefaultWeightMsgBlockUser),
+			SimulateMsgBlockUser(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUnblockUser, DefaultWeightMsgUnblockUser),
+			SimulateMsgUnblockUser(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgChangeGateway, DefaultWeightMsgChangeGateway),
+			SimulateMsgChangeGateway(ak, bk, ck, k),
+		),
+	}
+}
+
+
+func SimulateMsgRegister(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if _, err := k.GetRegisterInfo(ctx, simAccount.Address.String()); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegister, "user already registered"), nil, nil
+		}
+
+		gatewayNum, found := randomActiveGatewayNum(r, ctx, ck)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegister, "no active gateway number"), nil, nil
+		}
+
+		amount, ok := randomMortgageAmount(r, ctx, bk, k, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegister, "balance is lower than min mortgage"), nil, nil
+		}
+
+		msg := types.NewMsgRegister(simAccount.Address.String(), gatewayNum.GatewayAddress, gatewayNum.NumberIndex, amount)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(amount))
+	}
+}
+
+
+func SimulateMsgMortgage(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMortgage, "no registered user"), nil, nil
+		}
+
+		amount, ok := randomMortgageAmount(r, ctx, bk, k, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMortgage, "balance is lower than min mortgage"), nil, nil
+		}
+
+		msg := types.NewMsgMortgage(simAccount.Address.String(), userInfo.NodeAddress, amount)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(amount))
+	}
+}
+
+
+func SimulateMsgSetChatFee(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetChatFee, "no registered user"), nil, nil
+		}
+
+		minMortgage := k.GetParams(ctx).MinMortgageCoin.Amount
+		if !minMortgage.IsPositive() {
+			minMortgage = sdk.OneInt()
+		}
+		fee, err := simtypes.RandPositiveInt(r, minMortgage)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetChatFee, "unable to generate chat fee"), nil, err
+		}
+
+		msg := types.NewMsgSetChatFee(simAccount.Address.String(), sdk.NewCoin(config.BaseDenom, fee))
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgSendGift(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		giftList, err := k.GetAllGifts(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "unable to get gifts"), nil, err
+		}
+
+		enabled := make([]types.Gift, 0, len(giftList))
+		for _, gift := range giftList {
+			if gift.Enabled && gift.Price.IsPositive() {
+				enabled = append(enabled, gift)
+			}
+		}
+		if len(enabled) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "no enabled gift"), nil, nil
+		}
+		gift := enabled[r.Intn(len(enabled))]
+
+		gatewayNum, found := randomActiveGatewayNum(r, ctx, ck)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "no active gateway"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		toAccount, _ := simtypes.RandomAcc(r, accs)
+		if k.IsBlocked(ctx, toAccount.Address.String(), simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "sender is blocked by recipient"), nil, nil
+		}
+		giftAmount := int64(simtypes.RandIntBetween(r, 1, 4))
+		giftValueAll := sdk.NewCoin(gift.Price.Denom, gift.Price.Amount.MulRaw(giftAmount))
+
+		if giftValueAll.Amount.LT(k.GetParams(ctx).MinMortgageCoin.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "gift value is lower than min mortgage"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(giftValueAll.Denom).LT(giftValueAll.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendGift, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgSendGift(simAccount.Address.String(), toAccount.Address.String(), gift.Id, giftAmount, gift.Price)
+		msg.NodeAddress = gatewayNum.GatewayAddress
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(giftValueAll))
+	}
+}
+
+
+func SimulateMsgAddressBookSave(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddressBookSave, "no registered user"), nil, nil
+		}
+
+		addressBook := make([]string, 0)
+		for i := 0; i < r.Intn(5); i++ {
+			account, _ := simtypes.RandomAcc(r, accs)
+			addressBook = append(addressBook, account.Address.String())
+		}
+
+		msg := types.NewMsgAddressBookSave(simAccount.Address.String(), addressBook)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgGetRewards(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGetRewards, "no registered user"), nil, nil
+		}
+
+		reward, err := k.CaculateChatReward(ctx, userInfo)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGetRewards, "unable to calculate reward"), nil, err
+		}
+		if reward.IsPositive() && k.GetRewardPoolBalance(ctx).IsLT(reward) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGetRewards, "reward pool is insufficient"), nil, nil
+		}
+
+		msg := types.NewMsgGetRewards(simAccount.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgMobileTransfer(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || len(userInfo.Mobile) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMobileTransfer, "no user holding a mobile"), nil, nil
+		}
+
+		toAccount, toUserInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || toAccount.Equals(simAccount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMobileTransfer, "no registered recipient"), nil, nil
+		}
+		if uint64(len(toUserInfo.Mobile)) >= k.GetParams(ctx).MaxPhoneNumber {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMobileTransfer, "recipient holds max phone numbers"), nil, nil
+		}
+		if k.IsBlocked(ctx, toAccount.Address.String(), simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMobileTransfer, "sender is blocked by recipient"), nil, nil
+		}
+
+		mobile := userInfo.Mobile[r.Intn(len(userInfo.Mobile))]
+		if k.HasMobileListing(ctx, mobile) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMobileTransfer, "mobile is listed for sale"), nil, nil
+		}
+
+		msg := types.NewMsgMobileTransfer(simAccount.Address.String(), toAccount.Address.String(), mobile)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgRedeem(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeem, "no registered user"), nil, nil
+		}
+
+		if userInfo.CanRedemAmount.Denom != config.BaseDenom || !userInfo.CanRedemAmount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeem, "nothing to redeem"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, userInfo.CanRedemAmount.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeem, "unable to generate redeem amount"), nil, err
+		}
+
+		msg := types.NewMsgRedeem(simAccount.Address.String(), sdk.NewCoin(config.BaseDenom, amount))
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgApplyMobile(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgApplyMobile, "no registered user"), nil, nil
+		}
+		if uint64(len(userInfo.Mobile)) >= k.GetParams(ctx).MaxPhoneNumber {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgApplyMobile, "user holds max phone numbers"), nil, nil
+		}
+
+		gatewayNum, found := randomActiveGatewayNum(r, ctx, ck)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgApplyMobile, "no active gateway number"), nil, nil
+		}
+
+		msg := types.NewMsgApplyMobile(simAccount.Address.String(), gatewayNum.GatewayAddress, gatewayNum.NumberIndex)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgReleaseMobile(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || len(userInfo.Mobile) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseMobile, "no user holding a mobile"), nil, nil
+		}
+
+		mobile := userInfo.Mobile[r.Intn(len(userInfo.Mobile))]
+		if len(mobile) <= types.MobileSuffixLength {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseMobile, "invalid mobile"), nil, nil
+		}
+		if k.HasMobileListing(ctx, mobile) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseMobile, "mobile is listed for sale"), nil, nil
+		}
+
+		gatewayNum, err := ck.GetGatewayNumByMobile(ctx, mobile)
+		if err != nil || gatewayNum == nil || !containsString(gatewayNum.NumberEnd, mobile) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseMobile, "mobile is not issued by a gateway"), nil, nil
+		}
+
+		msg := types.NewMsgReleaseMobile(simAccount.Address.String(), mobile)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgPayChat(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		toAccount, toUserInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "no registered user"), nil, nil
+		}
+		if toUserInfo.ChatFee.Denom != config.BaseDenom || !toUserInfo.ChatFee.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "recipient chat is free"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if simAccount.Equals(toAccount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "cannot pay chat to self"), nil, nil
+		}
+		if k.HasChatSession(ctx, simAccount.Address.String(), toAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "chat session already exists"), nil, nil
+		}
+		if k.IsBlocked(ctx, toAccount.Address.String(), simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "sender is blocked by recipient"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(config.BaseDenom).LT(toUserInfo.ChatFee.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPayChat, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgPayChat(simAccount.Address.String(), toAccount.Address.String(), toUserInfo.ChatFee)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(toUserInfo.ChatFee))
+	}
+}
+
+
+func SimulateMsgAcceptChat(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sessions, err := k.GetAllChatSessions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptChat, "unable to get chat sessions"), nil, err
+		}
+		if len(sessions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptChat, "no chat session"), nil, nil
+		}
+		session := sessions[r.Intn(len(sessions))]
+
+		toAddress, err := sdk.AccAddressFromBech32(session.ToAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptChat, "invalid recipient address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, toAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptChat, "recipient is not a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgAcceptChat(session.ToAddress, session.FromAddress)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgListMobile(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || len(userInfo.Mobile) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgListMobile, "no user holding a mobile"), nil, nil
+		}
+
+		mobile := userInfo.Mobile[r.Intn(len(userInfo.Mobile))]
+		if len(mobile) <= types.MobileSuffixLength {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgListMobile, "invalid mobile"), nil, nil
+		}
+		if k.HasMobileListing(ctx, mobile) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgListMobile, "mobile already listed"), nil, nil
+		}
+
+		price := sdk.NewCoin(config.BaseDenom, k.GetParams(ctx).MinMortgageCoin.Amount.MulRaw(int64(simtypes.RandIntBetween(r, 1, 10))))
+
+		var expireHeight int64
+		if r.Intn(2) == 0 {
+			expireHeight = ctx.BlockHeight() + int64(simtypes.RandIntBetween(r, 1, 100))
+		}
+
+		msg := types.NewMsgListMobile(simAccount.Address.String(), mobile, price, expireHeight)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgCancelListing(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		listing, simAccount, found := randomMobileListing(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelListing, "no mobile listing"), nil, nil
+		}
+
+		msg := types.NewMsgCancelListing(listing.Seller, listing.Mobile)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgBuyMobile(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		listing, sellerAccount, found := randomMobileListing(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyMobile, "no mobile listing"), nil, nil
+		}
+		if listing.ExpireHeight != 0 && listing.ExpireHeight <= ctx.BlockHeight() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyMobile, "mobile listing expired"), nil, nil
+		}
+
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || simAccount.Equals(sellerAccount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyMobile, "no registered buyer"), nil, nil
+		}
+		if uint64(len(userInfo.Mobile)) >= k.GetParams(ctx).MaxPhoneNumber {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyMobile, "buyer holds max phone numbers"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(config.BaseDenom).LT(listing.Price.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyMobile, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgBuyMobile(simAccount.Address.String(), listing.Mobile, listing.Price)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(listing.Price))
+	}
+}
+
+
+func SimulateMsgSendToMobile(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		toAccount, toUserInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found || len(toUserInfo.Mobile) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToMobile, "no user holding a mobile"), nil, nil
+		}
+
+		mobile := toUserInfo.Mobile[r.Intn(len(toUserInfo.Mobile))]
+		if _, err := k.ResolveMobileOwner(ctx, mobile); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToMobile, "mobile is not assigned"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if simAccount.Equals(toAccount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToMobile, "cannot send to own mobile"), nil, nil
+		}
+		if k.IsBlocked(ctx, toAccount.Address.String(), simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToMobile, "sender is blocked by recipient"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(config.BaseDenom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToMobile, "insufficient funds"), nil, nil
+		}
+
+		coin := sdk.NewCoin(config.BaseDenom, amount)
+		msg := types.NewMsgSendToMobile(simAccount.Address.String(), mobile, coin)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(coin))
+	}
+}
+
+
+func SimulateMsgBlockUser(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBlockUser, "no registered user"), nil, nil
+		}
+		if uint64(len(k.GetBlockedUsers(ctx, simAccount.Address.String()))) >= k.GetParams(ctx).MaxBlockedUsers {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBlockUser, "blocked users limit reached"), nil, nil
+		}
+
+		blockedAccount, _ := simtypes.RandomAcc(r, accs)
+		if blockedAccount.Equals(simAccount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBlockUser, "cannot block self"), nil, nil
+		}
+		if k.IsBlocked(ctx, simAccount.Address.String(), blockedAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBlockUser, "user already blocked"), nil, nil
+		}
+
+		msg := types.NewMsgBlockUser(simAccount.Address.String(), blockedAccount.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgUnblockUser(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		blockLists := k.GetAllBlockLists(ctx)
+		if len(blockLists) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnblockUser, "no blocked user"), nil, nil
+		}
+
+		blockList := blockLists[r.Intn(len(blockLists))]
+		address, err := sdk.AccAddressFromBech32(blockList.FromAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnblockUser, "invalid address"), nil, nil
+		}
+		simAccount, found := simtypes.FindAccount(accs, address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnblockUser, "account not found"), nil, nil
+		}
+
+		blockedAddress := blockList.Blocked[r.Intn(len(blockList.Blocked))]
+		msg := types.NewMsgUnblockUser(simAccount.Address.String(), blockedAddress)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+
+func SimulateMsgChangeGateway(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, ck commkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, userInfo, found := randomRegisteredAccount(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "no registered user"), nil, nil
+		}
+
+		gatewayNum, found := randomActiveGatewayNum(r, ctx, ck)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "no active gateway number"), nil, nil
+		}
+		if gatewayNum.GatewayAddress == userInfo.NodeAddress {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "user already bound to gateway"), nil, nil
+		}
+
+		ported := 0
+		for _, mobile := range userInfo.Mobile {
+			if k.HasMobileListing(ctx, mobile) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "mobile is listed for sale"), nil, nil
+			}
+			hosting, err := ck.GetGatewayNumByMobile(ctx, mobile)
+			if err != nil || hosting == nil || !containsString(hosting.NumberEnd, mobile) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "mobile is not issued by a gateway"), nil, nil
+			}
+			if hosting.NumberIndex != gatewayNum.NumberIndex {
+				ported++
+			}
+		}
+		if len(gatewayNum.NumberEnd)+len(userInfo.Mobile) > types.MobileSuffixMax {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "gateway number segment is full"), nil, nil
+		}
+
+		portMobile := r.Intn(2) == 0
+		spent := sdk.NewCoins()
+		if portMobile {
+			params := k.GetParams(ctx)
+			fee := sdk.NewCoin(params.MobilePortFee.Denom, params.MobilePortFee.Amount.MulRaw(int64(ported)))
+			if fee.IsPositive() {
+				if fee.Amount.LT(params.MinMortgageCoin.Amount) {
+					return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "port fee is lower than min mortgage"), nil, nil
+				}
+				spendable := bk.SpendableCoins(ctx, simAccount.Address)
+				if spendable.AmountOf(fee.Denom).LT(fee.Amount) {
+					return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeGateway, "insufficient funds"), nil, nil
+				}
+				spent = sdk.NewCoins(fee)
+			}
+		}
+
+		msg := types.NewMsgChangeGateway(simAccount.Address.String(), gatewayNum.GatewayAddress, gatewayNum.NumberIndex, portMobile)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, spent)
+	}
+}
+
+
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+
+func randomRegisteredAccount(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, types.UserInfo, bool) {
+	userInfos, err := k.GetAllRegisterInfo(ctx)
+	if err != nil || len(userInfos) == 0 {
+		return simtypes.Account{}, types.UserInfo{}, false
+	}
+
+	userInfo := userInfos[r.Intn(len(userInfos))]
+	address, err := sdk.AccAddressFromBech32(userInfo.FromAddress)
+	if err != nil {
+		return simtypes.Account{}, types.UserInfo{}, false
+	}
+
+	simAccount, found := simtypes.FindAccount(accs, address)
+	return simAccount, userInfo, found
+}
+
+
+func randomMobileListing(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.MobileListing, simtypes.Account, bool) {
+	listings, err := k.GetAllMobileListings(ctx)
+	if err != nil || len(listings) == 0 {
+		return types.MobileListing{}, simtypes.Account{}, false
+	}
+
+	listing := listings[r.Intn(len(listings))]
+	address, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return types.MobileListing{}, simtypes.Account{}, false
+	}
+
+	simAccount, found := simtypes.FindAccount(accs, address)
+	return listing, simAccount, found
+}
+
+
+func randomActiveGatewayNum(r *rand.Rand, ctx sdk.Context, ck commkeeper.Keeper) (commtypes.GatewayNumIndex, bool) {
+	gatewayNumList, err := ck.GetGatewayNumList(ctx)
+	if err != nil {
+		return commtypes.GatewayNumIndex{}, false
+	}
+
+	active := make([]commtypes.GatewayNumIndex, 0, len(gatewayNumList))
+	for _, gatewayNum := range gatewayNumList {
+		if gatewayNum.Status == 0 && gatewayNum.GatewayAddress != "" && len(gatewayNum.NumberEnd) < types.MobileSuffixMax && ck.IsGatewayActive(ctx, gatewayNum.GatewayAddress) {
+			active = append(active, gatewayNum)
+		}
+	}
+	if len(active) == 0 {
+		return commtypes.GatewayNumIndex{}, false
+	}
+	return active[r.Intn(len(active))], true
+}
+
+
+func randomMortgageAmount(r *rand.Rand, ctx sdk.Context, bk bankkeeper.Keeper, k keeper.Keeper, address sdk.AccAddress) (sdk.Coin, bool) {
+	minMortgage := k.GetParams(ctx).MinMortgageCoin.Amount
+	balance := bk.SpendableCoins(ctx, address).AmountOf(config.BaseDenom)
+	if !balance.IsPositive() || balance.LT(minMortgage) {
+		return sdk.Coin{}, false
+	}
+
+	amount := minMortgage.Add(simtypes.RandomAmount(r, balance.Sub(minMortgage)))
+	if !amount.IsPositive() {
+		return sdk.Coin{}, false
+	}
+	return sdk.NewCoin(config.BaseDenom, amount), true
+}
+
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
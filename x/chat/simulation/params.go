@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/chat/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+var amino = codec.NewLegacyAmino()
+
+
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCoin),
+			func(r *rand.Rand) string {
+				return string(amino.MustMarshalJSON(GenMinMortgageCoin(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyChatRewardLog),
+			func(r *rand.Rand) string {
+				return string(amino.MustMarshalJSON(GenChatRewardLog(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxPhoneNumber),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxPhoneNumber(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRedeemPeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenRedeemPeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyChatSessionPeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenChatSessionPeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardPoolFeeShare),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRewardPoolFeeShare(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMobileMarketFee),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMobileMarketFee(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxBlockedUsers),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxBlockedUsers(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMobilePortFee),
+			func(r *rand.Rand) string {
+				return string(amino.MustMarshalJSON(GenMobilePortFee(r)))
+			},
+		),
+	}
+}
+
+
+func GenMinMortgageCoin(r *rand.Rand) sdk.Coin {
+	return sdk.NewCoin(config.BaseDenom, sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000))).Mul(sdk.NewInt(1000000000000000)))
+}
+
+
+func GenChatRewardLog(r *rand.Rand) []types.ChatReward {
+	chatRewardLog := make([]types.ChatReward, 0)
+	height := int64(1)
+	for i := 0; i < simtypes.RandIntBetween(r, 1, 5); i++ {
+		chatRewardLog = append(chatRewardLog, types.ChatReward{
+			Height: height,
+			Value:  sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10001)), 4).String(),
+		})
+		height += int64(simtypes.RandIntBetween(r, 1, 100000))
+	}
+	return chatRewardLog
+}
+
+
+func GenMaxPhoneNumber(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+
+func GenRedeemPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 200000))
+}
+
+
+func GenChatSessionPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 50000))
+}
+
+
+func GenRewardPoolFeeShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2)
+}
+
+
+func GenMobileMarketFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 21)), 2)
+}
+
+
+func GenMaxBlockedUsers(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+
+func GenMobilePortFee(r *rand.Rand) sdk.Coin {
+	return sdk.NewCoin(config.BaseDenom, sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 1000))).Mul(sdk.NewInt(1000000000000000)))
+}
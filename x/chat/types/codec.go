@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 
@@ -18,6 +19,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddressBookSave{}, MsgTypeAddressBookSave, nil)
 	cdc.RegisterConcrete(&MsgGetRewards{}, MsgTypeGetRewards, nil)
 	cdc.RegisterConcrete(&MsgMobileTransfer{}, MsgTypeMobileTransfer, nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, MsgTypeRedeem, nil)
+	cdc.RegisterConcrete(&MsgApplyMobile{}, MsgTypeApplyMobile, nil)
+	cdc.RegisterConcrete(&MsgReleaseMobile{}, MsgTypeReleaseMobile, nil)
+	cdc.RegisterConcrete(&MsgPayChat{}, MsgTypePayChat, nil)
+	cdc.RegisterConcrete(&MsgAcceptChat{}, MsgTypeAcceptChat, nil)
+	cdc.RegisterConcrete(&MsgListMobile{}, MsgTypeListMobile, nil)
+	cdc.RegisterConcrete(&MsgCancelListing{}, MsgTypeCancelListing, nil)
+	cdc.RegisterConcrete(&MsgBuyMobile{}, MsgTypeBuyMobile, nil)
+	cdc.RegisterConcrete(&MsgSendToMobile{}, MsgTypeSendToMobile, nil)
+	cdc.RegisterConcrete(&MsgBlockUser{}, MsgTypeBlockUser, nil)
+	cdc.RegisterConcrete(&MsgUnblockUser{}, MsgTypeUnblockUser, nil)
+	cdc.RegisterConcrete(&MsgChangeGateway{}, MsgTypeChangeGateway, nil)
 }
 
 
@@ -30,6 +43,22 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAddressBookSave{},
 		&MsgGetRewards{},
 		&MsgMobileTransfer{},
+		&MsgRedeem{},
+		&MsgApplyMobile{},
+		&MsgReleaseMobile{},
+		&MsgPayChat{},
+		&MsgAcceptChat{},
+		&MsgListMobile{},
+		&MsgCancelListing{},
+		&MsgBuyMobile{},
+		&MsgSendToMobile{},
+		&MsgBlockUser{},
+		&MsgUnblockUser{},
+		&MsgChangeGateway{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetGiftProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
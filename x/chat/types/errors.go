@@ -26,4 +26,31 @@ var (
 	ErrSetMortgageLog       = sdkerrors.Register(ModuleName, 120, "error set mortgage log")
 	ErrGetMortgageLog       = sdkerrors.Register(ModuleName, 121, "error get mortgage log")
 	ErrUserNotHaveMobile    = sdkerrors.Register(ModuleName, 122, "user not have mobile")
+	ErrRedeemAmount         = sdkerrors.Register(ModuleName, 123, "redeem amount error")
+	ErrSetRedeemQueue       = sdkerrors.Register(ModuleName, 124, "error set redeem queue")
+	ErrMortgageSplit        = sdkerrors.Register(ModuleName, 125, "mortgage split error")
+	ErrMaxPhoneNumber       = sdkerrors.Register(ModuleName, 126, "phone number limit reached")
+	ErrReleaseMobile        = sdkerrors.Register(ModuleName, 127, "error release mobile")
+	ErrAddressBook          = sdkerrors.Register(ModuleName, 128, "error address book")
+	ErrChatFee              = sdkerrors.Register(ModuleName, 129, "chat fee mismatch")
+	ErrChatFree             = sdkerrors.Register(ModuleName, 130, "user does not charge a chat fee")
+	ErrChatSessionExists    = sdkerrors.Register(ModuleName, 131, "chat session already exists")
+	ErrChatSessionNotFound  = sdkerrors.Register(ModuleName, 132, "chat session not found")
+	ErrGiftNotFound         = sdkerrors.Register(ModuleName, 133, "gift not found")
+	ErrGiftDisabled         = sdkerrors.Register(ModuleName, 134, "gift disabled")
+	ErrGiftPrice            = sdkerrors.Register(ModuleName, 135, "gift price mismatch")
+	ErrRewardPoolEmpty      = sdkerrors.Register(ModuleName, 136, "chat reward pool insufficient")
+	ErrMobileListed         = sdkerrors.Register(ModuleName, 137, "mobile is listed for sale")
+	ErrListingNotFound      = sdkerrors.Register(ModuleName, 138, "mobile listing not found")
+	ErrListingPrice         = sdkerrors.Register(ModuleName, 139, "mobile listing price mismatch")
+	ErrListingExpired       = sdkerrors.Register(ModuleName, 140, "mobile listing expired")
+	ErrBuyOwnMobile         = sdkerrors.Register(ModuleName, 141, "cannot buy own mobile")
+	ErrMobileNotAssigned    = sdkerrors.Register(ModuleName, 142, "mobile is not assigned to a user")
+	ErrUserBlocked          = sdkerrors.Register(ModuleName, 143, "sender is blocked by recipient")
+	ErrUserAlreadyBlocked   = sdkerrors.Register(ModuleName, 144, "user already blocked")
+	ErrUserNotBlocked       = sdkerrors.Register(ModuleName, 145, "user not blocked")
+	ErrMaxBlockedUsers      = sdkerrors.Register(ModuleName, 146, "blocked users limit reached")
+	ErrGatewayUnchanged     = sdkerrors.Register(ModuleName, 147, "user already bound to gateway")
+	ErrGatewayInactive      = sdkerrors.Register(ModuleName, 148, "gateway is inactive")
+	ErrMobileHeldByGateway  = sdkerrors.Register(ModuleName, 149, "mobile is held by gateway")
 )
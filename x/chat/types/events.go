@@ -40,6 +40,7 @@ const (
 	SendGiftEventTypeToAddress    = "send_gift_to_address"
 	SendGiftEventTypeGateAddress  = "send_gift_gate_address"
 	SendGiftEventTypeGiftId       = "send_gift_gift_id"
+	SendGiftEventTypeGiftName     = "send_gift_gift_name"
 	SendGiftEventTypeGiftValue    = "send_gift_gift_value"
 	SendGiftEventTypeGiftDenom    = "send_gift_gift_denom"
 	SendGiftEventTypeGiftAmount   = "send_gift_gift_amount"
@@ -52,6 +53,86 @@ const (
 	GetRewardEventTypeMortgageAmountAdd = "get_rewards_mortgage_amount_add"
 	GetRewardEventTypeMortgageAmountNew = "get_rewards_mortgage_amount_new"
 	GetRewardEventTypeDenom             = "get_rewards_denom"
+
+
+
+	MobileEventTypeFromAddress = "mobile_from_address"
+	MobileEventTypeToAddress   = "mobile_to_address"
+	MobileEventTypeNodeAddress = "mobile_node_address"
+	MobileEventTypeMobile      = "mobile_mobile"
+
+
+
+	EventTypeChatSessionExpire = "chat_session_expire"
+
+	ChatSessionEventTypeFromAddress  = "chat_session_from_address"
+	ChatSessionEventTypeToAddress    = "chat_session_to_address"
+	ChatSessionEventTypeAmount       = "chat_session_amount"
+	ChatSessionEventTypeDenom        = "chat_session_denom"
+	ChatSessionEventTypeExpireHeight = "chat_session_expire_height"
+
+
+
+	EventTypeSetGift = "set_gift"
+
+	GiftEventTypeId      = "gift_id"
+	GiftEventTypeName    = "gift_name"
+	GiftEventTypePrice   = "gift_price"
+	GiftEventTypeEnabled = "gift_enabled"
+
+
+
+	EventTypeFundRewardPool = "fund_reward_pool"
+
+	RewardPoolEventTypeAmount = "reward_pool_amount"
+	RewardPoolEventTypeDenom  = "reward_pool_denom"
+
+
+
+	EventTypeRedeemComplete = "redeem_complete"
+
+	RedeemEventTypeFromAddress      = "redeem_from_address"
+	RedeemEventTypeAmount           = "redeem_amount"
+	RedeemEventTypeDenom            = "redeem_denom"
+	RedeemEventTypeCompletionHeight = "redeem_completion_height"
+
+
+
+	EventTypeListingExpire = "mobile_listing_expire"
+
+	ListingEventTypeMobile       = "listing_mobile"
+	ListingEventTypeSeller       = "listing_seller"
+	ListingEventTypeBuyer        = "listing_buyer"
+	ListingEventTypeAmount       = "listing_amount"
+	ListingEventTypeDenom        = "listing_denom"
+	ListingEventTypeFee          = "listing_fee"
+	ListingEventTypeExpireHeight = "listing_expire_height"
+
+
+
+	EventTypeSendToMobile = "send_to_mobile"
+
+	SendToMobileEventTypeFromAddress = "send_to_mobile_from_address"
+	SendToMobileEventTypeToAddress   = "send_to_mobile_to_address"
+	SendToMobileEventTypeMobile      = "send_to_mobile_mobile"
+	SendToMobileEventTypeAmount      = "send_to_mobile_amount"
+
+
+
+	BlockEventTypeFromAddress    = "block_from_address"
+	BlockEventTypeBlockedAddress = "block_blocked_address"
+
+
+
+	EventTypeChangeGatewayMobile = "change_gateway_mobile"
+
+	ChangeGatewayEventTypeFromAddress    = "change_gateway_from_address"
+	ChangeGatewayEventTypeOldNodeAddress = "change_gateway_old_node_address"
+	ChangeGatewayEventTypeNodeAddress    = "change_gateway_node_address"
+	ChangeGatewayEventTypePortMobile     = "change_gateway_port_mobile"
+	ChangeGatewayEventTypeFee            = "change_gateway_fee"
+	ChangeGatewayEventTypeOldMobile      = "change_gateway_old_mobile"
+	ChangeGatewayEventTypeMobile         = "change_gateway_mobile"
 )
 
 
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 
 func NewGenesisState(params Params) GenesisState {
 	return GenesisState{
@@ -11,13 +17,147 @@ func NewGenesisState(params Params) GenesisState {
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:          DefaultParams(),
+		ChatRewardIndex: ChatRewardIndex{Index: sdk.ZeroDec()},
 	}
 }
 
 
 
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	userAddresses := make(map[string]bool)
+	mobileOwners := make(map[string]string)
+	for _, userInfo := range gs.UserInfos {
+		if _, err := sdk.AccAddressFromBech32(userInfo.FromAddress); err != nil {
+			return fmt.Errorf("invalid user address %s: %w", userInfo.FromAddress, err)
+		}
+		if userAddresses[userInfo.FromAddress] {
+			return fmt.Errorf("duplicate user info for address %s", userInfo.FromAddress)
+		}
+		userAddresses[userInfo.FromAddress] = true
+
+		for _, mobile := range userInfo.Mobile {
+			if owner, ok := mobileOwners[mobile]; ok {
+				return fmt.Errorf("duplicate mobile %s held by %s and %s", mobile, owner, userInfo.FromAddress)
+			}
+			mobileOwners[mobile] = userInfo.FromAddress
+		}
+	}
+
+	if !gs.ChatRewardIndex.Index.IsNil() && gs.ChatRewardIndex.Index.IsNegative() {
+		return fmt.Errorf("invalid chat reward index %s", gs.ChatRewardIndex.Index)
+	}
+
+	snapshotAddresses := make(map[string]bool)
+	for _, record := range gs.RewardSnapshots {
+		if _, err := sdk.AccAddressFromBech32(record.FromAddress); err != nil {
+			return fmt.Errorf("invalid reward snapshot address %s: %w", record.FromAddress, err)
+		}
+		if snapshotAddresses[record.FromAddress] {
+			return fmt.Errorf("duplicate reward snapshot for address %s", record.FromAddress)
+		}
+		snapshotAddresses[record.FromAddress] = true
+		if record.Snapshot.Index.IsNil() || record.Snapshot.Index.IsNegative() {
+			return fmt.Errorf("invalid reward snapshot index %s for address %s", record.Snapshot.Index, record.FromAddress)
+		}
+		if record.Snapshot.Pending.IsNil() || record.Snapshot.Pending.IsNegative() {
+			return fmt.Errorf("invalid reward snapshot pending %s for address %s", record.Snapshot.Pending, record.FromAddress)
+		}
+	}
+
+	for _, entry := range gs.RedeemQueue {
+		if _, err := sdk.AccAddressFromBech32(entry.FromAddress); err != nil {
+			return fmt.Errorf("invalid redeem address %s: %w", entry.FromAddress, err)
+		}
+		if !entry.Amount.IsValid() || entry.Amount.IsZero() {
+			return fmt.Errorf("invalid redeem amount %s for address %s", entry.Amount, entry.FromAddress)
+		}
+	}
+
+	addressBookAddresses := make(map[string]bool)
+	for _, addressBook := range gs.AddressBooks {
+		if _, err := sdk.AccAddressFromBech32(addressBook.FromAddress); err != nil {
+			return fmt.Errorf("invalid address book address %s: %w", addressBook.FromAddress, err)
+		}
+		if addressBookAddresses[addressBook.FromAddress] {
+			return fmt.Errorf("duplicate address book for address %s", addressBook.FromAddress)
+		}
+		addressBookAddresses[addressBook.FromAddress] = true
+	}
+
+	chatSessions := make(map[string]bool)
+	for _, session := range gs.ChatSessions {
+		if _, err := sdk.AccAddressFromBech32(session.FromAddress); err != nil {
+			return fmt.Errorf("invalid chat session sender %s: %w", session.FromAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(session.ToAddress); err != nil {
+			return fmt.Errorf("invalid chat session recipient %s: %w", session.ToAddress, err)
+		}
+		if !session.Fee.IsValid() || session.Fee.IsZero() {
+			return fmt.Errorf("invalid chat session fee %s from %s", session.Fee, session.FromAddress)
+		}
+		sessionKey := session.FromAddress + "/" + session.ToAddress
+		if chatSessions[sessionKey] {
+			return fmt.Errorf("duplicate chat session from %s to %s", session.FromAddress, session.ToAddress)
+		}
+		chatSessions[sessionKey] = true
+	}
+
+	if err := ValidateGifts(gs.Gifts); err != nil {
+		return err
+	}
+
+	listedMobiles := make(map[string]bool)
+	for _, listing := range gs.MobileListings {
+		if _, err := sdk.AccAddressFromBech32(listing.Seller); err != nil {
+			return fmt.Errorf("invalid mobile listing seller %s: %w", listing.Seller, err)
+		}
+		if mobileOwners[listing.Mobile] != listing.Seller {
+			return fmt.Errorf("mobile listing %s is not held by seller %s", listing.Mobile, listing.Seller)
+		}
+		if listedMobiles[listing.Mobile] {
+			return fmt.Errorf("duplicate mobile listing %s", listing.Mobile)
+		}
+		listedMobiles[listing.Mobile] = true
+		if !listing.Price.IsValid() || listing.Price.IsZero() {
+			return fmt.Errorf("invalid mobile listing price %s for mobile %s", listing.Price, listing.Mobile)
+		}
+		if listing.ExpireHeight < 0 {
+			return fmt.Errorf("invalid mobile listing expire height %d for mobile %s", listing.ExpireHeight, listing.Mobile)
+		}
+	}
+
+	blockListAddresses := make(map[string]bool)
+	for _, blockList := range gs.BlockLists {
+		if _, err := sdk.AccAddressFromBech32(blockList.FromAddress); err != nil {
+			return fmt.Errorf("invalid block list address %s: %w", blockList.FromAddress, err)
+		}
+		if blockListAddresses[blockList.FromAddress] {
+			return fmt.Errorf("duplicate block list for address %s", blockList.FromAddress)
+		}
+		blockListAddresses[blockList.FromAddress] = true
+		if uint64(len(blockList.Blocked)) > gs.Params.MaxBlockedUsers {
+			return fmt.Errorf("block list of %s exceeds max blocked users %d", blockList.FromAddress, gs.Params.MaxBlockedUsers)
+		}
+
+		blocked := make(map[string]bool)
+		for _, address := range blockList.Blocked {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return fmt.Errorf("invalid blocked address %s: %w", address, err)
+			}
+			if address == blockList.FromAddress {
+				return fmt.Errorf("address %s cannot block itself", address)
+			}
+			if blocked[address] {
+				return fmt.Errorf("duplicate blocked address %s for %s", address, blockList.FromAddress)
+			}
+			blocked[address] = true
+		}
+	}
 
 	return nil
 }
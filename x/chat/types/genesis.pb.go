@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -28,10 +29,28 @@ const _ = proto.GoGoProtoPackageIsVersion3
 
 type GenesisState struct {
 
-	Params               Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+
+	UserInfos []UserInfo `protobuf:"bytes,2,rep,name=user_infos,json=userInfos,proto3" json:"user_infos"`
+
+	RedeemQueue []RedeemEntry `protobuf:"bytes,5,rep,name=redeem_queue,json=redeemQueue,proto3" json:"redeem_queue"`
+
+	AddressBooks []AddressBook `protobuf:"bytes,6,rep,name=address_books,json=addressBooks,proto3" json:"address_books"`
+
+	ChatSessions []ChatSession `protobuf:"bytes,7,rep,name=chat_sessions,json=chatSessions,proto3" json:"chat_sessions"`
+
+	Gifts []Gift `protobuf:"bytes,8,rep,name=gifts,proto3" json:"gifts"`
+
+	ChatRewardIndex ChatRewardIndex `protobuf:"bytes,9,opt,name=chat_reward_index,json=chatRewardIndex,proto3" json:"chat_reward_index"`
+
+	RewardSnapshots []RewardSnapshotRecord `protobuf:"bytes,10,rep,name=reward_snapshots,json=rewardSnapshots,proto3" json:"reward_snapshots"`
+
+	MobileListings []MobileListing `protobuf:"bytes,11,rep,name=mobile_listings,json=mobileListings,proto3" json:"mobile_listings"`
+
+	BlockLists           []BlockList `protobuf:"bytes,12,rep,name=block_lists,json=blockLists,proto3" json:"block_lists"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +93,124 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetUserInfos() []UserInfo {
+	if m != nil {
+		return m.UserInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedeemQueue() []RedeemEntry {
+	if m != nil {
+		return m.RedeemQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressBooks() []AddressBook {
+	if m != nil {
+		return m.AddressBooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChatSessions() []ChatSession {
+	if m != nil {
+		return m.ChatSessions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGifts() []Gift {
+	if m != nil {
+		return m.Gifts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChatRewardIndex() ChatRewardIndex {
+	if m != nil {
+		return m.ChatRewardIndex
+	}
+	return ChatRewardIndex{}
+}
+
+func (m *GenesisState) GetRewardSnapshots() []RewardSnapshotRecord {
+	if m != nil {
+		return m.RewardSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMobileListings() []MobileListing {
+	if m != nil {
+		return m.MobileListings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockLists() []BlockList {
+	if m != nil {
+		return m.BlockLists
+	}
+	return nil
+}
+
+type RewardSnapshotRecord struct {
+	FromAddress          string         `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Snapshot             RewardSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RewardSnapshotRecord) Reset()         { *m = RewardSnapshotRecord{} }
+func (m *RewardSnapshotRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardSnapshotRecord) ProtoMessage()    {}
+func (*RewardSnapshotRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14205810582f3203, []int{1}
+}
+func (m *RewardSnapshotRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSnapshotRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSnapshotRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSnapshotRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSnapshotRecord.Merge(m, src)
+}
+func (m *RewardSnapshotRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSnapshotRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSnapshotRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSnapshotRecord proto.InternalMessageInfo
+
+func (m *RewardSnapshotRecord) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *RewardSnapshotRecord) GetSnapshot() RewardSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return RewardSnapshot{}
+}
+
 
 type Params struct {
 
@@ -85,17 +222,31 @@ type Params struct {
 
 	ChatRewardLog []ChatReward `protobuf:"bytes,4,rep,name=chatRewardLog,proto3" json:"chatRewardLog"`
 
-	MaxPhoneNumber       uint64   `protobuf:"varint,5,opt,name=maxPhoneNumber,proto3" json:"maxPhoneNumber,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxPhoneNumber uint64 `protobuf:"varint,5,opt,name=maxPhoneNumber,proto3" json:"maxPhoneNumber,omitempty"`
+
+	RedeemPeriod int64 `protobuf:"varint,6,opt,name=redeemPeriod,proto3" json:"redeemPeriod,omitempty"`
+
+	MortgageSplit []MortgageRecipient `protobuf:"bytes,7,rep,name=mortgageSplit,proto3" json:"mortgageSplit"`
+
+	ChatSessionPeriod int64 `protobuf:"varint,8,opt,name=chatSessionPeriod,proto3" json:"chatSessionPeriod,omitempty"`
+
+	RewardPoolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=rewardPoolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewardPoolFeeShare"`
+
+	MobileMarketFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=mobileMarketFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mobileMarketFee"`
+
+	MaxBlockedUsers uint64 `protobuf:"varint,11,opt,name=maxBlockedUsers,proto3" json:"maxBlockedUsers,omitempty"`
+
+	MobilePortFee        types.Coin `protobuf:"bytes,12,opt,name=mobilePortFee,proto3" json:"mobilePortFee"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14205810582f3203, []int{1}
+	return fileDescriptor_14205810582f3203, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,6 +310,110 @@ func (m *Params) GetMaxPhoneNumber() uint64 {
 	return 0
 }
 
+func (m *Params) GetRedeemPeriod() int64 {
+	if m != nil {
+		return m.RedeemPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMortgageSplit() []MortgageRecipient {
+	if m != nil {
+		return m.MortgageSplit
+	}
+	return nil
+}
+
+func (m *Params) GetChatSessionPeriod() int64 {
+	if m != nil {
+		return m.ChatSessionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockedUsers() uint64 {
+	if m != nil {
+		return m.MaxBlockedUsers
+	}
+	return 0
+}
+
+func (m *Params) GetMobilePortFee() types.Coin {
+	if m != nil {
+		return m.MobilePortFee
+	}
+	return types.Coin{}
+}
+
+
+type MortgageRecipient struct {
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+
+	RecipientType string `protobuf:"bytes,2,opt,name=recipient_type,json=recipientType,proto3" json:"recipient_type,omitempty"`
+
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+
+	Ratio                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *MortgageRecipient) Reset()         { *m = MortgageRecipient{} }
+func (m *MortgageRecipient) String() string { return proto.CompactTextString(m) }
+func (*MortgageRecipient) ProtoMessage()    {}
+func (*MortgageRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14205810582f3203, []int{3}
+}
+func (m *MortgageRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MortgageRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MortgageRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MortgageRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MortgageRecipient.Merge(m, src)
+}
+func (m *MortgageRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MortgageRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MortgageRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MortgageRecipient proto.InternalMessageInfo
+
+func (m *MortgageRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MortgageRecipient) GetRecipientType() string {
+	if m != nil {
+		return m.RecipientType
+	}
+	return ""
+}
+
+func (m *MortgageRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type ChatReward struct {
 
 	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
@@ -173,7 +428,7 @@ func (m *ChatReward) Reset()         { *m = ChatReward{} }
 func (m *ChatReward) String() string { return proto.CompactTextString(m) }
 func (*ChatReward) ProtoMessage()    {}
 func (*ChatReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14205810582f3203, []int{2}
+	return fileDescriptor_14205810582f3203, []int{4}
 }
 func (m *ChatReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +473,9 @@ func (m *ChatReward) GetValue() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "freemasonry.chat.v1.GenesisState")
+	proto.RegisterType((*RewardSnapshotRecord)(nil), "freemasonry.chat.v1.RewardSnapshotRecord")
 	proto.RegisterType((*Params)(nil), "freemasonry.chat.v1.Params")
+	proto.RegisterType((*MortgageRecipient)(nil), "freemasonry.chat.v1.MortgageRecipient")
 	proto.RegisterType((*ChatReward)(nil), "freemasonry.chat.v1.chatReward")
 }
 
@@ -226,31 +483,64 @@ func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f320
 
 var fileDescriptor_14205810582f3203 = []byte{
 
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0x65, 0x9b, 0x34, 0x12, 0x2e, 0xe5, 0xc3, 0x54, 0x28, 0x14, 0xb4, 0x8d, 0x72, 0xa8, 0x22,
-	0x84, 0x6c, 0xa5, 0x70, 0x81, 0x1b, 0xe5, 0x00, 0x15, 0x1f, 0xaa, 0x16, 0x89, 0x03, 0xb7, 0x59,
-	0x67, 0xf0, 0x5a, 0xac, 0x3d, 0x2b, 0xdb, 0x29, 0xcd, 0xdf, 0xe1, 0xd7, 0xf4, 0xc8, 0x2f, 0x40,
-	0x28, 0xbf, 0x04, 0xed, 0xae, 0x51, 0xd5, 0x36, 0x37, 0xcf, 0xbc, 0xf7, 0x66, 0xde, 0x1b, 0xb3,
-	0x5d, 0x8d, 0x0e, 0x83, 0x09, 0xa2, 0xf1, 0x14, 0x89, 0x3f, 0xfc, 0xee, 0x11, 0x2d, 0x04, 0x72,
-	0x7e, 0x25, 0x54, 0x05, 0x51, 0x9c, 0xcd, 0xf7, 0x9f, 0x6a, 0x22, 0x5d, 0xa3, 0x84, 0xc6, 0x48,
-	0x70, 0x8e, 0x22, 0x44, 0x43, 0x2e, 0x49, 0xf6, 0xf7, 0x34, 0x69, 0xea, 0x9e, 0xb2, 0x7d, 0xa5,
-	0x6e, 0xae, 0x28, 0x58, 0x0a, 0xb2, 0x84, 0x80, 0xf2, 0x6c, 0x5e, 0x62, 0x84, 0xb9, 0x54, 0x64,
-	0x5c, 0x8f, 0x4f, 0x4f, 0xd8, 0x9d, 0x77, 0xfd, 0xe6, 0x2f, 0x11, 0x22, 0xf2, 0x57, 0x6c, 0xd4,
-	0x80, 0x07, 0x1b, 0xc6, 0xd9, 0x24, 0x9b, 0xed, 0x1c, 0x3d, 0x11, 0x1b, 0x9c, 0x88, 0xd3, 0x8e,
-	0x72, 0x3c, 0xbc, 0xf8, 0x73, 0x70, 0xab, 0x48, 0x82, 0xe9, 0xaf, 0x2d, 0x36, 0xea, 0x01, 0xfe,
-	0x8c, 0xdd, 0x57, 0x64, 0xed, 0xd2, 0x99, 0xb8, 0x7a, 0xb3, 0x58, 0x78, 0x0c, 0xfd, 0xbc, 0xdb,
-	0xc5, 0x8d, 0x3e, 0x7f, 0xce, 0x1e, 0xa0, 0xa2, 0x9a, 0xb4, 0x51, 0x50, 0xff, 0x27, 0x6f, 0x75,
-	0xe4, 0x9b, 0x00, 0x3f, 0x61, 0xf7, 0xac, 0x71, 0x9f, 0xc8, 0x47, 0x0d, 0x1a, 0xdf, 0x92, 0x71,
-	0xe3, 0x41, 0x67, 0xf4, 0xb1, 0xe8, 0x93, 0x8a, 0x36, 0xa9, 0x48, 0x49, 0x45, 0x4b, 0x48, 0x36,
-	0xaf, 0xeb, 0xf8, 0x07, 0xb6, 0xdb, 0xe6, 0x29, 0xf0, 0x27, 0xf8, 0xc5, 0x47, 0xd2, 0xe3, 0xe1,
-	0x64, 0x30, 0xdb, 0x39, 0x3a, 0xd8, 0x98, 0xf8, 0x92, 0x99, 0xc6, 0x5d, 0xd5, 0xf2, 0x43, 0x76,
-	0xd7, 0xc2, 0xf9, 0x69, 0x45, 0x0e, 0x3f, 0x2f, 0x6d, 0x89, 0x7e, 0xbc, 0x3d, 0xc9, 0x66, 0xc3,
-	0xe2, 0x5a, 0x77, 0xfa, 0x9a, 0xb1, 0x4b, 0x21, 0x7f, 0xc4, 0x46, 0xef, 0xd1, 0xe8, 0x2a, 0x76,
-	0xd7, 0x19, 0x14, 0xa9, 0xe2, 0x7b, 0x6c, 0xfb, 0x2b, 0xd4, 0x4b, 0x4c, 0x77, 0xe8, 0x8b, 0xe3,
-	0x97, 0x17, 0xeb, 0x3c, 0xfb, 0xbd, 0xce, 0xb3, 0xbf, 0xeb, 0x3c, 0xfb, 0x76, 0x78, 0xc5, 0xa6,
-	0x92, 0x65, 0x4d, 0xea, 0x87, 0xaa, 0xc0, 0x38, 0x79, 0x2e, 0xdb, 0x1d, 0x32, 0xae, 0x1a, 0x0c,
-	0xe5, 0xa8, 0xfb, 0xe8, 0x17, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0xef, 0xb8, 0x5c, 0x62,
-	0x02, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xc7, 0x71, 0xfc, 0x51, 0xe7, 0xd8, 0xce, 0x87, 0xc8, 0x30, 0x6a, 0x01, 0xc7, 0x18, 0xc8,
+	0x18, 0x06, 0x76, 0x27, 0x05, 0x2e, 0xe0, 0xae, 0x6e, 0xd3, 0x92, 0xd2, 0x30, 0xee, 0x1a, 0x3a,
+	0x4c, 0x2f, 0xf0, 0xc8, 0xeb, 0xe3, 0xb5, 0xc6, 0x5e, 0xc9, 0x48, 0x72, 0x88, 0xef, 0x98, 0xe1,
+	0x7d, 0x78, 0x8e, 0x5e, 0x72, 0xcd, 0x45, 0x87, 0xc9, 0x2b, 0xf0, 0x02, 0x8c, 0xb4, 0xda, 0xa6,
+	0x4e, 0x9c, 0x4e, 0x27, 0x57, 0xb6, 0x8e, 0xfe, 0xe7, 0xa7, 0x23, 0x9d, 0x8f, 0x85, 0x46, 0x82,
+	0x02, 0x35, 0xd7, 0xc1, 0x5c, 0x49, 0x23, 0xc9, 0xbb, 0x63, 0x85, 0x98, 0x32, 0x2d, 0x85, 0x5a,
+	0x06, 0xf1, 0x84, 0x99, 0xe0, 0xf4, 0xf0, 0xce, 0x07, 0x89, 0x94, 0xc9, 0x0c, 0x43, 0x36, 0xe7,
+	0x21, 0x13, 0x42, 0x1a, 0x66, 0xb8, 0x14, 0xde, 0xe5, 0xce, 0x5e, 0x22, 0x13, 0xe9, 0xfe, 0x86,
+	0xf6, 0x9f, 0xb7, 0x36, 0x63, 0xa9, 0x53, 0xa9, 0xc3, 0x21, 0xd3, 0x18, 0x9e, 0x1e, 0x0e, 0xd1,
+	0xb0, 0xc3, 0x30, 0x96, 0x5c, 0xf8, 0x7d, 0x62, 0xe1, 0xe1, 0xe9, 0x61, 0xe8, 0x0e, 0x71, 0xb6,
+	0xf6, 0x9f, 0x15, 0xa8, 0x3f, 0xca, 0xc2, 0xe9, 0x1b, 0x66, 0x90, 0x7c, 0x0b, 0x95, 0x39, 0x53,
+	0x2c, 0xd5, 0xb4, 0xd0, 0x2a, 0x74, 0x6a, 0x77, 0xdf, 0x0f, 0xd6, 0x84, 0x17, 0xf4, 0x9c, 0xa4,
+	0x5b, 0x7a, 0xf1, 0x72, 0xff, 0x9d, 0xc8, 0x3b, 0x90, 0x2e, 0xc0, 0x42, 0xa3, 0x1a, 0x70, 0x31,
+	0x96, 0x9a, 0x6e, 0xb4, 0x8a, 0x9d, 0xda, 0xdd, 0x0f, 0xd7, 0xba, 0xff, 0xac, 0x51, 0x1d, 0x8b,
+	0xb1, 0xf4, 0x80, 0xcd, 0x85, 0x5f, 0x6b, 0x72, 0x0c, 0x75, 0x85, 0x23, 0xc4, 0x74, 0xf0, 0xdb,
+	0x02, 0x17, 0x48, 0xcb, 0x8e, 0xd2, 0x5a, 0x4b, 0x89, 0x9c, 0xf0, 0x48, 0x18, 0xb5, 0xf4, 0xa0,
+	0x5a, 0xe6, 0xfb, 0xd4, 0xba, 0x92, 0x1f, 0xa0, 0xc1, 0x46, 0x23, 0x85, 0x5a, 0x0f, 0x86, 0x52,
+	0x4e, 0x35, 0xad, 0xbc, 0x81, 0x75, 0x2f, 0x53, 0x76, 0xa5, 0x9c, 0x7a, 0x56, 0x9d, 0x5d, 0x98,
+	0xb4, 0x85, 0x59, 0xe9, 0x40, 0xa3, 0xd6, 0x36, 0x11, 0xf4, 0xd6, 0x1b, 0x60, 0xf7, 0x27, 0xcc,
+	0xf4, 0x33, 0x61, 0x0e, 0x8b, 0x2f, 0x4c, 0x9a, 0x7c, 0x03, 0xe5, 0x84, 0x8f, 0x8d, 0xa6, 0x55,
+	0x07, 0xb9, 0xbd, 0x16, 0xf2, 0x88, 0x8f, 0x8d, 0xf7, 0xce, 0xd4, 0xe4, 0x19, 0xec, 0xba, 0x18,
+	0x14, 0xfe, 0xce, 0xd4, 0x68, 0xc0, 0xc5, 0x08, 0xcf, 0xe8, 0xa6, 0xcb, 0xd2, 0x27, 0xd7, 0xc6,
+	0x11, 0x39, 0xf1, 0xb1, 0xd5, 0x7a, 0xda, 0x76, 0xbc, 0x6a, 0x26, 0xcf, 0x61, 0xc7, 0x23, 0xb5,
+	0x60, 0x73, 0x3d, 0x91, 0x46, 0x53, 0x70, 0x91, 0x7d, 0x76, 0xcd, 0xbb, 0x5b, 0x71, 0xdf, 0x6b,
+	0x23, 0x8c, 0xa5, 0x1a, 0xe5, 0x6c, 0xb5, 0xb2, 0xa7, 0xc9, 0x53, 0xd8, 0x4e, 0xe5, 0x90, 0xcf,
+	0x70, 0x30, 0xe3, 0xda, 0x70, 0x91, 0x68, 0x5a, 0x73, 0xe8, 0xf6, 0x5a, 0xf4, 0x89, 0xd3, 0x3e,
+	0xc9, 0xa4, 0x9e, 0xb9, 0x95, 0xbe, 0x6e, 0xd4, 0xe4, 0x08, 0x6a, 0xc3, 0x99, 0x8c, 0xa7, 0x8e,
+	0xa8, 0x69, 0xdd, 0xe1, 0x9a, 0x6b, 0x71, 0x5d, 0xab, 0xb3, 0x8e, 0x1e, 0x05, 0xc3, 0xdc, 0xa0,
+	0x1f, 0x97, 0xaa, 0xc5, 0x9d, 0xd2, 0xe3, 0x52, 0xb5, 0xb4, 0x53, 0x6e, 0xff, 0x51, 0x80, 0xbd,
+	0x75, 0xb7, 0x22, 0x1f, 0x41, 0x7d, 0xac, 0x64, 0x3a, 0xf0, 0xb5, 0xe0, 0x7a, 0x62, 0x33, 0xaa,
+	0x59, 0x9b, 0xaf, 0x18, 0x72, 0x04, 0xd5, 0xfc, 0xd9, 0xe8, 0x86, 0x4b, 0xc6, 0xc7, 0x6f, 0xf1,
+	0x6a, 0x3e, 0xa0, 0x57, 0xae, 0xed, 0xff, 0xca, 0x50, 0xc9, 0xba, 0x8a, 0x7c, 0x0e, 0x3b, 0xb1,
+	0x4c, 0xd3, 0x85, 0xe0, 0x66, 0x79, 0x6f, 0xe5, 0xe0, 0x2b, 0x76, 0xf2, 0x05, 0xec, 0x62, 0x2c,
+	0x67, 0x32, 0xe1, 0x31, 0x9b, 0xe5, 0xe2, 0x0d, 0x27, 0xbe, 0xba, 0x41, 0x8e, 0x61, 0x3b, 0xe5,
+	0xe2, 0x44, 0x2a, 0x93, 0xb0, 0x04, 0xef, 0x4b, 0x2e, 0x68, 0xd1, 0x85, 0x7c, 0x3b, 0xc8, 0x66,
+	0x47, 0x60, 0x67, 0x47, 0xe0, 0x67, 0x47, 0x60, 0x05, 0x79, 0x62, 0x2f, 0xf9, 0xe5, 0x0d, 0x91,
+	0xdd, 0xea, 0x89, 0x4c, 0x68, 0xc9, 0xe5, 0x61, 0x7f, 0xed, 0xdd, 0x2f, 0x94, 0x1e, 0xb7, 0xea,
+	0x4b, 0x0e, 0x60, 0x2b, 0x65, 0x67, 0xbd, 0x89, 0x14, 0xf8, 0xe3, 0x22, 0x1d, 0xa2, 0xa2, 0xe5,
+	0x56, 0xa1, 0x53, 0x8a, 0x2e, 0x59, 0x49, 0x3b, 0x9f, 0x0e, 0x3d, 0x54, 0x5c, 0x8e, 0x68, 0xa5,
+	0x55, 0xe8, 0x14, 0xa3, 0x15, 0x1b, 0x89, 0xa0, 0x91, 0xfa, 0x40, 0xfb, 0xf3, 0x19, 0x37, 0xbe,
+	0x53, 0x0f, 0xae, 0xa9, 0xb7, 0x4c, 0x19, 0x61, 0xcc, 0xe7, 0x1c, 0x45, 0x9e, 0x97, 0x55, 0x84,
+	0x7d, 0xe5, 0xd7, 0x1a, 0xd8, 0x1f, 0x5e, 0x75, 0x87, 0x5f, 0xdd, 0x20, 0xbf, 0x02, 0xc9, 0xda,
+	0xa0, 0x27, 0xe5, 0xec, 0x21, 0x62, 0x7f, 0xc2, 0x14, 0xba, 0x46, 0xdd, 0xec, 0x06, 0x16, 0xff,
+	0xcf, 0xcb, 0xfd, 0x83, 0x84, 0x9b, 0xc9, 0x62, 0x18, 0xc4, 0x32, 0x0d, 0xfd, 0xd8, 0xce, 0x7e,
+	0xbe, 0xd4, 0xa3, 0x69, 0x68, 0x96, 0x73, 0xd4, 0xc1, 0x03, 0x8c, 0xa3, 0x35, 0x24, 0xf2, 0x4b,
+	0xde, 0x53, 0x27, 0x4c, 0x4d, 0xd1, 0x3c, 0x44, 0xa4, 0x70, 0x23, 0xf8, 0x65, 0x0c, 0xe9, 0xc0,
+	0x76, 0xca, 0xce, 0x5c, 0xd7, 0xe0, 0xc8, 0x0e, 0x69, 0xdb, 0xad, 0x36, 0x11, 0x97, 0xcd, 0xe4,
+	0x08, 0x1a, 0x99, 0x73, 0x4f, 0x2a, 0x17, 0x41, 0xfd, 0xed, 0xea, 0x68, 0xd5, 0xab, 0xfd, 0x57,
+	0x01, 0x76, 0xaf, 0xe4, 0x80, 0x10, 0x28, 0x09, 0x96, 0xa2, 0x2f, 0x7a, 0xf7, 0x9f, 0x7c, 0x0a,
+	0x5b, 0x2a, 0x17, 0x0c, 0xec, 0x15, 0x7c, 0x95, 0x37, 0x5e, 0x59, 0x7f, 0x5a, 0xce, 0x91, 0x50,
+	0xb8, 0x95, 0xf7, 0x6a, 0xd1, 0xed, 0xe7, 0x4b, 0xf2, 0x00, 0xca, 0xca, 0x7e, 0x44, 0x69, 0xe9,
+	0x46, 0x6f, 0x95, 0x39, 0xb7, 0xbf, 0x03, 0xb8, 0x28, 0x5d, 0xf2, 0x1e, 0x54, 0xbe, 0x47, 0x9e,
+	0x4c, 0x8c, 0x0b, 0xb5, 0x18, 0xf9, 0x15, 0xd9, 0x83, 0xf2, 0x33, 0x36, 0x5b, 0xe4, 0x31, 0x66,
+	0x8b, 0xee, 0xd7, 0x2f, 0xce, 0x9b, 0x85, 0xbf, 0xcf, 0x9b, 0x85, 0x7f, 0xcf, 0x9b, 0x85, 0xe7,
+	0x07, 0x2b, 0xf5, 0x18, 0x87, 0x6e, 0x34, 0xc5, 0x13, 0xc6, 0x45, 0x78, 0xe6, 0xbe, 0xd0, 0xd9,
+	0xe1, 0xc3, 0x8a, 0xfb, 0x50, 0x7f, 0xf5, 0xff, 0x00, 0x9f, 0x97, 0x82, 0xde, 0x36, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +567,128 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BlockLists) > 0 {
+		for iNdEx := len(m.BlockLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MobileListings) > 0 {
+		for iNdEx := len(m.MobileListings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MobileListings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RewardSnapshots) > 0 {
+		for iNdEx := len(m.RewardSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.ChatRewardIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Gifts) > 0 {
+		for iNdEx := len(m.Gifts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gifts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChatSessions) > 0 {
+		for iNdEx := len(m.ChatSessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChatSessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddressBooks) > 0 {
+		for iNdEx := len(m.AddressBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RedeemQueue) > 0 {
+		for iNdEx := len(m.RedeemQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UserInfos) > 0 {
+		for iNdEx := len(m.UserInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -290,7 +702,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *RewardSnapshotRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -300,7 +712,51 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardSnapshotRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSnapshotRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -314,6 +770,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.MobilePortFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxBlockedUsers != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBlockedUsers))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MobileMarketFee.Size()
+		i -= size
+		if _, err := m.MobileMarketFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RewardPoolFeeShare.Size()
+		i -= size
+		if _, err := m.RewardPoolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ChatSessionPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChatSessionPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MortgageSplit) > 0 {
+		for iNdEx := len(m.MortgageSplit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MortgageSplit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RedeemPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedeemPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.MaxPhoneNumber != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPhoneNumber))
 		i--
@@ -360,6 +875,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MortgageRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MortgageRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MortgageRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientType) > 0 {
+		i -= len(m.RecipientType)
+		copy(dAtA[i:], m.RecipientType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecipientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChatReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -418,6 +991,74 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UserInfos) > 0 {
+		for _, e := range m.UserInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedeemQueue) > 0 {
+		for _, e := range m.RedeemQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressBooks) > 0 {
+		for _, e := range m.AddressBooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChatSessions) > 0 {
+		for _, e := range m.ChatSessions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Gifts) > 0 {
+		for _, e := range m.Gifts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ChatRewardIndex.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RewardSnapshots) > 0 {
+		for _, e := range m.RewardSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MobileListings) > 0 {
+		for _, e := range m.MobileListings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockLists) > 0 {
+		for _, e := range m.BlockLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RewardSnapshotRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -449,6 +1090,53 @@ func (m *Params) Size() (n int) {
 	if m.MaxPhoneNumber != 0 {
 		n += 1 + sovGenesis(uint64(m.MaxPhoneNumber))
 	}
+	if m.RedeemPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.RedeemPeriod))
+	}
+	if len(m.MortgageSplit) > 0 {
+		for _, e := range m.MortgageSplit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChatSessionPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.ChatSessionPeriod))
+	}
+	l = m.RewardPoolFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MobileMarketFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxBlockedUsers != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBlockedUsers))
+	}
+	l = m.MobilePortFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MortgageRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RecipientType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -542,62 +1230,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -607,16 +1244,488 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserInfos = append(m.UserInfos, UserInfo{})
+			if err := m.UserInfos[len(m.UserInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemQueue = append(m.RedeemQueue, RedeemEntry{})
+			if err := m.RedeemQueue[len(m.RedeemQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBooks = append(m.AddressBooks, AddressBook{})
+			if err := m.AddressBooks[len(m.AddressBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatSessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChatSessions = append(m.ChatSessions, ChatSession{})
+			if err := m.ChatSessions[len(m.ChatSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gifts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gifts = append(m.Gifts, Gift{})
+			if err := m.Gifts[len(m.Gifts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatRewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChatRewardIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSnapshots = append(m.RewardSnapshots, RewardSnapshotRecord{})
+			if err := m.RewardSnapshots[len(m.RewardSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MobileListings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MobileListings = append(m.MobileListings, MobileListing{})
+			if err := m.MobileListings[len(m.MobileListings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockLists = append(m.BlockLists, BlockList{})
+			if err := m.BlockLists[len(m.BlockLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardSnapshotRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardSnapshotRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardSnapshotRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
@@ -625,9 +1734,199 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.CommunityAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcologicalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcologicalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMortgageCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMortgageCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatRewardLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChatRewardLog = append(m.ChatRewardLog, ChatReward{})
+			if err := m.ChatRewardLog[len(m.ChatRewardLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPhoneNumber", wireType)
+			}
+			m.MaxPhoneNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPhoneNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemPeriod", wireType)
+			}
+			m.RedeemPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedeemPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MortgageSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MortgageSplit = append(m.MortgageSplit, MortgageRecipient{})
+			if err := m.MortgageSplit[len(m.MortgageSplit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatSessionPeriod", wireType)
+			}
+			m.ChatSessionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChatSessionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcologicalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolFeeShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -655,13 +1954,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EcologicalAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardPoolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinMortgageCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MobileMarketFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -671,28 +1972,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinMortgageCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MobileMarketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockedUsers", wireType)
+			}
+			m.MaxBlockedUsers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockedUsers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatRewardLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MobilePortFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -719,16 +2040,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChatRewardLog = append(m.ChatRewardLog, ChatReward{})
-			if err := m.ChatRewardLog[len(m.ChatRewardLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MobilePortFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPhoneNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxPhoneNumber = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MortgageRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MortgageRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MortgageRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -738,11 +2109,122 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPhoneNumber |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -10,6 +12,7 @@ const (
 	
 	ModuleName     = "chat"
 	ModuleBurnName = "chat_burn"
+	RewardPoolName = "chat_reward_pool"
 	
 	StoreKey = ModuleName
 
@@ -31,11 +34,175 @@ const (
 
 	
 	MobileSuffixMax = 99999
+)
 
-	KeyPrefixRegisterInfo = "chat_register_info_"
-
-	KeyPrefixLastGetRewardLog = "chat_last_get_reward_log_"
 
-	
-	KeyPrefixMortgageAddLog = "chat_mortgage_add_log_"
+var (
+	KeyPrefixRegisterInfo     = []byte{0x01}
+	KeyPrefixMortgageAddLog   = []byte{0x02}
+	KeyPrefixLastGetRewardLog = []byte{0x03}
+	KeyPrefixRedeemQueue      = []byte{0x04}
+	KeyPrefixAddressBook      = []byte{0x05}
+	KeyPrefixChatSession      = []byte{0x06}
+	KeyPrefixChatSessionByTo  = []byte{0x07}
+	KeyPrefixChatSessionQueue = []byte{0x08}
+	KeyPrefixGift             = []byte{0x09}
+	KeyChatRewardIndex        = []byte{0x0A}
+	KeyPrefixRewardSnapshot   = []byte{0x0B}
+	KeyPrefixMobileListing    = []byte{0x0C}
+	KeyPrefixListingBySeller  = []byte{0x0D}
+	KeyPrefixListingQueue     = []byte{0x0E}
+	KeyPrefixMobileOwner      = []byte{0x0F}
+	KeyPrefixBlockedUser      = []byte{0x10}
+	KeyPrefixRedeemByFrom     = []byte{0x11}
 )
+
+func GetRegisterInfoKey(fromAddress string) []byte {
+	return append(KeyPrefixRegisterInfo, []byte(fromAddress)...)
+}
+
+
+func GetMortgageAddLogPrefix(fromAddress string) []byte {
+	return append(KeyPrefixMortgageAddLog, address.MustLengthPrefix([]byte(fromAddress))...)
+}
+
+func GetMortgageAddLogKey(fromAddress string, height int64) []byte {
+	return append(GetMortgageAddLogPrefix(fromAddress), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+
+func ParseMortgageAddLogKey(key []byte) string {
+	key = key[len(KeyPrefixMortgageAddLog):]
+	return string(key[1 : 1+int(key[0])])
+}
+
+func GetLastGetRewardLogKey(fromAddress string) []byte {
+	return append(KeyPrefixLastGetRewardLog, []byte(fromAddress)...)
+}
+
+
+func GetRedeemQueuePrefix(completionHeight int64) []byte {
+	return append(KeyPrefixRedeemQueue, sdk.Uint64ToBigEndian(uint64(completionHeight))...)
+}
+
+func GetRedeemQueueKey(completionHeight int64, fromAddress string) []byte {
+	return append(GetRedeemQueuePrefix(completionHeight), []byte(fromAddress)...)
+}
+
+
+func GetRedeemByFromPrefix(fromAddress string) []byte {
+	return append(KeyPrefixRedeemByFrom, address.MustLengthPrefix([]byte(fromAddress))...)
+}
+
+func GetRedeemByFromKey(fromAddress string, completionHeight int64) []byte {
+	return append(GetRedeemByFromPrefix(fromAddress), sdk.Uint64ToBigEndian(uint64(completionHeight))...)
+}
+
+func ParseRedeemByFromKey(key []byte) (string, int64) {
+	key = key[len(KeyPrefixRedeemByFrom):]
+	fromLen := int(key[0])
+	return string(key[1 : 1+fromLen]), int64(sdk.BigEndianToUint64(key[1+fromLen:]))
+}
+
+func GetAddressBookKey(fromAddress string) []byte {
+	return append(KeyPrefixAddressBook, []byte(fromAddress)...)
+}
+
+
+func GetChatSessionPrefix(fromAddress string) []byte {
+	return append(KeyPrefixChatSession, address.MustLengthPrefix([]byte(fromAddress))...)
+}
+
+func GetChatSessionKey(fromAddress, toAddress string) []byte {
+	return append(GetChatSessionPrefix(fromAddress), []byte(toAddress)...)
+}
+
+
+func GetChatSessionByToPrefix(toAddress string) []byte {
+	return append(KeyPrefixChatSessionByTo, address.MustLengthPrefix([]byte(toAddress))...)
+}
+
+func GetChatSessionByToKey(toAddress, fromAddress string) []byte {
+	return append(GetChatSessionByToPrefix(toAddress), []byte(fromAddress)...)
+}
+
+
+func ParseChatSessionByToKey(key []byte) (string, string) {
+	key = key[len(KeyPrefixChatSessionByTo):]
+	toLen := int(key[0])
+	return string(key[1 : 1+toLen]), string(key[1+toLen:])
+}
+
+
+func GetChatSessionQueuePrefix(expireHeight int64) []byte {
+	return append(KeyPrefixChatSessionQueue, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+func GetChatSessionQueueKey(expireHeight int64, fromAddress, toAddress string) []byte {
+	return append(GetChatSessionQueuePrefix(expireHeight), append(address.MustLengthPrefix([]byte(fromAddress)), []byte(toAddress)...)...)
+}
+
+func ParseChatSessionQueueKey(key []byte) (string, string) {
+	key = key[len(KeyPrefixChatSessionQueue)+8:]
+	fromLen := int(key[0])
+	return string(key[1 : 1+fromLen]), string(key[1+fromLen:])
+}
+
+func GetGiftKey(id int64) []byte {
+	return append(KeyPrefixGift, sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+func GetRewardSnapshotKey(fromAddress string) []byte {
+	return append(KeyPrefixRewardSnapshot, []byte(fromAddress)...)
+}
+
+func GetMobileListingKey(mobile string) []byte {
+	return append(KeyPrefixMobileListing, []byte(mobile)...)
+}
+
+
+func GetListingBySellerPrefix(seller string) []byte {
+	return append(KeyPrefixListingBySeller, address.MustLengthPrefix([]byte(seller))...)
+}
+
+func GetListingBySellerKey(seller, mobile string) []byte {
+	return append(GetListingBySellerPrefix(seller), []byte(mobile)...)
+}
+
+func ParseListingBySellerKey(key []byte) (string, string) {
+	key = key[len(KeyPrefixListingBySeller):]
+	sellerLen := int(key[0])
+	return string(key[1 : 1+sellerLen]), string(key[1+sellerLen:])
+}
+
+
+func GetListingQueuePrefix(expireHeight int64) []byte {
+	return append(KeyPrefixListingQueue, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+func GetListingQueueKey(expireHeight int64, mobile string) []byte {
+	return append(GetListingQueuePrefix(expireHeight), []byte(mobile)...)
+}
+
+func ParseListingQueueKey(key []byte) string {
+	return string(key[len(KeyPrefixListingQueue)+8:])
+}
+
+func GetMobileOwnerKey(mobile string) []byte {
+	return append(KeyPrefixMobileOwner, []byte(mobile)...)
+}
+
+
+func GetBlockedUserPrefix(fromAddress string) []byte {
+	return append(KeyPrefixBlockedUser, address.MustLengthPrefix([]byte(fromAddress))...)
+}
+
+func GetBlockedUserKey(fromAddress, blockedAddress string) []byte {
+	return append(GetBlockedUserPrefix(fromAddress), []byte(blockedAddress)...)
+}
+
+func ParseBlockedUserKey(key []byte) (string, string) {
+	key = key[len(KeyPrefixBlockedUser):]
+	fromLen := int(key[0])
+	return string(key[1 : 1+fromLen]), string(key[1+fromLen:])
+}
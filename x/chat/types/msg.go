@@ -14,6 +14,18 @@ var (
 	_ sdk.Msg = &MsgSendGift{}
 	_ sdk.Msg = &MsgSetChatFee{}
 	_ sdk.Msg = &MsgAddressBookSave{}
+	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgApplyMobile{}
+	_ sdk.Msg = &MsgReleaseMobile{}
+	_ sdk.Msg = &MsgPayChat{}
+	_ sdk.Msg = &MsgAcceptChat{}
+	_ sdk.Msg = &MsgListMobile{}
+	_ sdk.Msg = &MsgCancelListing{}
+	_ sdk.Msg = &MsgBuyMobile{}
+	_ sdk.Msg = &MsgSendToMobile{}
+	_ sdk.Msg = &MsgBlockUser{}
+	_ sdk.Msg = &MsgUnblockUser{}
+	_ sdk.Msg = &MsgChangeGateway{}
 )
 
 const (
@@ -24,9 +36,490 @@ const (
 	TypeMsgAddressBookSave = "address_book_save"
 	TypeMsgGetRewards      = "get_rewards"
 	TypeMsgMobileTransfer  = "mobile_transfer"
+	TypeMsgRedeem          = "redeem"
+	TypeMsgApplyMobile     = "apply_mobile"
+	TypeMsgReleaseMobile   = "release_mobile"
+	TypeMsgPayChat         = "pay_chat"
+	TypeMsgAcceptChat      = "accept_chat"
+	TypeMsgListMobile      = "list_mobile"
+	TypeMsgCancelListing   = "cancel_listing"
+	TypeMsgBuyMobile       = "buy_mobile"
+	TypeMsgSendToMobile    = "send_to_mobile"
+	TypeMsgBlockUser       = "block_user"
+	TypeMsgUnblockUser     = "unblock_user"
+	TypeMsgChangeGateway   = "change_gateway"
 )
 
 
+func NewMsgRedeem(fromAddress string, amount types.Coin) *MsgRedeem {
+	return &MsgRedeem{
+		FromAddress: fromAddress,
+		Amount:      amount,
+	}
+}
+
+func (msg MsgRedeem) Route() string { return RouterKey }
+func (msg MsgRedeem) Type() string  { return TypeMsgRedeem }
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgRedeem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRedeem) ValidateBasic() error {
+
+	if msg.Amount.Denom != config.BaseDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin error")
+	}
+
+	if !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+func (m MsgRedeem) XXX_MessageName() string {
+	return TypeMsgRedeem
+}
+
+
+func NewMsgApplyMobile(fromAddress, nodeAddress, mobilePrefix string) *MsgApplyMobile {
+	return &MsgApplyMobile{
+		FromAddress:  fromAddress,
+		NodeAddress:  nodeAddress,
+		MobilePrefix: mobilePrefix,
+	}
+}
+
+func (msg MsgApplyMobile) Route() string { return RouterKey }
+func (msg MsgApplyMobile) Type() string  { return TypeMsgApplyMobile }
+func (msg MsgApplyMobile) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgApplyMobile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgApplyMobile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.ValAddressFromBech32(msg.NodeAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid node address")
+	}
+	if msg.MobilePrefix == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "mobile prefix cannot be empty")
+	}
+	return nil
+}
+func (m MsgApplyMobile) XXX_MessageName() string {
+	return TypeMsgApplyMobile
+}
+
+
+func NewMsgReleaseMobile(fromAddress, mobile string) *MsgReleaseMobile {
+	return &MsgReleaseMobile{
+		FromAddress: fromAddress,
+		Mobile:      mobile,
+	}
+}
+
+func (msg MsgReleaseMobile) Route() string { return RouterKey }
+func (msg MsgReleaseMobile) Type() string  { return TypeMsgReleaseMobile }
+func (msg MsgReleaseMobile) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgReleaseMobile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgReleaseMobile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Mobile) <= MobileSuffixLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mobile %s", msg.Mobile)
+	}
+	return nil
+}
+func (m MsgReleaseMobile) XXX_MessageName() string {
+	return TypeMsgReleaseMobile
+}
+
+
+func NewMsgPayChat(fromAddress, toAddress string, fee types.Coin) *MsgPayChat {
+	return &MsgPayChat{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Fee:         fee,
+	}
+}
+
+func (msg MsgPayChat) Route() string { return RouterKey }
+func (msg MsgPayChat) Type() string  { return TypeMsgPayChat }
+func (msg MsgPayChat) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgPayChat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgPayChat) ValidateBasic() error {
+	if msg.Fee.Denom != config.BaseDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin error")
+	}
+
+	if !msg.Fee.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid recipient address")
+	}
+	if msg.FromAddress == msg.ToAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot pay chat to self")
+	}
+	return nil
+}
+func (m MsgPayChat) XXX_MessageName() string {
+	return TypeMsgPayChat
+}
+
+
+func NewMsgAcceptChat(fromAddress, senderAddress string) *MsgAcceptChat {
+	return &MsgAcceptChat{
+		FromAddress:   fromAddress,
+		SenderAddress: senderAddress,
+	}
+}
+
+func (msg MsgAcceptChat) Route() string { return RouterKey }
+func (msg MsgAcceptChat) Type() string  { return TypeMsgAcceptChat }
+func (msg MsgAcceptChat) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgAcceptChat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAcceptChat) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid chat sender address")
+	}
+	return nil
+}
+func (m MsgAcceptChat) XXX_MessageName() string {
+	return TypeMsgAcceptChat
+}
+
+
+func NewMsgListMobile(fromAddress, mobile string, price types.Coin, expireHeight int64) *MsgListMobile {
+	return &MsgListMobile{
+		FromAddress:  fromAddress,
+		Mobile:       mobile,
+		Price:        price,
+		ExpireHeight: expireHeight,
+	}
+}
+
+func (msg MsgListMobile) Route() string { return RouterKey }
+func (msg MsgListMobile) Type() string  { return TypeMsgListMobile }
+func (msg MsgListMobile) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgListMobile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgListMobile) ValidateBasic() error {
+	if msg.Price.Denom != config.BaseDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin error")
+	}
+
+	if !msg.Price.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Mobile) <= MobileSuffixLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mobile %s", msg.Mobile)
+	}
+	if msg.ExpireHeight < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid expire height %d", msg.ExpireHeight)
+	}
+	return nil
+}
+func (m MsgListMobile) XXX_MessageName() string {
+	return TypeMsgListMobile
+}
+
+
+func NewMsgCancelListing(fromAddress, mobile string) *MsgCancelListing {
+	return &MsgCancelListing{
+		FromAddress: fromAddress,
+		Mobile:      mobile,
+	}
+}
+
+func (msg MsgCancelListing) Route() string { return RouterKey }
+func (msg MsgCancelListing) Type() string  { return TypeMsgCancelListing }
+func (msg MsgCancelListing) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgCancelListing) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCancelListing) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Mobile) <= MobileSuffixLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mobile %s", msg.Mobile)
+	}
+	return nil
+}
+func (m MsgCancelListing) XXX_MessageName() string {
+	return TypeMsgCancelListing
+}
+
+
+func NewMsgBuyMobile(fromAddress, mobile string, price types.Coin) *MsgBuyMobile {
+	return &MsgBuyMobile{
+		FromAddress: fromAddress,
+		Mobile:      mobile,
+		Price:       price,
+	}
+}
+
+func (msg MsgBuyMobile) Route() string { return RouterKey }
+func (msg MsgBuyMobile) Type() string  { return TypeMsgBuyMobile }
+func (msg MsgBuyMobile) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgBuyMobile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgBuyMobile) ValidateBasic() error {
+	if msg.Price.Denom != config.BaseDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin error")
+	}
+
+	if !msg.Price.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Mobile) <= MobileSuffixLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mobile %s", msg.Mobile)
+	}
+	return nil
+}
+func (m MsgBuyMobile) XXX_MessageName() string {
+	return TypeMsgBuyMobile
+}
+
+
+func NewMsgSendToMobile(fromAddress, mobile string, amount types.Coin) *MsgSendToMobile {
+	return &MsgSendToMobile{
+		FromAddress: fromAddress,
+		Mobile:      mobile,
+		Amount:      amount,
+	}
+}
+
+func (msg MsgSendToMobile) Route() string { return RouterKey }
+func (msg MsgSendToMobile) Type() string  { return TypeMsgSendToMobile }
+func (msg MsgSendToMobile) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgSendToMobile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSendToMobile) ValidateBasic() error {
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Mobile) <= MobileSuffixLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mobile %s", msg.Mobile)
+	}
+	return nil
+}
+func (m MsgSendToMobile) XXX_MessageName() string {
+	return TypeMsgSendToMobile
+}
+
+
+func NewMsgBlockUser(fromAddress, blockedAddress string) *MsgBlockUser {
+	return &MsgBlockUser{
+		FromAddress:    fromAddress,
+		BlockedAddress: blockedAddress,
+	}
+}
+
+func (msg MsgBlockUser) Route() string { return RouterKey }
+func (msg MsgBlockUser) Type() string  { return TypeMsgBlockUser }
+func (msg MsgBlockUser) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgBlockUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgBlockUser) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.BlockedAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid blocked address")
+	}
+	if msg.FromAddress == msg.BlockedAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot block self")
+	}
+	return nil
+}
+func (m MsgBlockUser) XXX_MessageName() string {
+	return TypeMsgBlockUser
+}
+
+
+func NewMsgUnblockUser(fromAddress, blockedAddress string) *MsgUnblockUser {
+	return &MsgUnblockUser{
+		FromAddress:    fromAddress,
+		BlockedAddress: blockedAddress,
+	}
+}
+
+func (msg MsgUnblockUser) Route() string { return RouterKey }
+func (msg MsgUnblockUser) Type() string  { return TypeMsgUnblockUser }
+func (msg MsgUnblockUser) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgUnblockUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgUnblockUser) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.BlockedAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid blocked address")
+	}
+	if msg.FromAddress == msg.BlockedAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot unblock self")
+	}
+	return nil
+}
+func (m MsgUnblockUser) XXX_MessageName() string {
+	return TypeMsgUnblockUser
+}
+
+
+func NewMsgChangeGateway(fromAddress, nodeAddress, mobilePrefix string, portMobile bool) *MsgChangeGateway {
+	return &MsgChangeGateway{
+		FromAddress:  fromAddress,
+		NodeAddress:  nodeAddress,
+		MobilePrefix: mobilePrefix,
+		PortMobile:   portMobile,
+	}
+}
+
+func (msg MsgChangeGateway) Route() string { return RouterKey }
+func (msg MsgChangeGateway) Type() string  { return TypeMsgChangeGateway }
+func (msg MsgChangeGateway) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgChangeGateway) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgChangeGateway) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.ValAddressFromBech32(msg.NodeAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid node address")
+	}
+	if msg.MobilePrefix == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "mobile prefix cannot be empty")
+	}
+	return nil
+}
+func (m MsgChangeGateway) XXX_MessageName() string {
+	return TypeMsgChangeGateway
+}
+
+
 func NewMsgMobileTransfer(fromAddress, toAddress, mobile string) *MsgMobileTransfer {
 	return &MsgMobileTransfer{
 		FromAddress: fromAddress,
@@ -55,7 +548,10 @@ func (msg MsgMobileTransfer) ValidateBasic() error {
 
 	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
-		return sdkerrors.Wrap(err, "invalid sender address")
+		return sdkerrors.Wrap(err, "invalid recipient address")
+	}
+	if msg.FromAddress == msg.ToAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot transfer mobile to self")
 	}
 
 	return nil
@@ -159,6 +655,10 @@ func (msg MsgSendGift) ValidateBasic() error {
 	if !msg.GiftValue.Amount.IsPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount error")
 	}
+
+	if msg.GiftAmount <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "gift amount must be positive")
+	}
 	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid sender address")
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgMobileTransferValidateBasic(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+
+	testCases := []struct {
+		name    string
+		from    string
+		to      string
+		expPass bool
+	}{
+		{"valid", alice, bob, true},
+		{"invalid sender", "alice", bob, false},
+		{"invalid recipient", alice, "bob", false},
+		{"self transfer", alice, alice, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewMsgMobileTransfer(tc.from, tc.to, "12345678").ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
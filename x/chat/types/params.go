@@ -4,17 +4,39 @@ import (
 	"fmt"
 	"freemasonry.cc/blockchain/cmd/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
-	KeyCommunityAddress  = []byte("CommunityAddress")
-	KeyEcologicalAddress = []byte("EcologicalAddress")
-	KeyCoin              = []byte("Coin")
-	KeyChatRewardLog     = []byte("ChatRewardLog")
-	KeyMaxPhoneNumber    = []byte("MaxPhoneNumber")
+	KeyCommunityAddress   = []byte("CommunityAddress")
+	KeyEcologicalAddress  = []byte("EcologicalAddress")
+	KeyCoin               = []byte("Coin")
+	KeyChatRewardLog      = []byte("ChatRewardLog")
+	KeyMaxPhoneNumber     = []byte("MaxPhoneNumber")
+	KeyRedeemPeriod       = []byte("RedeemPeriod")
+	KeyMortgageSplit      = []byte("MortgageSplit")
+	KeyChatSessionPeriod  = []byte("ChatSessionPeriod")
+	KeyRewardPoolFeeShare = []byte("RewardPoolFeeShare")
+	KeyMobileMarketFee    = []byte("MobileMarketFee")
+	KeyMaxBlockedUsers    = []byte("MaxBlockedUsers")
+	KeyMobilePortFee      = []byte("MobilePortFee")
 )
 
+const (
+	MortgageRecipientNode    = "node"
+	MortgageRecipientBurn    = "burn"
+	MortgageRecipientAddress = "address"
+	MortgageRecipientModule  = "module"
+	MortgageRecipientRemain  = "remain"
+)
+
+
+var mortgageRecipientModules = map[string]bool{
+	authtypes.FeeCollectorName: true,
+	RewardPoolName:             true,
+}
+
 
 func NewParams(
 	communityAddress string,
@@ -22,22 +44,37 @@ func NewParams(
 	coin sdk.Coin,
 	chatRewardLog []ChatReward,
 	maxPhoneNumber uint64,
+	redeemPeriod int64,
+	mortgageSplit []MortgageRecipient,
+	chatSessionPeriod int64,
+	rewardPoolFeeShare sdk.Dec,
+	mobileMarketFee sdk.Dec,
+	maxBlockedUsers uint64,
+	mobilePortFee sdk.Coin,
 ) Params {
 	return Params{
-		CommunityAddress:  communityAddress,
-		EcologicalAddress: ecologicalAddress,
-		MinMortgageCoin:   coin,
-		ChatRewardLog:     chatRewardLog,
-		MaxPhoneNumber:    maxPhoneNumber,
+		CommunityAddress:   communityAddress,
+		EcologicalAddress:  ecologicalAddress,
+		MinMortgageCoin:    coin,
+		ChatRewardLog:      chatRewardLog,
+		MaxPhoneNumber:     maxPhoneNumber,
+		RedeemPeriod:       redeemPeriod,
+		MortgageSplit:      mortgageSplit,
+		ChatSessionPeriod:  chatSessionPeriod,
+		RewardPoolFeeShare: rewardPoolFeeShare,
+		MobileMarketFee:    mobileMarketFee,
+		MaxBlockedUsers:    maxBlockedUsers,
+		MobilePortFee:      mobilePortFee,
 	}
 }
 
 func DefaultParams() Params {
-
+	communityAddress := "dex1vmx0e3r7v93axstfkqpxjpvgearcmxls2x287f"
+	ecologicalAddress := "dex1wrx8tdyv5j3l5lst9n080aar3v0f5zywh303cy"
 
 	return Params{
-		CommunityAddress:  "dex1vmx0e3r7v93axstfkqpxjpvgearcmxls2x287f",
-		EcologicalAddress: "dex1wrx8tdyv5j3l5lst9n080aar3v0f5zywh303cy",
+		CommunityAddress:  communityAddress,
+		EcologicalAddress: ecologicalAddress,
 		MinMortgageCoin:   sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000000000000000000)),
 		ChatRewardLog: []ChatReward{
 			{
@@ -46,6 +83,20 @@ func DefaultParams() Params {
 			},
 		},
 		MaxPhoneNumber: 10,
+		RedeemPeriod:   100800,
+		MortgageSplit: []MortgageRecipient{
+			{Name: "remain", RecipientType: MortgageRecipientRemain, Ratio: sdk.NewDecWithPrec(85, 2)},
+			{Name: "community", RecipientType: MortgageRecipientAddress, Address: communityAddress, Ratio: sdk.NewDecWithPrec(1, 2)},
+			{Name: "node", RecipientType: MortgageRecipientNode, Ratio: sdk.NewDecWithPrec(5, 2)},
+			{Name: "ecological", RecipientType: MortgageRecipientAddress, Address: ecologicalAddress, Ratio: sdk.NewDecWithPrec(1, 2)},
+			{Name: "pos", RecipientType: MortgageRecipientModule, Address: authtypes.FeeCollectorName, Ratio: sdk.NewDecWithPrec(3, 2)},
+			{Name: "burn", RecipientType: MortgageRecipientBurn, Ratio: sdk.NewDecWithPrec(5, 2)},
+		},
+		ChatSessionPeriod:  17280,
+		RewardPoolFeeShare: sdk.NewDecWithPrec(10, 2),
+		MobileMarketFee:    sdk.ZeroDec(),
+		MaxBlockedUsers:    1000,
+		MobilePortFee:      sdk.NewCoin(config.BaseDenom, sdk.NewInt(1000000000000000000)),
 	}
 }
 
@@ -67,7 +118,35 @@ func (p Params) Validate() error {
 	}
 
 	if err := validateMaxPhoneNumber(p.MaxPhoneNumber); err != nil {
+		return err
+	}
+
+	if err := validateRedeemPeriod(p.RedeemPeriod); err != nil {
+		return err
+	}
+
+	if err := validateMortgageSplit(p.MortgageSplit); err != nil {
+		return err
+	}
+
+	if err := validateChatSessionPeriod(p.ChatSessionPeriod); err != nil {
+		return err
+	}
+
+	if err := validateRewardPoolFeeShare(p.RewardPoolFeeShare); err != nil {
+		return err
+	}
+
+	if err := validateMobileMarketFee(p.MobileMarketFee); err != nil {
+		return err
+	}
 
+	if err := validateMaxBlockedUsers(p.MaxBlockedUsers); err != nil {
+		return err
+	}
+
+	if err := validateMobilePortFee(p.MobilePortFee); err != nil {
+		return err
 	}
 	return nil
 }
@@ -79,10 +158,25 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCoin, &p.MinMortgageCoin, validateCoin),
 		paramtypes.NewParamSetPair(KeyChatRewardLog, &p.ChatRewardLog, validateChatRewardLog),
 		paramtypes.NewParamSetPair(KeyMaxPhoneNumber, &p.MaxPhoneNumber, validateMaxPhoneNumber),
+		paramtypes.NewParamSetPair(KeyRedeemPeriod, &p.RedeemPeriod, validateRedeemPeriod),
+		paramtypes.NewParamSetPair(KeyMortgageSplit, &p.MortgageSplit, validateMortgageSplit),
+		paramtypes.NewParamSetPair(KeyChatSessionPeriod, &p.ChatSessionPeriod, validateChatSessionPeriod),
+		paramtypes.NewParamSetPair(KeyRewardPoolFeeShare, &p.RewardPoolFeeShare, validateRewardPoolFeeShare),
+		paramtypes.NewParamSetPair(KeyMobileMarketFee, &p.MobileMarketFee, validateMobileMarketFee),
+		paramtypes.NewParamSetPair(KeyMaxBlockedUsers, &p.MaxBlockedUsers, validateMaxBlockedUsers),
+		paramtypes.NewParamSetPair(KeyMobilePortFee, &p.MobilePortFee, validateMobilePortFee),
 	}
 }
 
 func validateAddrString(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid address %s: %s", v, err)
+	}
 
 	return nil
 }
@@ -97,23 +191,23 @@ func validateChatRewardLog(i interface{}) error {
 		return fmt.Errorf("invalid parameter len")
 	}
 
-	if len(v) > 1 {
-		for i := 0; i < len(v)-1; i++ {
-			if v[i].Height > v[i+1].Height {
-				return fmt.Errorf("invalid parameter height")
-			}
+	for i := 0; i < len(v); i++ {
+		if v[i].Height <= 0 {
+			return fmt.Errorf("invalid parameter height")
+		}
+		if i > 0 && v[i-1].Height >= v[i].Height {
+			return fmt.Errorf("invalid parameter height")
+		}
 
-			curValue := v[i].Value
-			curValueDec, err := sdk.NewDecFromStr(curValue)
-			if err != nil {
-				return fmt.Errorf("invalid value format")
-			}
-			if curValueDec.GT(sdk.NewDec(1)) {
-				return fmt.Errorf("invalid value (GT1)")
-			}
-			if curValueDec.LT(sdk.MustNewDecFromStr("0.0001")) {
-				return fmt.Errorf("invalid value (LT0.0001)")
-			}
+		curValueDec, err := sdk.NewDecFromStr(v[i].Value)
+		if err != nil {
+			return fmt.Errorf("invalid value format")
+		}
+		if curValueDec.GT(sdk.NewDec(1)) {
+			return fmt.Errorf("invalid value (GT1)")
+		}
+		if curValueDec.LT(sdk.MustNewDecFromStr("0.0001")) {
+			return fmt.Errorf("invalid value (LT0.0001)")
 		}
 	}
 
@@ -121,11 +215,167 @@ func validateChatRewardLog(i interface{}) error {
 }
 
 func validateMaxPhoneNumber(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max phone number must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRedeemPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("redeem period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateChatSessionPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("chat session period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardPoolFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward pool fee share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMobileMarketFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mobile market fee must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxBlockedUsers(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max blocked users must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMobilePortFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	if v.Denom != config.BaseDenom {
+		return fmt.Errorf("invalid coin denom: %s, expected %s", v.Denom, config.BaseDenom)
+	}
+
+	return nil
+}
+
+func validateMortgageSplit(i interface{}) error {
+	v, ok := i.([]MortgageRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool)
+	remain := 0
+	total := sdk.ZeroDec()
+	for _, recipient := range v {
+		if recipient.Name == "" {
+			return fmt.Errorf("mortgage recipient name cannot be empty")
+		}
+		if names[recipient.Name] {
+			return fmt.Errorf("duplicate mortgage recipient: %s", recipient.Name)
+		}
+		names[recipient.Name] = true
+
+		if recipient.Ratio.IsNil() || recipient.Ratio.IsNegative() || recipient.Ratio.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid mortgage ratio of %s: %s", recipient.Name, recipient.Ratio)
+		}
+		total = total.Add(recipient.Ratio)
+
+		switch recipient.RecipientType {
+		case MortgageRecipientRemain:
+			remain++
+		case MortgageRecipientNode, MortgageRecipientBurn:
+		case MortgageRecipientAddress:
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return fmt.Errorf("invalid mortgage recipient address of %s: %s", recipient.Name, err)
+			}
+		case MortgageRecipientModule:
+			if !mortgageRecipientModules[recipient.Address] {
+				return fmt.Errorf("invalid mortgage recipient module of %s: %s", recipient.Name, recipient.Address)
+			}
+		default:
+			return fmt.Errorf("invalid mortgage recipient type of %s: %s", recipient.Name, recipient.RecipientType)
+		}
+	}
+
+	if remain != 1 {
+		return fmt.Errorf("mortgage split must contain exactly one %s recipient", MortgageRecipientRemain)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("mortgage split ratios must sum to 1: %s", total)
+	}
 
 	return nil
 }
 
 func validateCoin(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	if v.Denom != config.BaseDenom {
+		return fmt.Errorf("invalid coin denom: %s, expected %s", v.Denom, config.BaseDenom)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("coin amount must be positive: %s", v)
+	}
 
 	return nil
 }
@@ -137,5 +387,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(KeyCoin, DefaultParams().MinMortgageCoin, validateCoin),
 		paramtypes.NewParamSetPair(KeyChatRewardLog, DefaultParams().ChatRewardLog, validateChatRewardLog),
 		paramtypes.NewParamSetPair(KeyMaxPhoneNumber, DefaultParams().MaxPhoneNumber, validateMaxPhoneNumber),
+		paramtypes.NewParamSetPair(KeyRedeemPeriod, DefaultParams().RedeemPeriod, validateRedeemPeriod),
+		paramtypes.NewParamSetPair(KeyMortgageSplit, DefaultParams().MortgageSplit, validateMortgageSplit),
+		paramtypes.NewParamSetPair(KeyChatSessionPeriod, DefaultParams().ChatSessionPeriod, validateChatSessionPeriod),
+		paramtypes.NewParamSetPair(KeyRewardPoolFeeShare, DefaultParams().RewardPoolFeeShare, validateRewardPoolFeeShare),
+		paramtypes.NewParamSetPair(KeyMobileMarketFee, DefaultParams().MobileMarketFee, validateMobileMarketFee),
+		paramtypes.NewParamSetPair(KeyMaxBlockedUsers, DefaultParams().MaxBlockedUsers, validateMaxBlockedUsers),
+		paramtypes.NewParamSetPair(KeyMobilePortFee, DefaultParams().MobilePortFee, validateMobilePortFee),
 	)
 }
+
+
+func GetChatRewardRatio(chatRewardLog []ChatReward, height int64) (sdk.Dec, error) {
+	ratio := sdk.ZeroDec()
+	for _, chatReward := range chatRewardLog {
+		if chatReward.Height > height {
+			break
+		}
+		value, err := sdk.NewDecFromStr(chatReward.Value)
+		if err != nil {
+			return sdk.Dec{}, ErrGetBonus
+		}
+		ratio = value
+	}
+	return ratio, nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	config.SetBech32Prefixes(sdk.GetConfig())
+
+	testCases := []struct {
+		name     string
+		malleate func(p *Params)
+		expPass  bool
+	}{
+		{"default", func(p *Params) {}, true},
+		{"invalid community address", func(p *Params) { p.CommunityAddress = "dex1invalid" }, false},
+		{"empty ecological address", func(p *Params) { p.EcologicalAddress = "" }, false},
+		{"zero min mortgage coin", func(p *Params) { p.MinMortgageCoin = sdk.NewCoin(config.BaseDenom, sdk.ZeroInt()) }, false},
+		{"negative min mortgage coin", func(p *Params) { p.MinMortgageCoin = sdk.Coin{Denom: config.BaseDenom, Amount: sdk.NewInt(-1)} }, false},
+		{"min mortgage coin in other denom", func(p *Params) { p.MinMortgageCoin = sdk.NewCoin("stake", sdk.NewInt(1)) }, false},
+		{"empty chat reward log", func(p *Params) { p.ChatRewardLog = nil }, false},
+		{"increasing chat reward log", func(p *Params) {
+			p.ChatRewardLog = []ChatReward{{Height: 1, Value: "0.01"}, {Height: 100, Value: "0.005"}}
+		}, true},
+		{"equal chat reward log heights", func(p *Params) {
+			p.ChatRewardLog = []ChatReward{{Height: 1, Value: "0.01"}, {Height: 1, Value: "0.005"}}
+		}, false},
+		{"decreasing chat reward log heights", func(p *Params) {
+			p.ChatRewardLog = []ChatReward{{Height: 100, Value: "0.01"}, {Height: 1, Value: "0.005"}}
+		}, false},
+		{"invalid last chat reward value", func(p *Params) {
+			p.ChatRewardLog = []ChatReward{{Height: 1, Value: "0.01"}, {Height: 100, Value: "2"}}
+		}, false},
+		{"single chat reward value too small", func(p *Params) {
+			p.ChatRewardLog = []ChatReward{{Height: 1, Value: "0.00001"}}
+		}, false},
+		{"zero max phone number", func(p *Params) { p.MaxPhoneNumber = 0 }, false},
+		{"zero redeem period", func(p *Params) { p.RedeemPeriod = 0 }, false},
+		{"zero chat session period", func(p *Params) { p.ChatSessionPeriod = 0 }, false},
+		{"zero reward pool fee share", func(p *Params) { p.RewardPoolFeeShare = sdk.ZeroDec() }, true},
+		{"negative reward pool fee share", func(p *Params) { p.RewardPoolFeeShare = sdk.NewDec(-1) }, false},
+		{"reward pool fee share above 1", func(p *Params) { p.RewardPoolFeeShare = sdk.NewDecWithPrec(11, 1) }, false},
+		{"mobile market fee", func(p *Params) { p.MobileMarketFee = sdk.NewDecWithPrec(5, 2) }, true},
+		{"negative mobile market fee", func(p *Params) { p.MobileMarketFee = sdk.NewDec(-1) }, false},
+		{"mobile market fee above 1", func(p *Params) { p.MobileMarketFee = sdk.NewDecWithPrec(11, 1) }, false},
+		{"zero max blocked users", func(p *Params) { p.MaxBlockedUsers = 0 }, false},
+		{"zero mobile port fee", func(p *Params) { p.MobilePortFee = sdk.NewCoin(config.BaseDenom, sdk.ZeroInt()) }, true},
+		{"mobile port fee wrong denom", func(p *Params) { p.MobilePortFee = sdk.NewCoin("stake", sdk.NewInt(1)) }, false},
+		{"mortgage split not summing to 1", func(p *Params) {
+			p.MortgageSplit = []MortgageRecipient{{Name: "remain", RecipientType: MortgageRecipientRemain, Ratio: sdk.NewDecWithPrec(9, 1)}}
+		}, false},
+		{"mortgage split to reward pool", func(p *Params) { setMortgageModule(p, RewardPoolName) }, true},
+		{"mortgage split to empty module", func(p *Params) { setMortgageModule(p, "") }, false},
+		{"mortgage split to unknown module", func(p *Params) { setMortgageModule(p, "unknown") }, false},
+		{"mortgage split to bonded pool", func(p *Params) { setMortgageModule(p, "bonded_tokens_pool") }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func setMortgageModule(p *Params, module string) {
+	split := append([]MortgageRecipient{}, p.MortgageSplit...)
+	for i := range split {
+		if split[i].RecipientType == MortgageRecipientModule {
+			split[i].Address = module
+		}
+	}
+	p.MortgageSplit = split
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetGift string = "SetGift"
+)
+
+var (
+	_ govtypes.Content = &SetGiftProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetGift)
+	govtypes.RegisterProposalTypeCodec(&SetGiftProposal{}, "chat/SetGiftProposal")
+}
+
+
+func NewSetGiftProposal(title, description string, gifts []Gift) govtypes.Content {
+	return &SetGiftProposal{
+		Title:       title,
+		Description: description,
+		Gifts:       gifts,
+	}
+}
+
+func (*SetGiftProposal) ProposalRoute() string { return RouterKey }
+
+func (*SetGiftProposal) ProposalType() string {
+	return ProposalTypeSetGift
+}
+
+func (p *SetGiftProposal) ValidateBasic() error {
+	if len(p.Gifts) == 0 {
+		return fmt.Errorf("gift list cannot be empty")
+	}
+
+	if err := ValidateGifts(p.Gifts); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+
+func ValidateGifts(gifts []Gift) error {
+	ids := make(map[int64]bool, len(gifts))
+	for _, gift := range gifts {
+		if err := gift.Validate(); err != nil {
+			return err
+		}
+		if ids[gift.Id] {
+			return fmt.Errorf("duplicate gift id %d", gift.Id)
+		}
+		ids[gift.Id] = true
+	}
+	return nil
+}
+
+func (g Gift) Validate() error {
+	if g.Id <= 0 {
+		return fmt.Errorf("gift id must be positive: %d", g.Id)
+	}
+
+	if g.Name == "" {
+		return fmt.Errorf("gift %d name cannot be empty", g.Id)
+	}
+
+	if err := g.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid gift %d price: %w", g.Id, err)
+	}
+
+	if g.Price.Denom != config.BaseDenom {
+		return fmt.Errorf("gift %d price denom must be %s: %s", g.Id, config.BaseDenom, g.Price.Denom)
+	}
+
+	if !g.Price.IsPositive() {
+		return fmt.Errorf("gift %d price must be positive: %s", g.Id, g.Price)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetGiftProposalValidateBasic(t *testing.T) {
+	price := sdk.NewCoin(config.BaseDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		name    string
+		gifts   []Gift
+		expPass bool
+	}{
+		{"valid", []Gift{{Id: 1, Name: "rose", Price: price, Enabled: true}, {Id: 2, Name: "car", Price: price}}, true},
+		{"empty", nil, false},
+		{"zero id", []Gift{{Id: 0, Name: "rose", Price: price}}, false},
+		{"empty name", []Gift{{Id: 1, Price: price}}, false},
+		{"duplicate id", []Gift{{Id: 1, Name: "rose", Price: price}, {Id: 1, Name: "car", Price: price}}, false},
+		{"zero price", []Gift{{Id: 1, Name: "rose", Price: sdk.NewCoin(config.BaseDenom, sdk.ZeroInt())}}, false},
+		{"other denom", []Gift{{Id: 1, Name: "rose", Price: sdk.NewCoin("stake", sdk.NewInt(1))}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewSetGiftProposal("title", "description", tc.gifts).ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
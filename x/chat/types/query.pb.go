@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -26,178 +29,1604 @@ var _ = math.Inf
 
 const _ = proto.GoGoProtoPackageIsVersion3
 
+type QueryUserInfoRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryUserInfoRequest) Reset()         { *m = QueryUserInfoRequest{} }
+func (m *QueryUserInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserInfoRequest) ProtoMessage()    {}
+func (*QueryUserInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{0}
+}
+func (m *QueryUserInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryUserInfoRequest.Unmarshal(m, b)
+}
+func (m *QueryUserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryUserInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryUserInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserInfoRequest.Merge(m, src)
+}
+func (m *QueryUserInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryUserInfoRequest.Size(m)
+}
+func (m *QueryUserInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserInfoRequest.DiscardUnknown(m)
+}
 
+var xxx_messageInfo_QueryUserInfoRequest proto.InternalMessageInfo
+
+func (m *QueryUserInfoRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-type TestQueryRequest struct {
+type QueryUserInfoResponse struct {
+	UserInfo             UserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TestQueryRequest) Reset()         { *m = TestQueryRequest{} }
-func (m *TestQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*TestQueryRequest) ProtoMessage()    {}
-func (*TestQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{0}
+func (m *QueryUserInfoResponse) Reset()         { *m = QueryUserInfoResponse{} }
+func (m *QueryUserInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserInfoResponse) ProtoMessage()    {}
+func (*QueryUserInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{1}
+}
+func (m *QueryUserInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryUserInfoResponse.Unmarshal(m, b)
+}
+func (m *QueryUserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryUserInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryUserInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserInfoResponse.Merge(m, src)
+}
+func (m *QueryUserInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryUserInfoResponse.Size(m)
+}
+func (m *QueryUserInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUserInfoResponse proto.InternalMessageInfo
+
+func (m *QueryUserInfoResponse) GetUserInfo() UserInfo {
+	if m != nil {
+		return m.UserInfo
+	}
+	return UserInfo{}
+}
+
+type QueryResolveMobileRequest struct {
+	Mobile               string   `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryResolveMobileRequest) Reset()         { *m = QueryResolveMobileRequest{} }
+func (m *QueryResolveMobileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveMobileRequest) ProtoMessage()    {}
+func (*QueryResolveMobileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{2}
 }
-func (m *TestQueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TestQueryRequest.Unmarshal(m, b)
+func (m *QueryResolveMobileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolveMobileRequest.Unmarshal(m, b)
 }
-func (m *TestQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TestQueryRequest.Marshal(b, m, deterministic)
+func (m *QueryResolveMobileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolveMobileRequest.Marshal(b, m, deterministic)
 }
-func (m *TestQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TestQueryRequest.Merge(m, src)
+func (m *QueryResolveMobileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveMobileRequest.Merge(m, src)
 }
-func (m *TestQueryRequest) XXX_Size() int {
-	return xxx_messageInfo_TestQueryRequest.Size(m)
+func (m *QueryResolveMobileRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryResolveMobileRequest.Size(m)
 }
-func (m *TestQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TestQueryRequest.DiscardUnknown(m)
+func (m *QueryResolveMobileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveMobileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TestQueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryResolveMobileRequest proto.InternalMessageInfo
 
+func (m *QueryResolveMobileRequest) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
 
+type QueryResolveMobileResponse struct {
 
-type TestQueryResponse struct {
-	TestR                string   `protobuf:"bytes,1,opt,name=testR,proto3" json:"testR,omitempty"`
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TestQueryResponse) Reset()         { *m = TestQueryResponse{} }
-func (m *TestQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*TestQueryResponse) ProtoMessage()    {}
-func (*TestQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{1}
+func (m *QueryResolveMobileResponse) Reset()         { *m = QueryResolveMobileResponse{} }
+func (m *QueryResolveMobileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveMobileResponse) ProtoMessage()    {}
+func (*QueryResolveMobileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{3}
 }
-func (m *TestQueryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TestQueryResponse.Unmarshal(m, b)
+func (m *QueryResolveMobileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResolveMobileResponse.Unmarshal(m, b)
 }
-func (m *TestQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TestQueryResponse.Marshal(b, m, deterministic)
+func (m *QueryResolveMobileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResolveMobileResponse.Marshal(b, m, deterministic)
 }
-func (m *TestQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TestQueryResponse.Merge(m, src)
+func (m *QueryResolveMobileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveMobileResponse.Merge(m, src)
 }
-func (m *TestQueryResponse) XXX_Size() int {
-	return xxx_messageInfo_TestQueryResponse.Size(m)
+func (m *QueryResolveMobileResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryResolveMobileResponse.Size(m)
 }
-func (m *TestQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TestQueryResponse.DiscardUnknown(m)
+func (m *QueryResolveMobileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveMobileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TestQueryResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryResolveMobileResponse proto.InternalMessageInfo
 
-func (m *TestQueryResponse) GetTestR() string {
+func (m *QueryResolveMobileResponse) GetAddress() string {
 	if m != nil {
-		return m.TestR
+		return m.Address
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*TestQueryRequest)(nil), "freemasonry.chat.v1.TestQueryRequest")
-	proto.RegisterType((*TestQueryResponse)(nil), "freemasonry.chat.v1.TestQueryResponse")
+type QueryUserByMobileRequest struct {
+	Mobile               string   `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+func (m *QueryUserByMobileRequest) Reset()         { *m = QueryUserByMobileRequest{} }
+func (m *QueryUserByMobileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserByMobileRequest) ProtoMessage()    {}
+func (*QueryUserByMobileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{4}
+}
+func (m *QueryUserByMobileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryUserByMobileRequest.Unmarshal(m, b)
+}
+func (m *QueryUserByMobileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryUserByMobileRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryUserByMobileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserByMobileRequest.Merge(m, src)
+}
+func (m *QueryUserByMobileRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryUserByMobileRequest.Size(m)
+}
+func (m *QueryUserByMobileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserByMobileRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_5c6ac9b241082464 = []byte{
+var xxx_messageInfo_QueryUserByMobileRequest proto.InternalMessageInfo
 
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x8f, 0xc1, 0x4a, 0xc6, 0x30,
-	0x10, 0x84, 0xed, 0xa1, 0x42, 0xe3, 0x45, 0x63, 0x0f, 0x52, 0x3c, 0x48, 0xc1, 0x52, 0x2f, 0x09,
-	0xea, 0x1b, 0xf8, 0x06, 0x16, 0x4f, 0xe2, 0x25, 0x0d, 0x6b, 0x5a, 0xac, 0xd9, 0x34, 0xbb, 0x15,
-	0xfb, 0xf6, 0xd2, 0x06, 0x44, 0x45, 0xf8, 0x6f, 0xb3, 0xc3, 0x7c, 0x3b, 0xbb, 0xe2, 0x64, 0x5e,
-	0x20, 0xae, 0x2a, 0x44, 0x64, 0x94, 0xe7, 0xaf, 0x11, 0xe0, 0xdd, 0x10, 0xfa, 0xb8, 0x2a, 0x3b,
-	0x18, 0x56, 0x1f, 0xb7, 0xd5, 0xa5, 0x43, 0x74, 0x13, 0x68, 0x13, 0x46, 0x6d, 0xbc, 0x47, 0x36,
-	0x3c, 0xa2, 0xa7, 0x84, 0x54, 0xa5, 0x43, 0x87, 0xbb, 0xd4, 0x9b, 0x4a, 0x6e, 0x2d, 0xc5, 0xe9,
-	0x13, 0x10, 0x3f, 0x6e, 0xbb, 0x3b, 0x98, 0x17, 0x20, 0xae, 0x6f, 0xc4, 0xd9, 0x0f, 0x8f, 0x02,
-	0x7a, 0x02, 0x59, 0x8a, 0x9c, 0x81, 0xb8, 0xbb, 0xc8, 0xae, 0xb2, 0xb6, 0xe8, 0xd2, 0x70, 0x07,
-	0x22, 0xdf, 0x63, 0xf2, 0x45, 0x14, 0xdf, 0x8c, 0xbc, 0x56, 0xff, 0x9c, 0xa7, 0xfe, 0xf6, 0x54,
-	0xcd, 0xa1, 0x58, 0xaa, 0xae, 0x8f, 0x1e, 0xda, 0xe7, 0xe6, 0x57, 0xd4, 0xea, 0x7e, 0x42, 0xfb,
-	0x66, 0x07, 0x33, 0x7a, 0xfd, 0xa9, 0x37, 0x54, 0xf3, 0x1a, 0x80, 0xfa, 0xe3, 0xfd, 0xad, 0xfb,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x5d, 0xa1, 0x03, 0x2e, 0x01, 0x00, 0x00,
+func (m *QueryUserByMobileRequest) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
 }
 
+type QueryUserByMobileResponse struct {
+	UserInfo             UserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryUserByMobileResponse) Reset()         { *m = QueryUserByMobileResponse{} }
+func (m *QueryUserByMobileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserByMobileResponse) ProtoMessage()    {}
+func (*QueryUserByMobileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{5}
+}
+func (m *QueryUserByMobileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryUserByMobileResponse.Unmarshal(m, b)
+}
+func (m *QueryUserByMobileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryUserByMobileResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryUserByMobileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserByMobileResponse.Merge(m, src)
+}
+func (m *QueryUserByMobileResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryUserByMobileResponse.Size(m)
+}
+func (m *QueryUserByMobileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserByMobileResponse.DiscardUnknown(m)
+}
 
+var xxx_messageInfo_QueryUserByMobileResponse proto.InternalMessageInfo
 
+func (m *QueryUserByMobileResponse) GetUserInfo() UserInfo {
+	if m != nil {
+		return m.UserInfo
+	}
+	return UserInfo{}
+}
 
-const _ = grpc.SupportPackageIsVersion4
+type QueryParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsRequest.Size(m)
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
 
-//
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type QueryClient interface {
+type QueryParamsResponse struct {
+	Params               Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-	TestQuery(ctx context.Context, in *TestQueryRequest, opts ...grpc.CallOption) (*TestQueryResponse, error)
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsResponse.Size(m)
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc *grpc.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryPendingRewardsRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *queryClient) TestQuery(ctx context.Context, in *TestQueryRequest, opts ...grpc.CallOption) (*TestQueryResponse, error) {
-	out := new(TestQueryResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/TestQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{8}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPendingRewardsRequest.Unmarshal(m, b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryPendingRewardsRequest.Size(m)
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
+type QueryPendingRewardsResponse struct {
 
-type QueryServer interface {
+	Rewards types.Coin `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
+
+	LastReceiveHeight    int64    `protobuf:"varint,2,opt,name=last_receive_height,json=lastReceiveHeight,proto3" json:"last_receive_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-	TestQuery(context.Context, *TestQueryRequest) (*TestQueryResponse, error)
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{9}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPendingRewardsResponse.Unmarshal(m, b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryPendingRewardsResponse.Size(m)
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
 
-type UnimplementedQueryServer struct {
+func (m *QueryPendingRewardsResponse) GetRewards() types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return types.Coin{}
+}
+
+func (m *QueryPendingRewardsResponse) GetLastReceiveHeight() int64 {
+	if m != nil {
+		return m.LastReceiveHeight
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) TestQuery(ctx context.Context, req *TestQueryRequest) (*TestQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TestQuery not implemented")
+type QueryAddressBookRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAddressBookRequest) Reset()         { *m = QueryAddressBookRequest{} }
+func (m *QueryAddressBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBookRequest) ProtoMessage()    {}
+func (*QueryAddressBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{10}
+}
+func (m *QueryAddressBookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAddressBookRequest.Unmarshal(m, b)
+}
+func (m *QueryAddressBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAddressBookRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryAddressBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBookRequest.Merge(m, src)
+}
+func (m *QueryAddressBookRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAddressBookRequest.Size(m)
+}
+func (m *QueryAddressBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBookRequest.DiscardUnknown(m)
 }
 
-func _Query_TestQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TestQueryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAddressBookRequest proto.InternalMessageInfo
+
+func (m *QueryAddressBookRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TestQuery(ctx, in)
+	return ""
+}
+
+type QueryAddressBookResponse struct {
+	AddressBook          AddressBook `protobuf:"bytes,1,opt,name=address_book,json=addressBook,proto3" json:"address_book"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *QueryAddressBookResponse) Reset()         { *m = QueryAddressBookResponse{} }
+func (m *QueryAddressBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBookResponse) ProtoMessage()    {}
+func (*QueryAddressBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{11}
+}
+func (m *QueryAddressBookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAddressBookResponse.Unmarshal(m, b)
+}
+func (m *QueryAddressBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAddressBookResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryAddressBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBookResponse.Merge(m, src)
+}
+func (m *QueryAddressBookResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAddressBookResponse.Size(m)
+}
+func (m *QueryAddressBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBookResponse proto.InternalMessageInfo
+
+func (m *QueryAddressBookResponse) GetAddressBook() AddressBook {
+	if m != nil {
+		return m.AddressBook
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/freemasonry.chat.v1.Query/TestQuery",
+	return AddressBook{}
+}
+
+type QueryChatSessionsRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryChatSessionsRequest) Reset()         { *m = QueryChatSessionsRequest{} }
+func (m *QueryChatSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChatSessionsRequest) ProtoMessage()    {}
+func (*QueryChatSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{12}
+}
+func (m *QueryChatSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChatSessionsRequest.Unmarshal(m, b)
+}
+func (m *QueryChatSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChatSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryChatSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChatSessionsRequest.Merge(m, src)
+}
+func (m *QueryChatSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryChatSessionsRequest.Size(m)
+}
+func (m *QueryChatSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChatSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChatSessionsRequest proto.InternalMessageInfo
+
+func (m *QueryChatSessionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TestQuery(ctx, req.(*TestQueryRequest))
+	return ""
+}
+
+type QueryChatSessionsResponse struct {
+
+	Sent []ChatSession `protobuf:"bytes,1,rep,name=sent,proto3" json:"sent"`
+
+	Received             []ChatSession `protobuf:"bytes,2,rep,name=received,proto3" json:"received"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueryChatSessionsResponse) Reset()         { *m = QueryChatSessionsResponse{} }
+func (m *QueryChatSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChatSessionsResponse) ProtoMessage()    {}
+func (*QueryChatSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{13}
+}
+func (m *QueryChatSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChatSessionsResponse.Unmarshal(m, b)
+}
+func (m *QueryChatSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChatSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryChatSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChatSessionsResponse.Merge(m, src)
+}
+func (m *QueryChatSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryChatSessionsResponse.Size(m)
+}
+func (m *QueryChatSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChatSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChatSessionsResponse proto.InternalMessageInfo
+
+func (m *QueryChatSessionsResponse) GetSent() []ChatSession {
+	if m != nil {
+		return m.Sent
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "freemasonry.chat.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
+func (m *QueryChatSessionsResponse) GetReceived() []ChatSession {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+type QueryGiftsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGiftsRequest) Reset()         { *m = QueryGiftsRequest{} }
+func (m *QueryGiftsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGiftsRequest) ProtoMessage()    {}
+func (*QueryGiftsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{14}
+}
+func (m *QueryGiftsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGiftsRequest.Unmarshal(m, b)
+}
+func (m *QueryGiftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGiftsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGiftsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGiftsRequest.Merge(m, src)
+}
+func (m *QueryGiftsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGiftsRequest.Size(m)
+}
+func (m *QueryGiftsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGiftsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGiftsRequest proto.InternalMessageInfo
+
+type QueryGiftsResponse struct {
+	Gifts                []Gift   `protobuf:"bytes,1,rep,name=gifts,proto3" json:"gifts"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGiftsResponse) Reset()         { *m = QueryGiftsResponse{} }
+func (m *QueryGiftsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGiftsResponse) ProtoMessage()    {}
+func (*QueryGiftsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{15}
+}
+func (m *QueryGiftsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGiftsResponse.Unmarshal(m, b)
+}
+func (m *QueryGiftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGiftsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGiftsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGiftsResponse.Merge(m, src)
+}
+func (m *QueryGiftsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGiftsResponse.Size(m)
+}
+func (m *QueryGiftsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGiftsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGiftsResponse proto.InternalMessageInfo
+
+func (m *QueryGiftsResponse) GetGifts() []Gift {
+	if m != nil {
+		return m.Gifts
+	}
+	return nil
+}
+
+type QueryRewardPoolRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{16}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRewardPoolRequest.Unmarshal(m, b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRewardPoolRequest.Size(m)
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+type QueryRewardPoolResponse struct {
+
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+
+	Outstanding types.Coin `protobuf:"bytes,2,opt,name=outstanding,proto3" json:"outstanding"`
+
+	RewardPerCycle types.Coin `protobuf:"bytes,3,opt,name=reward_per_cycle,json=rewardPerCycle,proto3" json:"reward_per_cycle"`
+
+	RunwayCycles int64 `protobuf:"varint,4,opt,name=runway_cycles,json=runwayCycles,proto3" json:"runway_cycles,omitempty"`
+
+	RunwayBlocks         int64    `protobuf:"varint,5,opt,name=runway_blocks,json=runwayBlocks,proto3" json:"runway_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{17}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRewardPoolResponse.Unmarshal(m, b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRewardPoolResponse.Size(m)
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryRewardPoolResponse) GetOutstanding() types.Coin {
+	if m != nil {
+		return m.Outstanding
+	}
+	return types.Coin{}
+}
+
+func (m *QueryRewardPoolResponse) GetRewardPerCycle() types.Coin {
+	if m != nil {
+		return m.RewardPerCycle
+	}
+	return types.Coin{}
+}
+
+func (m *QueryRewardPoolResponse) GetRunwayCycles() int64 {
+	if m != nil {
+		return m.RunwayCycles
+	}
+	return 0
+}
+
+func (m *QueryRewardPoolResponse) GetRunwayBlocks() int64 {
+	if m != nil {
+		return m.RunwayBlocks
+	}
+	return 0
+}
+
+type QueryMobileListingsRequest struct {
+
+	Prefix               string             `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pagination           *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryMobileListingsRequest) Reset()         { *m = QueryMobileListingsRequest{} }
+func (m *QueryMobileListingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMobileListingsRequest) ProtoMessage()    {}
+func (*QueryMobileListingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{18}
+}
+func (m *QueryMobileListingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMobileListingsRequest.Unmarshal(m, b)
+}
+func (m *QueryMobileListingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMobileListingsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryMobileListingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMobileListingsRequest.Merge(m, src)
+}
+func (m *QueryMobileListingsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryMobileListingsRequest.Size(m)
+}
+func (m *QueryMobileListingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMobileListingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMobileListingsRequest proto.InternalMessageInfo
+
+func (m *QueryMobileListingsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryMobileListingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMobileListingsResponse struct {
+	Listings             []MobileListing     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryMobileListingsResponse) Reset()         { *m = QueryMobileListingsResponse{} }
+func (m *QueryMobileListingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMobileListingsResponse) ProtoMessage()    {}
+func (*QueryMobileListingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{19}
+}
+func (m *QueryMobileListingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMobileListingsResponse.Unmarshal(m, b)
+}
+func (m *QueryMobileListingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMobileListingsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryMobileListingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMobileListingsResponse.Merge(m, src)
+}
+func (m *QueryMobileListingsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryMobileListingsResponse.Size(m)
+}
+func (m *QueryMobileListingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMobileListingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMobileListingsResponse proto.InternalMessageInfo
+
+func (m *QueryMobileListingsResponse) GetListings() []MobileListing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *QueryMobileListingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMobileListingsBySellerRequest struct {
+	Seller               string             `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Pagination           *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryMobileListingsBySellerRequest) Reset()         { *m = QueryMobileListingsBySellerRequest{} }
+func (m *QueryMobileListingsBySellerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMobileListingsBySellerRequest) ProtoMessage()    {}
+func (*QueryMobileListingsBySellerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{20}
+}
+func (m *QueryMobileListingsBySellerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMobileListingsBySellerRequest.Unmarshal(m, b)
+}
+func (m *QueryMobileListingsBySellerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMobileListingsBySellerRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryMobileListingsBySellerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMobileListingsBySellerRequest.Merge(m, src)
+}
+func (m *QueryMobileListingsBySellerRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryMobileListingsBySellerRequest.Size(m)
+}
+func (m *QueryMobileListingsBySellerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMobileListingsBySellerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMobileListingsBySellerRequest proto.InternalMessageInfo
+
+func (m *QueryMobileListingsBySellerRequest) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *QueryMobileListingsBySellerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMobileListingsBySellerResponse struct {
+	Listings             []MobileListing     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryMobileListingsBySellerResponse) Reset()         { *m = QueryMobileListingsBySellerResponse{} }
+func (m *QueryMobileListingsBySellerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMobileListingsBySellerResponse) ProtoMessage()    {}
+func (*QueryMobileListingsBySellerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{21}
+}
+func (m *QueryMobileListingsBySellerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMobileListingsBySellerResponse.Unmarshal(m, b)
+}
+func (m *QueryMobileListingsBySellerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMobileListingsBySellerResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryMobileListingsBySellerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMobileListingsBySellerResponse.Merge(m, src)
+}
+func (m *QueryMobileListingsBySellerResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryMobileListingsBySellerResponse.Size(m)
+}
+func (m *QueryMobileListingsBySellerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMobileListingsBySellerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMobileListingsBySellerResponse proto.InternalMessageInfo
+
+func (m *QueryMobileListingsBySellerResponse) GetListings() []MobileListing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *QueryMobileListingsBySellerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBlockedUsersRequest struct {
+	Address              string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination           *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryBlockedUsersRequest) Reset()         { *m = QueryBlockedUsersRequest{} }
+func (m *QueryBlockedUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedUsersRequest) ProtoMessage()    {}
+func (*QueryBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{22}
+}
+func (m *QueryBlockedUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryBlockedUsersRequest.Unmarshal(m, b)
+}
+func (m *QueryBlockedUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryBlockedUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryBlockedUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedUsersRequest.Merge(m, src)
+}
+func (m *QueryBlockedUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryBlockedUsersRequest.Size(m)
+}
+func (m *QueryBlockedUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedUsersRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedUsersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBlockedUsersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBlockedUsersResponse struct {
+	Blocked              []string            `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryBlockedUsersResponse) Reset()         { *m = QueryBlockedUsersResponse{} }
+func (m *QueryBlockedUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedUsersResponse) ProtoMessage()    {}
+func (*QueryBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{23}
+}
+func (m *QueryBlockedUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryBlockedUsersResponse.Unmarshal(m, b)
+}
+func (m *QueryBlockedUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryBlockedUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryBlockedUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedUsersResponse.Merge(m, src)
+}
+func (m *QueryBlockedUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryBlockedUsersResponse.Size(m)
+}
+func (m *QueryBlockedUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedUsersResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedUsersResponse) GetBlocked() []string {
+	if m != nil {
+		return m.Blocked
+	}
+	return nil
+}
+
+func (m *QueryBlockedUsersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryUserInfoRequest)(nil), "freemasonry.chat.v1.QueryUserInfoRequest")
+	proto.RegisterType((*QueryUserInfoResponse)(nil), "freemasonry.chat.v1.QueryUserInfoResponse")
+	proto.RegisterType((*QueryResolveMobileRequest)(nil), "freemasonry.chat.v1.QueryResolveMobileRequest")
+	proto.RegisterType((*QueryResolveMobileResponse)(nil), "freemasonry.chat.v1.QueryResolveMobileResponse")
+	proto.RegisterType((*QueryUserByMobileRequest)(nil), "freemasonry.chat.v1.QueryUserByMobileRequest")
+	proto.RegisterType((*QueryUserByMobileResponse)(nil), "freemasonry.chat.v1.QueryUserByMobileResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "freemasonry.chat.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "freemasonry.chat.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "freemasonry.chat.v1.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "freemasonry.chat.v1.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryAddressBookRequest)(nil), "freemasonry.chat.v1.QueryAddressBookRequest")
+	proto.RegisterType((*QueryAddressBookResponse)(nil), "freemasonry.chat.v1.QueryAddressBookResponse")
+	proto.RegisterType((*QueryChatSessionsRequest)(nil), "freemasonry.chat.v1.QueryChatSessionsRequest")
+	proto.RegisterType((*QueryChatSessionsResponse)(nil), "freemasonry.chat.v1.QueryChatSessionsResponse")
+	proto.RegisterType((*QueryGiftsRequest)(nil), "freemasonry.chat.v1.QueryGiftsRequest")
+	proto.RegisterType((*QueryGiftsResponse)(nil), "freemasonry.chat.v1.QueryGiftsResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "freemasonry.chat.v1.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "freemasonry.chat.v1.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryMobileListingsRequest)(nil), "freemasonry.chat.v1.QueryMobileListingsRequest")
+	proto.RegisterType((*QueryMobileListingsResponse)(nil), "freemasonry.chat.v1.QueryMobileListingsResponse")
+	proto.RegisterType((*QueryMobileListingsBySellerRequest)(nil), "freemasonry.chat.v1.QueryMobileListingsBySellerRequest")
+	proto.RegisterType((*QueryMobileListingsBySellerResponse)(nil), "freemasonry.chat.v1.QueryMobileListingsBySellerResponse")
+	proto.RegisterType((*QueryBlockedUsersRequest)(nil), "freemasonry.chat.v1.QueryBlockedUsersRequest")
+	proto.RegisterType((*QueryBlockedUsersResponse)(nil), "freemasonry.chat.v1.QueryBlockedUsersResponse")
+}
+
+func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+
+var fileDescriptor_5c6ac9b241082464 = []byte{
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x71, 0x9a, 0xa4, 0xc9, 0x4b, 0x1a, 0xe8, 0x24, 0x4d, 0xbd, 0x4e, 0x0b, 0x91, 0x83,
+	0x92, 0x25, 0x4d, 0xec, 0xfc, 0x22, 0xfc, 0x10, 0x07, 0xba, 0x41, 0x94, 0x08, 0x90, 0x96, 0xad,
+	0x38, 0x80, 0x84, 0x2c, 0xef, 0xee, 0xc4, 0xb1, 0xe2, 0x78, 0xb6, 0x1e, 0x6f, 0xda, 0x55, 0x52,
+	0x84, 0x10, 0x07, 0x2e, 0x1c, 0x50, 0x8f, 0x1c, 0x10, 0x42, 0xe2, 0x42, 0x8f, 0xfc, 0x11, 0x70,
+	0xe6, 0xce, 0x89, 0x3f, 0x04, 0x79, 0xe6, 0x79, 0x33, 0x9b, 0xcc, 0xee, 0xba, 0x52, 0x0e, 0x9c,
+	0x36, 0x7e, 0x7e, 0x5f, 0xbf, 0x8f, 0x9f, 0xdf, 0xcc, 0x7c, 0x15, 0x98, 0x7a, 0xd4, 0xa6, 0x49,
+	0xc7, 0x69, 0x25, 0x2c, 0x65, 0x64, 0xf6, 0x20, 0xa1, 0xf4, 0xd8, 0xe7, 0x2c, 0x4e, 0x3a, 0x4e,
+	0xe3, 0xd0, 0x4f, 0x9d, 0x93, 0x4d, 0xeb, 0x4e, 0xc0, 0x58, 0x10, 0x51, 0xd7, 0x6f, 0x85, 0xae,
+	0x1f, 0xc7, 0x2c, 0xf5, 0xd3, 0x90, 0xc5, 0x5c, 0x4a, 0xac, 0xb9, 0x80, 0x05, 0x4c, 0xfc, 0xe9,
+	0x66, 0x7f, 0x61, 0xf4, 0xd5, 0x06, 0xe3, 0xc7, 0x8c, 0xbb, 0x75, 0x9f, 0x53, 0xf7, 0x64, 0xb3,
+	0x4e, 0x53, 0x7f, 0xd3, 0x6d, 0xb0, 0x30, 0xc6, 0xfb, 0xab, 0xea, 0x7d, 0x41, 0xd0, 0xcd, 0x6a,
+	0xf9, 0x41, 0x18, 0x8b, 0x12, 0x98, 0x4b, 0x32, 0x10, 0xf7, 0x64, 0xd3, 0x15, 0x40, 0x32, 0x76,
+	0x2b, 0x8f, 0x05, 0x34, 0xa6, 0x3c, 0x44, 0x18, 0x7b, 0x03, 0xe6, 0x3e, 0xcb, 0x1e, 0xf6, 0x39,
+	0xa7, 0xc9, 0x7e, 0x7c, 0xc0, 0x6a, 0xf4, 0x51, 0x9b, 0xf2, 0x94, 0x98, 0x70, 0xdd, 0x6f, 0x36,
+	0x13, 0xca, 0xb9, 0x69, 0x2c, 0x1a, 0xe5, 0xc9, 0x5a, 0x7e, 0x69, 0x7f, 0x01, 0xb7, 0x2e, 0x28,
+	0x78, 0x8b, 0xc5, 0x9c, 0x92, 0xf7, 0x61, 0xb2, 0xcd, 0x69, 0xe2, 0x85, 0xf1, 0x01, 0x13, 0xa2,
+	0xa9, 0xad, 0xbb, 0x8e, 0xa6, 0x3d, 0x4e, 0xae, 0xac, 0x8c, 0xfe, 0xf9, 0xcf, 0x6b, 0x2f, 0xd5,
+	0x26, 0xda, 0x78, 0x6d, 0x6f, 0x43, 0x49, 0x3c, 0xba, 0x46, 0x39, 0x8b, 0x4e, 0xe8, 0xa7, 0xac,
+	0x1e, 0x46, 0x34, 0x27, 0x9a, 0x87, 0xf1, 0x63, 0x11, 0x40, 0x20, 0xbc, 0xb2, 0x77, 0xc1, 0xd2,
+	0x89, 0x10, 0xaa, 0xff, 0x7b, 0x6c, 0x81, 0xd9, 0x7d, 0x8f, 0x4a, 0xa7, 0x58, 0xad, 0xaf, 0xa0,
+	0xa4, 0xd1, 0x5c, 0xd9, 0xfb, 0xcf, 0x01, 0x11, 0x8f, 0xaf, 0xfa, 0x89, 0x7f, 0xcc, 0x11, 0xc6,
+	0xae, 0xc2, 0x6c, 0x4f, 0x14, 0xcb, 0xbd, 0x03, 0xe3, 0x2d, 0x11, 0xc1, 0x5a, 0x0b, 0xda, 0x5a,
+	0x52, 0x84, 0x95, 0x50, 0xd0, 0x6d, 0x59, 0x95, 0xc6, 0xcd, 0x30, 0x0e, 0x6a, 0xf4, 0xb1, 0x9f,
+	0x34, 0xf9, 0xf0, 0x4f, 0xff, 0xbd, 0x01, 0x0b, 0x5a, 0x61, 0x17, 0xe9, 0x7a, 0x22, 0x43, 0xc8,
+	0x54, 0x72, 0xe4, 0xd4, 0x3a, 0xd9, 0xd4, 0x3a, 0x38, 0xaf, 0xce, 0x1e, 0x0b, 0x63, 0x24, 0xca,
+	0xf3, 0x89, 0x03, 0xb3, 0x91, 0xcf, 0x53, 0x2f, 0xa1, 0x0d, 0x1a, 0x9e, 0x50, 0xef, 0x90, 0x86,
+	0xc1, 0x61, 0x6a, 0x8e, 0x2c, 0x1a, 0xe5, 0x6b, 0xb5, 0x9b, 0xd9, 0xad, 0x9a, 0xbc, 0xf3, 0x91,
+	0xb8, 0x61, 0x6f, 0xc3, 0x6d, 0x41, 0x72, 0x5f, 0xa2, 0x55, 0x18, 0x3b, 0x1a, 0xce, 0x4f, 0xc1,
+	0xbc, 0x2c, 0x42, 0xf6, 0x7d, 0x98, 0xc6, 0x34, 0xaf, 0xce, 0xd8, 0x11, 0xbe, 0xc0, 0xa2, 0xb6,
+	0xa9, 0x8a, 0x1e, 0xdf, 0x63, 0xca, 0x3f, 0x0f, 0xd9, 0x3b, 0x58, 0x66, 0xef, 0xd0, 0x4f, 0x1f,
+	0x52, 0xce, 0xb3, 0xb5, 0x3f, 0x1c, 0xee, 0x27, 0x03, 0x4a, 0x1a, 0x19, 0xe2, 0xbd, 0x0b, 0xa3,
+	0x9c, 0xc6, 0xa9, 0x69, 0x2c, 0x5e, 0xeb, 0x8b, 0xa5, 0x08, 0x11, 0x4b, 0x68, 0x48, 0x05, 0x26,
+	0xb0, 0xad, 0x4d, 0x73, 0xe4, 0x85, 0xf4, 0x5d, 0x9d, 0x3d, 0x0b, 0x37, 0x05, 0xdc, 0x83, 0xf0,
+	0x20, 0xed, 0x4e, 0xe6, 0xc7, 0x40, 0xd4, 0x20, 0xa2, 0xbe, 0x09, 0x63, 0x41, 0x16, 0x40, 0xd6,
+	0x92, 0xb6, 0x56, 0x26, 0xc1, 0x22, 0x32, 0xdb, 0x36, 0x61, 0x1e, 0xd7, 0x71, 0x36, 0x11, 0x55,
+	0xc6, 0xa2, 0xbc, 0xcc, 0xf3, 0x11, 0xb8, 0x7d, 0xe9, 0xd6, 0xf9, 0xc8, 0xd5, 0xfd, 0xc8, 0x8f,
+	0x1b, 0xb4, 0xf0, 0xc8, 0x61, 0x3e, 0xb9, 0x0f, 0x53, 0xac, 0x9d, 0xf2, 0xd4, 0x17, 0xb3, 0x6c,
+	0x8e, 0x14, 0x93, 0xab, 0x1a, 0xb2, 0x0f, 0xaf, 0xc8, 0x01, 0xf6, 0x5a, 0x34, 0xf1, 0x1a, 0x9d,
+	0x46, 0x44, 0xcd, 0x6b, 0xc5, 0x9e, 0x33, 0x23, 0x85, 0x55, 0x9a, 0xec, 0x65, 0x32, 0xb2, 0x04,
+	0x37, 0x92, 0x76, 0xfc, 0xd8, 0xef, 0xc8, 0xc7, 0x70, 0x73, 0x54, 0x8c, 0xfe, 0xb4, 0x0c, 0x8a,
+	0x1c, 0xae, 0x24, 0xd5, 0x23, 0xd6, 0x38, 0xe2, 0xe6, 0x98, 0x9a, 0x54, 0x11, 0x31, 0xfb, 0x0c,
+	0x57, 0xb7, 0xdc, 0x9e, 0x3e, 0x09, 0x79, 0x1a, 0xc6, 0x01, 0x57, 0xb6, 0xb6, 0x56, 0x42, 0x0f,
+	0xc2, 0x27, 0xf9, 0xd6, 0x26, 0xaf, 0xc8, 0x87, 0x00, 0xe7, 0xe7, 0x08, 0x36, 0x63, 0xb9, 0xe7,
+	0x25, 0xe4, 0xb1, 0x97, 0xbf, 0x4a, 0xd5, 0x0f, 0xf2, 0xed, 0xb2, 0xa6, 0x28, 0xed, 0xe7, 0xf9,
+	0x1e, 0x71, 0xb1, 0x3c, 0x7e, 0xb0, 0x0f, 0x60, 0x22, 0xc2, 0x18, 0x0e, 0x88, 0xad, 0x1d, 0x90,
+	0x1e, 0x79, 0x3e, 0x8e, 0xb9, 0x92, 0x3c, 0xd0, 0xd0, 0xae, 0x0c, 0xa5, 0x95, 0x08, 0x3d, 0xb8,
+	0xdf, 0x19, 0x60, 0x6b, 0x70, 0x2b, 0x9d, 0x87, 0x34, 0x8a, 0x68, 0xa2, 0x74, 0x8d, 0x8b, 0x40,
+	0xde, 0x35, 0x79, 0x75, 0x65, 0x5d, 0xfb, 0xc3, 0x80, 0xa5, 0x81, 0x18, 0xff, 0xcf, 0xee, 0x9d,
+	0xe1, 0x4e, 0x27, 0x26, 0x8f, 0x36, 0xb3, 0x83, 0x6d, 0xf8, 0x4e, 0x77, 0x65, 0x4d, 0xfb, 0x1a,
+	0x4a, 0x9a, 0xea, 0xe7, 0x07, 0x7f, 0x5d, 0xc6, 0x45, 0xa3, 0x26, 0x6b, 0xf9, 0xe5, 0x95, 0xbd,
+	0xfd, 0xd6, 0x0f, 0x2f, 0xc3, 0x98, 0x00, 0x20, 0xcf, 0x0c, 0x98, 0xc8, 0x4f, 0x75, 0xf2, 0x86,
+	0xf6, 0x8b, 0xe8, 0x5c, 0x96, 0xb5, 0x5a, 0x24, 0x55, 0x56, 0xb6, 0x37, 0xbe, 0xfd, 0xfb, 0xdf,
+	0x67, 0x23, 0xab, 0xa4, 0xec, 0x2a, 0x1a, 0x37, 0x77, 0x75, 0x5d, 0xe7, 0xe1, 0x9e, 0x62, 0x9b,
+	0x9f, 0x92, 0xdf, 0x0c, 0xb8, 0xd1, 0xe3, 0x8a, 0x88, 0xd3, 0xbf, 0x9e, 0xce, 0x73, 0x59, 0x6e,
+	0xe1, 0x7c, 0x84, 0xdc, 0x11, 0x90, 0x0e, 0x59, 0xd3, 0x42, 0x26, 0x52, 0xe3, 0x49, 0x37, 0xe5,
+	0x9e, 0xca, 0xdf, 0xa7, 0xe4, 0x57, 0x03, 0xa6, 0x55, 0x4b, 0x45, 0xd6, 0x07, 0xf7, 0xe5, 0x82,
+	0x5d, 0xb3, 0x9c, 0xa2, 0xe9, 0x85, 0x28, 0x45, 0x2b, 0xeb, 0x9d, 0x4b, 0x94, 0xdf, 0x18, 0x30,
+	0x2e, 0xed, 0x14, 0x59, 0xe9, 0x5f, 0xb0, 0xc7, 0xbb, 0x59, 0xe5, 0xe1, 0x89, 0xc8, 0xb4, 0x24,
+	0x98, 0xee, 0x92, 0x05, 0x2d, 0x93, 0x34, 0x6e, 0xe4, 0x77, 0x03, 0x66, 0x7a, 0xbd, 0x17, 0x19,
+	0xf0, 0x89, 0xb4, 0xf6, 0xce, 0xda, 0x28, 0x2e, 0x40, 0xb4, 0x5d, 0x81, 0xb6, 0x41, 0x1c, 0x3d,
+	0x9a, 0x14, 0x79, 0xe8, 0xe4, 0x94, 0xf9, 0xfb, 0xd9, 0x80, 0x29, 0xc5, 0x2a, 0x91, 0xb5, 0xfe,
+	0x95, 0x2f, 0xdb, 0x38, 0x6b, 0xbd, 0x60, 0x36, 0x42, 0x6e, 0x0b, 0xc8, 0x75, 0x72, 0x4f, 0x0b,
+	0xa9, 0x5a, 0x3b, 0x85, 0x30, 0x1b, 0x3c, 0xd5, 0x6e, 0x0d, 0x1a, 0x3c, 0x8d, 0x9b, 0xb3, 0x9c,
+	0xa2, 0xe9, 0x85, 0x06, 0x2f, 0xfb, 0xf5, 0x38, 0x6a, 0x14, 0xca, 0x1f, 0x0d, 0x80, 0x73, 0xeb,
+	0x43, 0xee, 0x0d, 0x5a, 0x94, 0x17, 0xbc, 0x93, 0xb5, 0x56, 0x2c, 0x19, 0xf9, 0xca, 0x82, 0xcf,
+	0x26, 0x8b, 0x7d, 0x96, 0xaf, 0xb4, 0x3a, 0x19, 0xc4, 0x19, 0x8c, 0x09, 0xd7, 0x47, 0x96, 0xfb,
+	0x17, 0x50, 0xbd, 0xa2, 0xb5, 0x32, 0x34, 0x0f, 0x19, 0x6c, 0xc1, 0x70, 0x87, 0x58, 0x5a, 0x06,
+	0xe1, 0x15, 0xc9, 0x2f, 0x06, 0xcc, 0xf4, 0x9e, 0x94, 0x83, 0xd6, 0x81, 0xd6, 0x08, 0x59, 0x1b,
+	0xc5, 0x05, 0x48, 0xb6, 0x26, 0xc8, 0x96, 0xc9, 0xeb, 0x5a, 0x32, 0xb9, 0x4d, 0x78, 0xdd, 0x43,
+	0xf6, 0x2f, 0x03, 0xe6, 0xf5, 0xa7, 0x39, 0x79, 0xab, 0x68, 0xe9, 0x0b, 0x36, 0xc4, 0x7a, 0xfb,
+	0xc5, 0x85, 0xc8, 0xfe, 0x9e, 0x60, 0xdf, 0x25, 0x3b, 0x45, 0xd8, 0x5d, 0xe9, 0x6e, 0xdc, 0x53,
+	0xf9, 0x2b, 0xd7, 0x89, 0x7a, 0xca, 0x0e, 0x5a, 0x27, 0x1a, 0x2f, 0x60, 0x39, 0x45, 0xd3, 0x0b,
+	0xad, 0x13, 0x3c, 0xc8, 0xbd, 0x6c, 0xa3, 0x56, 0xd6, 0x49, 0xa5, 0xfc, 0xe5, 0x72, 0x4f, 0x99,
+	0x86, 0xcc, 0x6c, 0x1c, 0xfa, 0x61, 0xec, 0x3e, 0x91, 0xf2, 0xb4, 0xd3, 0xa2, 0xbc, 0x3e, 0x2e,
+	0xfe, 0xf9, 0xb1, 0xfd, 0xdf, 0x00, 0x3f, 0xe9, 0x91, 0xe8, 0xcb, 0x11, 0x00, 0x00,
+}
+
+
+var _ context.Context
+var _ grpc.ClientConn
+
+
+
+const _ = grpc.SupportPackageIsVersion4
+
+
+
+
+type QueryClient interface {
+
+	UserInfo(ctx context.Context, in *QueryUserInfoRequest, opts ...grpc.CallOption) (*QueryUserInfoResponse, error)
+
+	ResolveMobile(ctx context.Context, in *QueryResolveMobileRequest, opts ...grpc.CallOption) (*QueryResolveMobileResponse, error)
+
+	UserByMobile(ctx context.Context, in *QueryUserByMobileRequest, opts ...grpc.CallOption) (*QueryUserByMobileResponse, error)
+
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+
+	AddressBook(ctx context.Context, in *QueryAddressBookRequest, opts ...grpc.CallOption) (*QueryAddressBookResponse, error)
+
+	ChatSessions(ctx context.Context, in *QueryChatSessionsRequest, opts ...grpc.CallOption) (*QueryChatSessionsResponse, error)
+
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+
+	Gifts(ctx context.Context, in *QueryGiftsRequest, opts ...grpc.CallOption) (*QueryGiftsResponse, error)
+
+	MobileListings(ctx context.Context, in *QueryMobileListingsRequest, opts ...grpc.CallOption) (*QueryMobileListingsResponse, error)
+
+	MobileListingsBySeller(ctx context.Context, in *QueryMobileListingsBySellerRequest, opts ...grpc.CallOption) (*QueryMobileListingsBySellerResponse, error)
+
+	BlockedUsers(ctx context.Context, in *QueryBlockedUsersRequest, opts ...grpc.CallOption) (*QueryBlockedUsersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) UserInfo(ctx context.Context, in *QueryUserInfoRequest, opts ...grpc.CallOption) (*QueryUserInfoResponse, error) {
+	out := new(QueryUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/UserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveMobile(ctx context.Context, in *QueryResolveMobileRequest, opts ...grpc.CallOption) (*QueryResolveMobileResponse, error) {
+	out := new(QueryResolveMobileResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/ResolveMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserByMobile(ctx context.Context, in *QueryUserByMobileRequest, opts ...grpc.CallOption) (*QueryUserByMobileResponse, error) {
+	out := new(QueryUserByMobileResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/UserByMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressBook(ctx context.Context, in *QueryAddressBookRequest, opts ...grpc.CallOption) (*QueryAddressBookResponse, error) {
+	out := new(QueryAddressBookResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/AddressBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChatSessions(ctx context.Context, in *QueryChatSessionsRequest, opts ...grpc.CallOption) (*QueryChatSessionsResponse, error) {
+	out := new(QueryChatSessionsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/ChatSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Gifts(ctx context.Context, in *QueryGiftsRequest, opts ...grpc.CallOption) (*QueryGiftsResponse, error) {
+	out := new(QueryGiftsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/Gifts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MobileListings(ctx context.Context, in *QueryMobileListingsRequest, opts ...grpc.CallOption) (*QueryMobileListingsResponse, error) {
+	out := new(QueryMobileListingsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/MobileListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MobileListingsBySeller(ctx context.Context, in *QueryMobileListingsBySellerRequest, opts ...grpc.CallOption) (*QueryMobileListingsBySellerResponse, error) {
+	out := new(QueryMobileListingsBySellerResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/MobileListingsBySeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedUsers(ctx context.Context, in *QueryBlockedUsersRequest, opts ...grpc.CallOption) (*QueryBlockedUsersResponse, error) {
+	out := new(QueryBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Query/BlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+
+type QueryServer interface {
+
+	UserInfo(context.Context, *QueryUserInfoRequest) (*QueryUserInfoResponse, error)
+
+	ResolveMobile(context.Context, *QueryResolveMobileRequest) (*QueryResolveMobileResponse, error)
+
+	UserByMobile(context.Context, *QueryUserByMobileRequest) (*QueryUserByMobileResponse, error)
+
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+
+	AddressBook(context.Context, *QueryAddressBookRequest) (*QueryAddressBookResponse, error)
+
+	ChatSessions(context.Context, *QueryChatSessionsRequest) (*QueryChatSessionsResponse, error)
+
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+
+	Gifts(context.Context, *QueryGiftsRequest) (*QueryGiftsResponse, error)
+
+	MobileListings(context.Context, *QueryMobileListingsRequest) (*QueryMobileListingsResponse, error)
+
+	MobileListingsBySeller(context.Context, *QueryMobileListingsBySellerRequest) (*QueryMobileListingsBySellerResponse, error)
+
+	BlockedUsers(context.Context, *QueryBlockedUsersRequest) (*QueryBlockedUsersResponse, error)
+}
+
+
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) UserInfo(ctx context.Context, req *QueryUserInfoRequest) (*QueryUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
+}
+func (*UnimplementedQueryServer) ResolveMobile(ctx context.Context, req *QueryResolveMobileRequest) (*QueryResolveMobileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveMobile not implemented")
+}
+func (*UnimplementedQueryServer) UserByMobile(ctx context.Context, req *QueryUserByMobileRequest) (*QueryUserByMobileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserByMobile not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) AddressBook(ctx context.Context, req *QueryAddressBookRequest) (*QueryAddressBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBook not implemented")
+}
+func (*UnimplementedQueryServer) ChatSessions(ctx context.Context, req *QueryChatSessionsRequest) (*QueryChatSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatSessions not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) Gifts(ctx context.Context, req *QueryGiftsRequest) (*QueryGiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gifts not implemented")
+}
+func (*UnimplementedQueryServer) MobileListings(ctx context.Context, req *QueryMobileListingsRequest) (*QueryMobileListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MobileListings not implemented")
+}
+func (*UnimplementedQueryServer) MobileListingsBySeller(ctx context.Context, req *QueryMobileListingsBySellerRequest) (*QueryMobileListingsBySellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MobileListingsBySeller not implemented")
+}
+func (*UnimplementedQueryServer) BlockedUsers(ctx context.Context, req *QueryBlockedUsersRequest) (*QueryBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedUsers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/UserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserInfo(ctx, req.(*QueryUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveMobileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/ResolveMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveMobile(ctx, req.(*QueryResolveMobileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserByMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserByMobileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserByMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/UserByMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserByMobile(ctx, req.(*QueryUserByMobileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/AddressBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressBook(ctx, req.(*QueryAddressBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChatSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChatSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChatSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/ChatSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChatSessions(ctx, req.(*QueryChatSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Gifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/Gifts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gifts(ctx, req.(*QueryGiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MobileListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMobileListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MobileListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/MobileListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MobileListings(ctx, req.(*QueryMobileListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MobileListingsBySeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMobileListingsBySellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MobileListingsBySeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/MobileListingsBySeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MobileListingsBySeller(ctx, req.(*QueryMobileListingsBySellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Query/BlockedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedUsers(ctx, req.(*QueryBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "freemasonry.chat.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UserInfo",
+			Handler:    _Query_UserInfo_Handler,
+		},
+		{
+			MethodName: "ResolveMobile",
+			Handler:    _Query_ResolveMobile_Handler,
+		},
+		{
+			MethodName: "UserByMobile",
+			Handler:    _Query_UserByMobile_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "AddressBook",
+			Handler:    _Query_AddressBook_Handler,
+		},
+		{
+			MethodName: "ChatSessions",
+			Handler:    _Query_ChatSessions_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "Gifts",
+			Handler:    _Query_Gifts_Handler,
+		},
+		{
+			MethodName: "MobileListings",
+			Handler:    _Query_MobileListings_Handler,
+		},
+		{
+			MethodName: "MobileListingsBySeller",
+			Handler:    _Query_MobileListingsBySeller_Handler,
+		},
 		{
-			MethodName: "TestQuery",
-			Handler:    _Query_TestQuery_Handler,
+			MethodName: "BlockedUsers",
+			Handler:    _Query_BlockedUsers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
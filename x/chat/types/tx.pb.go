@@ -407,6 +407,642 @@ func (m *MsgMobileTransfer) GetMobile() string {
 }
 
 
+type MsgRedeem struct {
+	FromAddress          string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Amount               types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MsgRedeem) Reset()         { *m = MsgRedeem{} }
+func (m *MsgRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeem) ProtoMessage()    {}
+func (*MsgRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{7}
+}
+func (m *MsgRedeem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgRedeem.Unmarshal(m, b)
+}
+func (m *MsgRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgRedeem.Marshal(b, m, deterministic)
+}
+func (m *MsgRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeem.Merge(m, src)
+}
+func (m *MsgRedeem) XXX_Size() int {
+	return xxx_messageInfo_MsgRedeem.Size(m)
+}
+func (m *MsgRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeem proto.InternalMessageInfo
+
+func (m *MsgRedeem) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgRedeem) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+
+type MsgApplyMobile struct {
+	FromAddress          string   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	NodeAddress          string   `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty" yaml:"node_address"`
+	MobilePrefix         string   `protobuf:"bytes,3,opt,name=mobile_prefix,json=mobilePrefix,proto3" json:"mobile_prefix,omitempty" yaml:"mobile_prefix"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgApplyMobile) Reset()         { *m = MsgApplyMobile{} }
+func (m *MsgApplyMobile) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyMobile) ProtoMessage()    {}
+func (*MsgApplyMobile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{8}
+}
+func (m *MsgApplyMobile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgApplyMobile.Unmarshal(m, b)
+}
+func (m *MsgApplyMobile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgApplyMobile.Marshal(b, m, deterministic)
+}
+func (m *MsgApplyMobile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyMobile.Merge(m, src)
+}
+func (m *MsgApplyMobile) XXX_Size() int {
+	return xxx_messageInfo_MsgApplyMobile.Size(m)
+}
+func (m *MsgApplyMobile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyMobile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApplyMobile proto.InternalMessageInfo
+
+func (m *MsgApplyMobile) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgApplyMobile) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return ""
+}
+
+func (m *MsgApplyMobile) GetMobilePrefix() string {
+	if m != nil {
+		return m.MobilePrefix
+	}
+	return ""
+}
+
+
+type MsgReleaseMobile struct {
+	FromAddress          string   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Mobile               string   `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty" yaml:"mobile"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgReleaseMobile) Reset()         { *m = MsgReleaseMobile{} }
+func (m *MsgReleaseMobile) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseMobile) ProtoMessage()    {}
+func (*MsgReleaseMobile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{9}
+}
+func (m *MsgReleaseMobile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgReleaseMobile.Unmarshal(m, b)
+}
+func (m *MsgReleaseMobile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgReleaseMobile.Marshal(b, m, deterministic)
+}
+func (m *MsgReleaseMobile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseMobile.Merge(m, src)
+}
+func (m *MsgReleaseMobile) XXX_Size() int {
+	return xxx_messageInfo_MsgReleaseMobile.Size(m)
+}
+func (m *MsgReleaseMobile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseMobile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseMobile proto.InternalMessageInfo
+
+func (m *MsgReleaseMobile) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgReleaseMobile) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
+
+
+type MsgPayChat struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
+
+	Fee                  types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MsgPayChat) Reset()         { *m = MsgPayChat{} }
+func (m *MsgPayChat) String() string { return proto.CompactTextString(m) }
+func (*MsgPayChat) ProtoMessage()    {}
+func (*MsgPayChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{10}
+}
+func (m *MsgPayChat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgPayChat.Unmarshal(m, b)
+}
+func (m *MsgPayChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgPayChat.Marshal(b, m, deterministic)
+}
+func (m *MsgPayChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayChat.Merge(m, src)
+}
+func (m *MsgPayChat) XXX_Size() int {
+	return xxx_messageInfo_MsgPayChat.Size(m)
+}
+func (m *MsgPayChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayChat proto.InternalMessageInfo
+
+func (m *MsgPayChat) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgPayChat) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgPayChat) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+
+type MsgAcceptChat struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+
+	SenderAddress        string   `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty" yaml:"sender_address"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgAcceptChat) Reset()         { *m = MsgAcceptChat{} }
+func (m *MsgAcceptChat) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptChat) ProtoMessage()    {}
+func (*MsgAcceptChat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{11}
+}
+func (m *MsgAcceptChat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgAcceptChat.Unmarshal(m, b)
+}
+func (m *MsgAcceptChat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgAcceptChat.Marshal(b, m, deterministic)
+}
+func (m *MsgAcceptChat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptChat.Merge(m, src)
+}
+func (m *MsgAcceptChat) XXX_Size() int {
+	return xxx_messageInfo_MsgAcceptChat.Size(m)
+}
+func (m *MsgAcceptChat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptChat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptChat proto.InternalMessageInfo
+
+func (m *MsgAcceptChat) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgAcceptChat) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+
+type MsgListMobile struct {
+	FromAddress string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Mobile      string     `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty" yaml:"mobile"`
+	Price       types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+
+	ExpireHeight         int64    `protobuf:"varint,4,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty" yaml:"expire_height"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgListMobile) Reset()         { *m = MsgListMobile{} }
+func (m *MsgListMobile) String() string { return proto.CompactTextString(m) }
+func (*MsgListMobile) ProtoMessage()    {}
+func (*MsgListMobile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{12}
+}
+func (m *MsgListMobile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgListMobile.Unmarshal(m, b)
+}
+func (m *MsgListMobile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgListMobile.Marshal(b, m, deterministic)
+}
+func (m *MsgListMobile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListMobile.Merge(m, src)
+}
+func (m *MsgListMobile) XXX_Size() int {
+	return xxx_messageInfo_MsgListMobile.Size(m)
+}
+func (m *MsgListMobile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListMobile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListMobile proto.InternalMessageInfo
+
+func (m *MsgListMobile) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgListMobile) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
+
+func (m *MsgListMobile) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgListMobile) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+
+type MsgCancelListing struct {
+	FromAddress          string   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Mobile               string   `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty" yaml:"mobile"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCancelListing) Reset()         { *m = MsgCancelListing{} }
+func (m *MsgCancelListing) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelListing) ProtoMessage()    {}
+func (*MsgCancelListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{13}
+}
+func (m *MsgCancelListing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCancelListing.Unmarshal(m, b)
+}
+func (m *MsgCancelListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCancelListing.Marshal(b, m, deterministic)
+}
+func (m *MsgCancelListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelListing.Merge(m, src)
+}
+func (m *MsgCancelListing) XXX_Size() int {
+	return xxx_messageInfo_MsgCancelListing.Size(m)
+}
+func (m *MsgCancelListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelListing proto.InternalMessageInfo
+
+func (m *MsgCancelListing) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelListing) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
+
+
+type MsgBuyMobile struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Mobile      string `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty" yaml:"mobile"`
+
+	Price                types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MsgBuyMobile) Reset()         { *m = MsgBuyMobile{} }
+func (m *MsgBuyMobile) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyMobile) ProtoMessage()    {}
+func (*MsgBuyMobile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{14}
+}
+func (m *MsgBuyMobile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgBuyMobile.Unmarshal(m, b)
+}
+func (m *MsgBuyMobile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgBuyMobile.Marshal(b, m, deterministic)
+}
+func (m *MsgBuyMobile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyMobile.Merge(m, src)
+}
+func (m *MsgBuyMobile) XXX_Size() int {
+	return xxx_messageInfo_MsgBuyMobile.Size(m)
+}
+func (m *MsgBuyMobile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyMobile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyMobile proto.InternalMessageInfo
+
+func (m *MsgBuyMobile) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgBuyMobile) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
+
+func (m *MsgBuyMobile) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+
+type MsgSendToMobile struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+
+	Mobile               string     `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty" yaml:"mobile"`
+	Amount               types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MsgSendToMobile) Reset()         { *m = MsgSendToMobile{} }
+func (m *MsgSendToMobile) String() string { return proto.CompactTextString(m) }
+func (*MsgSendToMobile) ProtoMessage()    {}
+func (*MsgSendToMobile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{15}
+}
+func (m *MsgSendToMobile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSendToMobile.Unmarshal(m, b)
+}
+func (m *MsgSendToMobile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSendToMobile.Marshal(b, m, deterministic)
+}
+func (m *MsgSendToMobile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendToMobile.Merge(m, src)
+}
+func (m *MsgSendToMobile) XXX_Size() int {
+	return xxx_messageInfo_MsgSendToMobile.Size(m)
+}
+func (m *MsgSendToMobile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendToMobile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendToMobile proto.InternalMessageInfo
+
+func (m *MsgSendToMobile) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgSendToMobile) GetMobile() string {
+	if m != nil {
+		return m.Mobile
+	}
+	return ""
+}
+
+func (m *MsgSendToMobile) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+
+type MsgBlockUser struct {
+	FromAddress          string   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	BlockedAddress       string   `protobuf:"bytes,2,opt,name=blocked_address,json=blockedAddress,proto3" json:"blocked_address,omitempty" yaml:"blocked_address"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgBlockUser) Reset()         { *m = MsgBlockUser{} }
+func (m *MsgBlockUser) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockUser) ProtoMessage()    {}
+func (*MsgBlockUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
+}
+func (m *MsgBlockUser) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgBlockUser.Unmarshal(m, b)
+}
+func (m *MsgBlockUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgBlockUser.Marshal(b, m, deterministic)
+}
+func (m *MsgBlockUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockUser.Merge(m, src)
+}
+func (m *MsgBlockUser) XXX_Size() int {
+	return xxx_messageInfo_MsgBlockUser.Size(m)
+}
+func (m *MsgBlockUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockUser proto.InternalMessageInfo
+
+func (m *MsgBlockUser) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgBlockUser) GetBlockedAddress() string {
+	if m != nil {
+		return m.BlockedAddress
+	}
+	return ""
+}
+
+
+type MsgUnblockUser struct {
+	FromAddress          string   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	BlockedAddress       string   `protobuf:"bytes,2,opt,name=blocked_address,json=blockedAddress,proto3" json:"blocked_address,omitempty" yaml:"blocked_address"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUnblockUser) Reset()         { *m = MsgUnblockUser{} }
+func (m *MsgUnblockUser) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockUser) ProtoMessage()    {}
+func (*MsgUnblockUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
+}
+func (m *MsgUnblockUser) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUnblockUser.Unmarshal(m, b)
+}
+func (m *MsgUnblockUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUnblockUser.Marshal(b, m, deterministic)
+}
+func (m *MsgUnblockUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockUser.Merge(m, src)
+}
+func (m *MsgUnblockUser) XXX_Size() int {
+	return xxx_messageInfo_MsgUnblockUser.Size(m)
+}
+func (m *MsgUnblockUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockUser proto.InternalMessageInfo
+
+func (m *MsgUnblockUser) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgUnblockUser) GetBlockedAddress() string {
+	if m != nil {
+		return m.BlockedAddress
+	}
+	return ""
+}
+
+
+type MsgChangeGateway struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+
+	NodeAddress string `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty" yaml:"node_address"`
+
+	MobilePrefix string `protobuf:"bytes,3,opt,name=mobile_prefix,json=mobilePrefix,proto3" json:"mobile_prefix,omitempty" yaml:"mobile_prefix"`
+
+	PortMobile           bool     `protobuf:"varint,4,opt,name=port_mobile,json=portMobile,proto3" json:"port_mobile,omitempty" yaml:"port_mobile"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgChangeGateway) Reset()         { *m = MsgChangeGateway{} }
+func (m *MsgChangeGateway) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeGateway) ProtoMessage()    {}
+func (*MsgChangeGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{18}
+}
+func (m *MsgChangeGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgChangeGateway.Unmarshal(m, b)
+}
+func (m *MsgChangeGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgChangeGateway.Marshal(b, m, deterministic)
+}
+func (m *MsgChangeGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeGateway.Merge(m, src)
+}
+func (m *MsgChangeGateway) XXX_Size() int {
+	return xxx_messageInfo_MsgChangeGateway.Size(m)
+}
+func (m *MsgChangeGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangeGateway proto.InternalMessageInfo
+
+func (m *MsgChangeGateway) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgChangeGateway) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return ""
+}
+
+func (m *MsgChangeGateway) GetMobilePrefix() string {
+	if m != nil {
+		return m.MobilePrefix
+	}
+	return ""
+}
+
+func (m *MsgChangeGateway) GetPortMobile() bool {
+	if m != nil {
+		return m.PortMobile
+	}
+	return false
+}
+
+
 type MsgEmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -417,7 +1053,7 @@ func (m *MsgEmptyResponse) Reset()         { *m = MsgEmptyResponse{} }
 func (m *MsgEmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEmptyResponse) ProtoMessage()    {}
 func (*MsgEmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{7}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{19}
 }
 func (m *MsgEmptyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgEmptyResponse.Unmarshal(m, b)
@@ -447,7 +1083,7 @@ func (m *MsgTestResponse) Reset()         { *m = MsgTestResponse{} }
 func (m *MsgTestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTestResponse) ProtoMessage()    {}
 func (*MsgTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{8}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{20}
 }
 func (m *MsgTestResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgTestResponse.Unmarshal(m, b)
@@ -475,147 +1111,306 @@ func init() {
 	proto.RegisterType((*MsgAddressBookSave)(nil), "freemasonry.chat.v1.MsgAddressBookSave")
 	proto.RegisterType((*MsgGetRewards)(nil), "freemasonry.chat.v1.MsgGetRewards")
 	proto.RegisterType((*MsgMobileTransfer)(nil), "freemasonry.chat.v1.MsgMobileTransfer")
+	proto.RegisterType((*MsgRedeem)(nil), "freemasonry.chat.v1.MsgRedeem")
+	proto.RegisterType((*MsgApplyMobile)(nil), "freemasonry.chat.v1.MsgApplyMobile")
+	proto.RegisterType((*MsgReleaseMobile)(nil), "freemasonry.chat.v1.MsgReleaseMobile")
+	proto.RegisterType((*MsgPayChat)(nil), "freemasonry.chat.v1.MsgPayChat")
+	proto.RegisterType((*MsgAcceptChat)(nil), "freemasonry.chat.v1.MsgAcceptChat")
+	proto.RegisterType((*MsgListMobile)(nil), "freemasonry.chat.v1.MsgListMobile")
+	proto.RegisterType((*MsgCancelListing)(nil), "freemasonry.chat.v1.MsgCancelListing")
+	proto.RegisterType((*MsgBuyMobile)(nil), "freemasonry.chat.v1.MsgBuyMobile")
+	proto.RegisterType((*MsgSendToMobile)(nil), "freemasonry.chat.v1.MsgSendToMobile")
+	proto.RegisterType((*MsgBlockUser)(nil), "freemasonry.chat.v1.MsgBlockUser")
+	proto.RegisterType((*MsgUnblockUser)(nil), "freemasonry.chat.v1.MsgUnblockUser")
+	proto.RegisterType((*MsgChangeGateway)(nil), "freemasonry.chat.v1.MsgChangeGateway")
 	proto.RegisterType((*MsgEmptyResponse)(nil), "freemasonry.chat.v1.MsgEmptyResponse")
 	proto.RegisterType((*MsgTestResponse)(nil), "freemasonry.chat.v1.MsgTestResponse")
 }
 
-func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
-
-var fileDescriptor_0fd2153dc07d3b5c = []byte{
-
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xfe, 0xb5, 0xdd, 0xaf, 0x6c, 0xee, 0xfe, 0xd0, 0x6c, 0x8c, 0x6e, 0x42, 0x4b, 0x65, 0x89,
-	0x31, 0x84, 0x94, 0x68, 0x03, 0x09, 0x69, 0x12, 0x42, 0xeb, 0x04, 0x13, 0x42, 0x91, 0x50, 0x3a,
-	0x81, 0xc4, 0x25, 0x72, 0x1a, 0xc7, 0x8b, 0xd6, 0xc4, 0x55, 0xec, 0x95, 0xf5, 0x3b, 0xec, 0x83,
-	0x70, 0xe4, 0x63, 0xf0, 0x29, 0x72, 0xe7, 0x9a, 0x03, 0x07, 0x4e, 0xc8, 0x8e, 0xd3, 0xa6, 0x15,
-	0x81, 0x4e, 0x3d, 0x20, 0x71, 0xf3, 0xeb, 0xf7, 0x7d, 0x9e, 0xf8, 0x7d, 0xfd, 0xf8, 0x69, 0xc1,
-	0x32, 0xbf, 0x36, 0x06, 0x31, 0xe5, 0x54, 0xdb, 0xf4, 0x63, 0x8c, 0x43, 0xc4, 0x68, 0x14, 0x8f,
-	0x8c, 0xde, 0x05, 0xe2, 0xc6, 0xf0, 0x70, 0xf7, 0x01, 0xa1, 0x94, 0xf4, 0xb1, 0x89, 0x06, 0x81,
-	0x89, 0xa2, 0x88, 0x72, 0xc4, 0x03, 0x1a, 0xb1, 0x0c, 0xb2, 0xbb, 0x45, 0x28, 0xa1, 0x72, 0x69,
-	0x8a, 0x95, 0xda, 0xdd, 0xeb, 0x51, 0x16, 0x52, 0x66, 0xba, 0x88, 0x61, 0x73, 0x78, 0xe8, 0x62,
-	0x8e, 0x0e, 0xcd, 0x1e, 0x0d, 0xa2, 0x2c, 0x0f, 0x3f, 0x57, 0x41, 0xc3, 0x62, 0xc4, 0xc6, 0x24,
-	0x60, 0x1c, 0xc7, 0xda, 0x31, 0x58, 0xf5, 0x63, 0x1a, 0x3a, 0xc8, 0xf3, 0x62, 0xcc, 0x58, 0xab,
-	0xd2, 0xae, 0x1c, 0xac, 0x74, 0xee, 0xa7, 0x89, 0xbe, 0x39, 0x42, 0x61, 0xff, 0x18, 0x16, 0xb3,
-	0xd0, 0x6e, 0x88, 0xf0, 0x24, 0x8b, 0x04, 0x36, 0xa2, 0x1e, 0x1e, 0x63, 0xab, 0xb3, 0xd8, 0x62,
-	0x16, 0xda, 0x0d, 0x11, 0xe6, 0x58, 0x17, 0x6c, 0x84, 0x34, 0xe6, 0x04, 0x11, 0xec, 0xa0, 0x90,
-	0x5e, 0x45, 0xbc, 0x55, 0x6b, 0x57, 0x0e, 0x1a, 0x47, 0x3b, 0x46, 0xd6, 0x81, 0x21, 0x3a, 0x30,
-	0x54, 0x07, 0xc6, 0x29, 0x0d, 0xa2, 0xce, 0xde, 0xd7, 0x44, 0xff, 0x2f, 0x4d, 0xf4, 0xed, 0x8c,
-	0x7d, 0x06, 0x0f, 0xed, 0xf5, 0x7c, 0xe7, 0x44, 0x6e, 0x68, 0x2f, 0xc0, 0x5a, 0x48, 0xdd, 0xa0,
-	0x8f, 0x9d, 0x41, 0x8c, 0xfd, 0xe0, 0xba, 0xb5, 0x24, 0x0f, 0xd8, 0x4a, 0x13, 0x7d, 0x2b, 0xa7,
-	0x28, 0xa4, 0xa1, 0xbd, 0x9a, 0xc5, 0xef, 0xb2, 0xf0, 0x5b, 0x45, 0x8e, 0xca, 0x52, 0xa4, 0xff,
-	0xf2, 0xa8, 0xe0, 0x4d, 0x05, 0xac, 0x59, 0x8c, 0x74, 0x31, 0x3f, 0xbd, 0x40, 0xfc, 0x35, 0x5e,
-	0xac, 0xdb, 0x97, 0xa0, 0xe6, 0x63, 0x2c, 0x9b, 0xfc, 0xed, 0x29, 0x35, 0x75, 0x4a, 0xa0, 0x18,
-	0x31, 0x86, 0xb6, 0x40, 0xc2, 0xef, 0x99, 0x4a, 0xbb, 0x38, 0xf2, 0xce, 0x02, 0x9f, 0xff, 0xb5,
-	0xd1, 0x3f, 0x03, 0x80, 0xd3, 0x31, 0xb2, 0x26, 0x91, 0xf7, 0xd2, 0x44, 0x6f, 0x66, 0xc8, 0x49,
-	0x0e, 0xda, 0x2b, 0x9c, 0xe6, 0xa8, 0x27, 0xe0, 0x0e, 0x09, 0x7c, 0xee, 0x04, 0x9e, 0x54, 0x5c,
-	0xad, 0xa3, 0xa5, 0x89, 0xbe, 0x9e, 0x41, 0x54, 0x02, 0xda, 0x75, 0xb1, 0x7a, 0xe3, 0x69, 0xcf,
-	0x41, 0x43, 0xee, 0xa9, 0x9b, 0xfd, 0x5f, 0x02, 0xb6, 0xd3, 0x44, 0xd7, 0x0a, 0x80, 0xfc, 0xda,
-	0x80, 0x88, 0x94, 0xba, 0xbb, 0x40, 0x46, 0xce, 0x10, 0xf5, 0xaf, 0x70, 0xab, 0xfe, 0xa7, 0x59,
-	0xef, 0xa8, 0x59, 0x37, 0x0b, 0xb4, 0x12, 0x0a, 0xed, 0x15, 0x11, 0xbc, 0x97, 0xeb, 0x9b, 0x0a,
-	0xd0, 0x2c, 0x46, 0x54, 0x27, 0x1d, 0x4a, 0x2f, 0xbb, 0x68, 0xb8, 0xb0, 0xf4, 0x55, 0xc2, 0x71,
-	0x29, 0xbd, 0x6c, 0x55, 0xdb, 0xb5, 0x69, 0x6c, 0x31, 0x0b, 0xed, 0x06, 0x9a, 0x7c, 0x1b, 0xbe,
-	0x95, 0xaa, 0x3c, 0xc3, 0xdc, 0xc6, 0x9f, 0x50, 0xec, 0xb1, 0x45, 0x0e, 0x02, 0xbf, 0x54, 0x40,
-	0x53, 0xbe, 0x67, 0xf1, 0xc6, 0xcf, 0x63, 0x14, 0x31, 0x7f, 0x41, 0x03, 0x9c, 0x96, 0x47, 0x75,
-	0x4e, 0x79, 0x3c, 0x06, 0xf5, 0xcc, 0x67, 0x94, 0xa0, 0x9a, 0x69, 0xa2, 0xaf, 0x15, 0xfd, 0x08,
-	0xda, 0xaa, 0x00, 0x6a, 0xe0, 0xae, 0xc5, 0xc8, 0xab, 0x70, 0xc0, 0x47, 0x36, 0x66, 0x03, 0x1a,
-	0x31, 0x0c, 0x9b, 0x60, 0xc3, 0x62, 0xe4, 0x1c, 0x33, 0x9e, 0x6f, 0x1d, 0xfd, 0x58, 0x02, 0x35,
-	0x8b, 0x11, 0xad, 0x0b, 0x96, 0xc7, 0xc6, 0xde, 0x36, 0x7e, 0xf1, 0x93, 0x62, 0x14, 0xac, 0x7f,
-	0xf7, 0x61, 0x59, 0xc5, 0xd4, 0xf7, 0x04, 0xa9, 0xb0, 0xc0, 0x33, 0x61, 0x81, 0xa5, 0xa4, 0xb9,
-	0x49, 0xce, 0x4b, 0xfa, 0x01, 0x80, 0x82, 0xd7, 0xc0, 0x32, 0xd0, 0xa4, 0xe6, 0x16, 0xa7, 0x1d,
-	0xbb, 0x46, 0xbb, 0x9c, 0x36, 0xab, 0x98, 0x97, 0x14, 0x81, 0x8d, 0xd9, 0x17, 0xf1, 0xa8, 0x0c,
-	0x39, 0x53, 0x78, 0x8b, 0x81, 0x14, 0x64, 0x5e, 0x3a, 0x90, 0x49, 0xcd, 0xbc, 0xc4, 0x0e, 0x58,
-	0x9f, 0x51, 0xfc, 0x7e, 0xf9, 0x25, 0x16, 0xeb, 0xe6, 0xfc, 0x40, 0xe7, 0xe0, 0xe3, 0xfe, 0x54,
-	0x5d, 0xcf, 0x74, 0xfb, 0xb4, 0x77, 0xd9, 0xbb, 0x40, 0x41, 0x64, 0x5e, 0x9b, 0x02, 0x67, 0xf2,
-	0xd1, 0x00, 0x33, 0xb7, 0x2e, 0xff, 0x82, 0x3c, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0x20, 0x03,
-	0x91, 0xa5, 0xf7, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
+
+var fileDescriptor_0fd2153dc07d3b5c = []byte{
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x23, 0xb5,
+	0x17, 0xff, 0x4e, 0xf2, 0xdd, 0x6c, 0xfb, 0xf2, 0xa3, 0x74, 0xb6, 0x5d, 0xd2, 0x0a, 0x35, 0xc5,
+	0xb0, 0x4b, 0x11, 0x52, 0xa2, 0x2e, 0x48, 0x48, 0x2b, 0x21, 0x68, 0xaa, 0xa5, 0x8b, 0x20, 0x62,
+	0x99, 0xb4, 0x20, 0x40, 0x28, 0x72, 0x32, 0xce, 0x64, 0xd4, 0x64, 0x3c, 0x1a, 0xbb, 0xdd, 0xe6,
+	0xca, 0x79, 0x11, 0xda, 0xff, 0x82, 0x13, 0xe2, 0x0f, 0x40, 0x42, 0xe2, 0x02, 0x7f, 0x45, 0xee,
+	0x5c, 0x73, 0xe0, 0xc4, 0x01, 0x79, 0xec, 0xc9, 0x78, 0x02, 0x43, 0x27, 0x04, 0xb5, 0x2c, 0xb7,
+	0xb1, 0xdf, 0xfb, 0x7c, 0xf2, 0xfc, 0xfc, 0xf1, 0xf3, 0x73, 0x60, 0x85, 0x5f, 0xd4, 0xfd, 0x80,
+	0x72, 0x6a, 0xde, 0xea, 0x07, 0x84, 0x8c, 0x30, 0xa3, 0x5e, 0x30, 0xae, 0xf7, 0x06, 0x98, 0xd7,
+	0xcf, 0xf7, 0xb7, 0x5f, 0x70, 0x28, 0x75, 0x86, 0xa4, 0x81, 0x7d, 0xb7, 0x81, 0x3d, 0x8f, 0x72,
+	0xcc, 0x5d, 0xea, 0x31, 0x09, 0xd9, 0xde, 0x70, 0xa8, 0x43, 0xc3, 0xcf, 0x86, 0xf8, 0x52, 0xb3,
+	0x3b, 0x3d, 0xca, 0x46, 0x94, 0x35, 0xba, 0x98, 0x91, 0xc6, 0xf9, 0x7e, 0x97, 0x70, 0xbc, 0xdf,
+	0xe8, 0x51, 0xd7, 0x93, 0x76, 0xf4, 0x4d, 0x0e, 0x8a, 0x2d, 0xe6, 0x58, 0xc4, 0x71, 0x19, 0x27,
+	0x81, 0x79, 0x1f, 0x4a, 0xfd, 0x80, 0x8e, 0x3a, 0xd8, 0xb6, 0x03, 0xc2, 0x58, 0xd5, 0xd8, 0x35,
+	0xf6, 0x56, 0x9b, 0xcf, 0x4f, 0x27, 0xb5, 0x5b, 0x63, 0x3c, 0x1a, 0xde, 0x47, 0xba, 0x15, 0x59,
+	0x45, 0x31, 0x3c, 0x90, 0x23, 0x81, 0xf5, 0xa8, 0x4d, 0x66, 0xd8, 0xdc, 0x3c, 0x56, 0xb7, 0x22,
+	0xab, 0x28, 0x86, 0x11, 0xb6, 0x0b, 0x6b, 0x23, 0x1a, 0x70, 0x07, 0x3b, 0xa4, 0x83, 0x47, 0xf4,
+	0xcc, 0xe3, 0xd5, 0xfc, 0xae, 0xb1, 0x57, 0xbc, 0xb7, 0x55, 0x97, 0x2b, 0xa8, 0x8b, 0x15, 0xd4,
+	0xd5, 0x0a, 0xea, 0x87, 0xd4, 0xf5, 0x9a, 0x3b, 0x3f, 0x4f, 0x6a, 0xff, 0x9b, 0x4e, 0x6a, 0xb7,
+	0x25, 0xfb, 0x1c, 0x1e, 0x59, 0x95, 0x68, 0xe6, 0x20, 0x9c, 0x30, 0xdf, 0x82, 0xf2, 0x88, 0x76,
+	0xdd, 0x21, 0xe9, 0xf8, 0x01, 0xe9, 0xbb, 0x17, 0xd5, 0xff, 0x87, 0x01, 0x56, 0xa7, 0x93, 0xda,
+	0x46, 0x44, 0xa1, 0x99, 0x91, 0x55, 0x92, 0xe3, 0x47, 0x72, 0xf8, 0x8b, 0x11, 0xa6, 0xaa, 0xa5,
+	0x48, 0xff, 0xcb, 0xa9, 0x42, 0x4f, 0x0c, 0x28, 0xb7, 0x98, 0xd3, 0x26, 0xfc, 0x70, 0x80, 0xf9,
+	0xbb, 0x64, 0xb9, 0xd5, 0xbe, 0x0d, 0xf9, 0x3e, 0x21, 0xd5, 0xdc, 0x65, 0x51, 0x9a, 0x2a, 0x4a,
+	0x50, 0x8c, 0x84, 0x20, 0x4b, 0x20, 0xd1, 0xaf, 0x52, 0xa5, 0x6d, 0xe2, 0xd9, 0x47, 0x6e, 0x9f,
+	0x5f, 0x5b, 0xea, 0xdf, 0x00, 0xe0, 0x74, 0x86, 0xcc, 0x87, 0xc8, 0xcd, 0xe9, 0xa4, 0xb6, 0x2e,
+	0x91, 0xb1, 0x0d, 0x59, 0xab, 0x9c, 0x46, 0xa8, 0xd7, 0xe0, 0xa6, 0xe3, 0xf6, 0x79, 0xc7, 0xb5,
+	0x43, 0xc5, 0xe5, 0x9b, 0xe6, 0x74, 0x52, 0xab, 0x48, 0x88, 0x32, 0x20, 0xab, 0x20, 0xbe, 0xde,
+	0xb3, 0xcd, 0x37, 0xa1, 0x18, 0xce, 0xa9, 0x9d, 0xbd, 0x11, 0x02, 0x6e, 0x4f, 0x27, 0x35, 0x53,
+	0x03, 0x44, 0xdb, 0x06, 0x62, 0xa4, 0xd4, 0xdd, 0x86, 0x70, 0xd4, 0x39, 0xc7, 0xc3, 0x33, 0x52,
+	0x2d, 0x5c, 0x96, 0xeb, 0x2d, 0x95, 0xeb, 0x75, 0x8d, 0x36, 0x84, 0x22, 0x6b, 0x55, 0x0c, 0x3e,
+	0x0e, 0xbf, 0x9f, 0x18, 0x60, 0xb6, 0x98, 0xa3, 0x56, 0xd2, 0xa4, 0xf4, 0xb4, 0x8d, 0xcf, 0x97,
+	0x96, 0xbe, 0x32, 0x74, 0xba, 0x94, 0x9e, 0x56, 0x73, 0xbb, 0xf9, 0x24, 0x56, 0xb7, 0x22, 0xab,
+	0x88, 0xe3, 0xdf, 0x46, 0xef, 0x87, 0xaa, 0x3c, 0x22, 0xdc, 0x22, 0x8f, 0x71, 0x60, 0xb3, 0x65,
+	0x02, 0x41, 0xdf, 0x19, 0xb0, 0x1e, 0x9e, 0x67, 0x71, 0xc6, 0x8f, 0x03, 0xec, 0xb1, 0xfe, 0x92,
+	0x05, 0x30, 0x29, 0x8f, 0x5c, 0x46, 0x79, 0xbc, 0x0a, 0x05, 0x59, 0x67, 0x94, 0xa0, 0xd6, 0xa7,
+	0x93, 0x5a, 0x59, 0xaf, 0x47, 0xc8, 0x52, 0x0e, 0xe8, 0xa9, 0x01, 0xab, 0x61, 0xb5, 0xb6, 0x09,
+	0x19, 0x2d, 0x15, 0xea, 0x43, 0x28, 0x28, 0x85, 0x5d, 0x7a, 0x2a, 0x37, 0x95, 0x52, 0x54, 0x4c,
+	0x91, 0xf6, 0x14, 0x1e, 0xfd, 0x64, 0x40, 0x45, 0x48, 0xc4, 0xf7, 0x87, 0x63, 0x99, 0xcb, 0x6b,
+	0x3b, 0x9e, 0x7f, 0x28, 0xf0, 0xf9, 0x85, 0x0a, 0xfc, 0x18, 0x9e, 0x0b, 0x93, 0x3b, 0x24, 0x98,
+	0x91, 0x7f, 0x60, 0x29, 0xf1, 0xc6, 0xe6, 0x2e, 0xdb, 0xd8, 0x1f, 0x0c, 0x80, 0x16, 0x73, 0x1e,
+	0xe1, 0xb1, 0xa8, 0xb7, 0xd7, 0x20, 0x42, 0x55, 0xa2, 0xf3, 0x7f, 0xbb, 0x44, 0x7f, 0x25, 0x6f,
+	0x8c, 0x83, 0x5e, 0x8f, 0xf8, 0x7c, 0xe9, 0x45, 0xbc, 0x03, 0x15, 0x46, 0x3c, 0x9b, 0x04, 0x73,
+	0x0b, 0xd9, 0x9a, 0x4e, 0x6a, 0x9b, 0x12, 0x9d, 0xb4, 0x23, 0xab, 0x2c, 0x27, 0xa2, 0xd3, 0xfd,
+	0x9b, 0x8c, 0xe7, 0x03, 0x97, 0xf1, 0x2b, 0xdd, 0x4a, 0xf3, 0x01, 0xdc, 0xf0, 0x03, 0xb7, 0x97,
+	0x21, 0x97, 0x1b, 0x2a, 0x97, 0x25, 0x49, 0x14, 0xa2, 0x90, 0x25, 0xd1, 0x42, 0xcb, 0xe4, 0xc2,
+	0x77, 0x03, 0xd2, 0x19, 0x10, 0xd7, 0x19, 0x70, 0x75, 0x75, 0x68, 0x5a, 0x4e, 0x98, 0x91, 0x55,
+	0x92, 0xe3, 0x87, 0x72, 0x28, 0xb5, 0x7c, 0x88, 0xbd, 0x1e, 0x19, 0x8a, 0x1c, 0xb8, 0x9e, 0x73,
+	0x55, 0x5a, 0xfe, 0xde, 0x80, 0x52, 0x8b, 0x39, 0xcd, 0xb3, 0xf1, 0xb3, 0x98, 0x78, 0xf4, 0xa3,
+	0x01, 0x6b, 0xaa, 0xd7, 0x38, 0xa6, 0x57, 0xbb, 0x82, 0xb8, 0x28, 0xe7, 0x97, 0x2c, 0xca, 0x5f,
+	0xab, 0x3d, 0x18, 0xd2, 0xde, 0xe9, 0x09, 0x5b, 0xf2, 0x5a, 0x3b, 0x84, 0xb5, 0xae, 0x20, 0x22,
+	0xf6, 0xdc, 0x69, 0xdc, 0x8e, 0x3b, 0xca, 0x39, 0x07, 0x64, 0x55, 0xd4, 0x4c, 0x74, 0x1e, 0x9f,
+	0xca, 0x6b, 0xe2, 0xc4, 0xeb, 0xfe, 0x7b, 0x62, 0xfa, 0x32, 0x27, 0x4f, 0xc9, 0x00, 0x7b, 0x0e,
+	0x39, 0xc2, 0x9c, 0x3c, 0xc6, 0xe3, 0x67, 0xf4, 0xf2, 0x12, 0x7d, 0xa3, 0x4f, 0x03, 0xde, 0x51,
+	0x5a, 0x13, 0xd5, 0x62, 0x45, 0xef, 0x1b, 0x35, 0x23, 0xb2, 0x40, 0x8c, 0xa4, 0xb6, 0x91, 0x19,
+	0xe6, 0xe0, 0xc1, 0xc8, 0xe7, 0x63, 0x8b, 0x30, 0x9f, 0x7a, 0x8c, 0xa0, 0xf5, 0xf0, 0x08, 0x1c,
+	0x13, 0xc6, 0xa3, 0xa9, 0x7b, 0xdf, 0x96, 0x21, 0xdf, 0x62, 0x8e, 0xd9, 0x86, 0x95, 0xd9, 0x63,
+	0x71, 0xb7, 0xfe, 0x27, 0xcf, 0xd4, 0xba, 0xf6, 0x9c, 0xdc, 0xbe, 0x93, 0xe6, 0x91, 0xf8, 0x3d,
+	0x41, 0x2a, 0x9e, 0x55, 0x47, 0xe2, 0x59, 0x95, 0x4a, 0x1a, 0x3d, 0xbc, 0xb2, 0x92, 0x7e, 0x02,
+	0xa0, 0xbd, 0x5f, 0x50, 0x1a, 0x28, 0xf6, 0x59, 0x20, 0xda, 0xd9, 0x4b, 0x64, 0x37, 0x9d, 0x56,
+	0x7a, 0x64, 0x25, 0xc5, 0xb0, 0x36, 0xdf, 0x65, 0xbf, 0x92, 0x86, 0x9c, 0x73, 0x5c, 0x20, 0x21,
+	0x5a, 0xeb, 0x9c, 0x9a, 0x90, 0xd8, 0x27, 0x2b, 0x71, 0x07, 0x2a, 0x73, 0x5d, 0xf4, 0xdd, 0xf4,
+	0x4d, 0xd4, 0xfd, 0xb2, 0xfe, 0xc0, 0x87, 0x50, 0x50, 0x3d, 0xef, 0x4e, 0xba, 0xe4, 0x84, 0x3d,
+	0x2b, 0xe1, 0xa7, 0x50, 0xd4, 0x1b, 0xd6, 0x97, 0x52, 0x33, 0x1d, 0x3b, 0x65, 0xa5, 0xfe, 0x02,
+	0xca, 0xc9, 0x16, 0xf2, 0x4e, 0x7a, 0xc8, 0x9a, 0x5b, 0x56, 0xfa, 0x8f, 0xe0, 0x66, 0xd4, 0x25,
+	0xd6, 0xd2, 0x10, 0xca, 0x61, 0x01, 0x5d, 0x68, 0x6d, 0x5b, 0xaa, 0x2e, 0x62, 0x9f, 0x05, 0x88,
+	0xb5, 0xfe, 0x2b, 0x95, 0x38, 0xf6, 0x59, 0x20, 0xc7, 0xc9, 0xd6, 0x26, 0x15, 0x97, 0x70, 0xcb,
+	0x4a, 0x7f, 0x02, 0xab, 0x71, 0xf7, 0xf2, 0x62, 0x1a, 0x66, 0xe6, 0x92, 0x95, 0xf6, 0x73, 0x28,
+	0x25, 0xba, 0x8a, 0x97, 0xff, 0xaa, 0x76, 0x1c, 0xd3, 0xc5, 0xc8, 0x45, 0xcc, 0xb3, 0x9b, 0x35,
+	0x3d, 0xe6, 0xc8, 0x65, 0x81, 0x83, 0xa2, 0x5f, 0xd9, 0xa9, 0x07, 0x45, 0x73, 0x5a, 0x64, 0x13,
+	0x13, 0x37, 0x6f, 0xfa, 0x26, 0xea, 0x6e, 0x19, 0xe9, 0x9b, 0x7b, 0x9f, 0xdd, 0x4d, 0xf8, 0xf5,
+	0x1a, 0x61, 0x84, 0xbd, 0x01, 0x76, 0xbd, 0xc6, 0x45, 0x43, 0xe0, 0x1a, 0x7c, 0xec, 0x13, 0xd6,
+	0x2d, 0x84, 0x7f, 0x85, 0xbe, 0xfe, 0xfb, 0x00, 0xbd, 0xb7, 0x7d, 0x44, 0x7f, 0x15, 0x00, 0x00,
+}
+
+
+var _ context.Context
+var _ grpc.ClientConn
+
+
+
+const _ = grpc.SupportPackageIsVersion4
+
+
+
+
+type MsgClient interface {
+	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	MortGage(ctx context.Context, in *MsgMortgage, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	SetChatFee(ctx context.Context, in *MsgSetChatFee, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	SendGift(ctx context.Context, in *MsgSendGift, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	AddressBookSave(ctx context.Context, in *MsgAddressBookSave, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	GetRewards(ctx context.Context, in *MsgGetRewards, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	MobileTransfer(ctx context.Context, in *MsgMobileTransfer, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	ApplyMobile(ctx context.Context, in *MsgApplyMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	ReleaseMobile(ctx context.Context, in *MsgReleaseMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	PayChat(ctx context.Context, in *MsgPayChat, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	AcceptChat(ctx context.Context, in *MsgAcceptChat, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	ListMobile(ctx context.Context, in *MsgListMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	CancelListing(ctx context.Context, in *MsgCancelListing, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	BuyMobile(ctx context.Context, in *MsgBuyMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	SendToMobile(ctx context.Context, in *MsgSendToMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	BlockUser(ctx context.Context, in *MsgBlockUser, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	UnblockUser(ctx context.Context, in *MsgUnblockUser, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+	ChangeGateway(ctx context.Context, in *MsgChangeGateway, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MortGage(ctx context.Context, in *MsgMortgage, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/MortGage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChatFee(ctx context.Context, in *MsgSetChatFee, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/SetChatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendGift(ctx context.Context, in *MsgSendGift, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/SendGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddressBookSave(ctx context.Context, in *MsgAddressBookSave, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/AddressBookSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetRewards(ctx context.Context, in *MsgGetRewards, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/GetRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MobileTransfer(ctx context.Context, in *MsgMobileTransfer, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/MobileTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/Redeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
+func (c *msgClient) ApplyMobile(ctx context.Context, in *MsgApplyMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/ApplyMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-var _ context.Context
-var _ grpc.ClientConn
-
-
-
-const _ = grpc.SupportPackageIsVersion4
-
-
-//
-
-type MsgClient interface {
-	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	MortGage(ctx context.Context, in *MsgMortgage, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	SetChatFee(ctx context.Context, in *MsgSetChatFee, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	SendGift(ctx context.Context, in *MsgSendGift, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	AddressBookSave(ctx context.Context, in *MsgAddressBookSave, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	GetRewards(ctx context.Context, in *MsgGetRewards, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	MobileTransfer(ctx context.Context, in *MsgMobileTransfer, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+func (c *msgClient) ReleaseMobile(ctx context.Context, in *MsgReleaseMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/ReleaseMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type msgClient struct {
-	cc *grpc.ClientConn
+func (c *msgClient) PayChat(ctx context.Context, in *MsgPayChat, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/PayChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewMsgClient(cc *grpc.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (c *msgClient) AcceptChat(ctx context.Context, in *MsgAcceptChat, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/AcceptChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) ListMobile(ctx context.Context, in *MsgListMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/Register", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/ListMobile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) MortGage(ctx context.Context, in *MsgMortgage, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) CancelListing(ctx context.Context, in *MsgCancelListing, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/MortGage", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/CancelListing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) SetChatFee(ctx context.Context, in *MsgSetChatFee, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) BuyMobile(ctx context.Context, in *MsgBuyMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/SetChatFee", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/BuyMobile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) SendGift(ctx context.Context, in *MsgSendGift, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) SendToMobile(ctx context.Context, in *MsgSendToMobile, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/SendGift", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/SendToMobile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) AddressBookSave(ctx context.Context, in *MsgAddressBookSave, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) BlockUser(ctx context.Context, in *MsgBlockUser, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/AddressBookSave", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/BlockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) GetRewards(ctx context.Context, in *MsgGetRewards, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) UnblockUser(ctx context.Context, in *MsgUnblockUser, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/GetRewards", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/UnblockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) MobileTransfer(ctx context.Context, in *MsgMobileTransfer, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+func (c *msgClient) ChangeGateway(ctx context.Context, in *MsgChangeGateway, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
 	out := new(MsgEmptyResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/MobileTransfer", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/freemasonry.chat.v1.Msg/ChangeGateway", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -631,6 +1426,18 @@ type MsgServer interface {
 	AddressBookSave(context.Context, *MsgAddressBookSave) (*MsgEmptyResponse, error)
 	GetRewards(context.Context, *MsgGetRewards) (*MsgEmptyResponse, error)
 	MobileTransfer(context.Context, *MsgMobileTransfer) (*MsgEmptyResponse, error)
+	Redeem(context.Context, *MsgRedeem) (*MsgEmptyResponse, error)
+	ApplyMobile(context.Context, *MsgApplyMobile) (*MsgEmptyResponse, error)
+	ReleaseMobile(context.Context, *MsgReleaseMobile) (*MsgEmptyResponse, error)
+	PayChat(context.Context, *MsgPayChat) (*MsgEmptyResponse, error)
+	AcceptChat(context.Context, *MsgAcceptChat) (*MsgEmptyResponse, error)
+	ListMobile(context.Context, *MsgListMobile) (*MsgEmptyResponse, error)
+	CancelListing(context.Context, *MsgCancelListing) (*MsgEmptyResponse, error)
+	BuyMobile(context.Context, *MsgBuyMobile) (*MsgEmptyResponse, error)
+	SendToMobile(context.Context, *MsgSendToMobile) (*MsgEmptyResponse, error)
+	BlockUser(context.Context, *MsgBlockUser) (*MsgEmptyResponse, error)
+	UnblockUser(context.Context, *MsgUnblockUser) (*MsgEmptyResponse, error)
+	ChangeGateway(context.Context, *MsgChangeGateway) (*MsgEmptyResponse, error)
 }
 
 
@@ -658,6 +1465,42 @@ func (*UnimplementedMsgServer) GetRewards(ctx context.Context, req *MsgGetReward
 func (*UnimplementedMsgServer) MobileTransfer(ctx context.Context, req *MsgMobileTransfer) (*MsgEmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MobileTransfer not implemented")
 }
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) ApplyMobile(ctx context.Context, req *MsgApplyMobile) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyMobile not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseMobile(ctx context.Context, req *MsgReleaseMobile) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseMobile not implemented")
+}
+func (*UnimplementedMsgServer) PayChat(ctx context.Context, req *MsgPayChat) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayChat not implemented")
+}
+func (*UnimplementedMsgServer) AcceptChat(ctx context.Context, req *MsgAcceptChat) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptChat not implemented")
+}
+func (*UnimplementedMsgServer) ListMobile(ctx context.Context, req *MsgListMobile) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMobile not implemented")
+}
+func (*UnimplementedMsgServer) CancelListing(ctx context.Context, req *MsgCancelListing) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelListing not implemented")
+}
+func (*UnimplementedMsgServer) BuyMobile(ctx context.Context, req *MsgBuyMobile) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyMobile not implemented")
+}
+func (*UnimplementedMsgServer) SendToMobile(ctx context.Context, req *MsgSendToMobile) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToMobile not implemented")
+}
+func (*UnimplementedMsgServer) BlockUser(ctx context.Context, req *MsgBlockUser) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (*UnimplementedMsgServer) UnblockUser(ctx context.Context, req *MsgUnblockUser) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (*UnimplementedMsgServer) ChangeGateway(ctx context.Context, req *MsgChangeGateway) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeGateway not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -789,6 +1632,222 @@ func _Msg_MobileTransfer_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/Redeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApplyMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApplyMobile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApplyMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/ApplyMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApplyMobile(ctx, req.(*MsgApplyMobile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseMobile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/ReleaseMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseMobile(ctx, req.(*MsgReleaseMobile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayChat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/PayChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayChat(ctx, req.(*MsgPayChat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptChat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/AcceptChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptChat(ctx, req.(*MsgAcceptChat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ListMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListMobile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/ListMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListMobile(ctx, req.(*MsgListMobile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelListing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/CancelListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelListing(ctx, req.(*MsgCancelListing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyMobile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/BuyMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyMobile(ctx, req.(*MsgBuyMobile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendToMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToMobile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendToMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/SendToMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToMobile(ctx, req.(*MsgSendToMobile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockUser(ctx, req.(*MsgBlockUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockUser(ctx, req.(*MsgUnblockUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.chat.v1.Msg/ChangeGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeGateway(ctx, req.(*MsgChangeGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "freemasonry.chat.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -821,6 +1880,54 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MobileTransfer",
 			Handler:    _Msg_MobileTransfer_Handler,
 		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "ApplyMobile",
+			Handler:    _Msg_ApplyMobile_Handler,
+		},
+		{
+			MethodName: "ReleaseMobile",
+			Handler:    _Msg_ReleaseMobile_Handler,
+		},
+		{
+			MethodName: "PayChat",
+			Handler:    _Msg_PayChat_Handler,
+		},
+		{
+			MethodName: "AcceptChat",
+			Handler:    _Msg_AcceptChat_Handler,
+		},
+		{
+			MethodName: "ListMobile",
+			Handler:    _Msg_ListMobile_Handler,
+		},
+		{
+			MethodName: "CancelListing",
+			Handler:    _Msg_CancelListing_Handler,
+		},
+		{
+			MethodName: "BuyMobile",
+			Handler:    _Msg_BuyMobile_Handler,
+		},
+		{
+			MethodName: "SendToMobile",
+			Handler:    _Msg_SendToMobile_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Msg_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Msg_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ChangeGateway",
+			Handler:    _Msg_ChangeGateway_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tx.proto",
@@ -12,18 +12,21 @@ const (
 	MsgTypeAddressBookSave = "chat/MsgTypeAddressBookSave"
 	MsgTypeGetRewards      = "chat/MsgTypeGetRewards"
 	MsgTypeMobileTransfer  = "chat/MsgTypeMobileTransfer"
+	MsgTypeRedeem          = "chat/MsgTypeRedeem"
+	MsgTypeApplyMobile     = "chat/MsgTypeApplyMobile"
+	MsgTypeReleaseMobile   = "chat/MsgTypeReleaseMobile"
+	MsgTypePayChat         = "chat/MsgTypePayChat"
+	MsgTypeAcceptChat      = "chat/MsgTypeAcceptChat"
+	MsgTypeListMobile      = "chat/MsgTypeListMobile"
+	MsgTypeCancelListing   = "chat/MsgTypeCancelListing"
+	MsgTypeBuyMobile       = "chat/MsgTypeBuyMobile"
+	MsgTypeSendToMobile    = "chat/MsgTypeSendToMobile"
+	MsgTypeBlockUser       = "chat/MsgTypeBlockUser"
+	MsgTypeUnblockUser     = "chat/MsgTypeUnblockUser"
+	MsgTypeChangeGateway   = "chat/MsgTypeChangeGateway"
 )
 
 
-type UserInfo struct {
-	FromAddress    string     `json:"from_address" yaml:"from_address"`
-	NodeAddress    string     `json:"node_address" yaml:"node_address"`
-	MortgageAmount types.Coin `json:"mortgage_amount" yaml:"mortgage_amount"`
-	CanRedemAmount types.Coin `json:"can_redem_amount" yaml:"can_redem_amount"`
-	Mobile         []string   `json:"mobile" yaml:"mobile"`
-	ChatFee        types.Coin `json:"chat_fee" yaml:"chat_fee"`
-}
-
 const (
 	TransferTypeToModule  = "to_module"
 	TransferTypeToAccount = "to_account"
@@ -44,17 +47,8 @@ type MortgageInfo struct {
 }
 
 type MortgageDevideInfo struct {
+	MortgageName    string `json:"mortgage_name"`
 	MortgageAddress string `json:"mortgage_address"` 
 	MortgageAmount  string `json:"mortgage_amount"`  
 	ShowBalance     bool   `json:"show_balance"`     
 }
-
-type LastReceiveLog struct {
-	Height int64      `json:"height"`
-	Value  types.Coin `json:"value"`
-}
-
-type MortgageAddLog struct {
-	Height        int64      `json:"height"`
-	MortgageValue types.Coin `json:"mortgage_value"`
-}
@@ -1,126 +1,366 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"freemasonry.cc/blockchain/x/comm/types"
 )
 
+const FlagGatewayAddress = "gateway"
+
 
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
-		Short:                      "Querying commands for the erc20 module",
+		Short:                      "Querying commands for the comm module",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
 
 	cmd.AddCommand(
-
-
-
+		GetGatewayCmd(),
+		GetGatewaysCmd(),
+		GetGatewayByNumberCmd(),
+		GetNumbersCmd(),
+		GetGatewayBonusCmd(),
+		GetAuctionsCmd(),
+		GetAuctionBidsCmd(),
+		GetGatewayUptimeCmd(),
+		GetGatewayEndpointsCmd(),
+		GetParamsCmd(),
 	)
 	return cmd
 }
 
-//
-
-
-
-
-
-
-
-
-
-
-
-
-//
-
-//
-
-
-
-
-//
-
-
-
-//
-
-
-
-
-//
-
-
-
-//
-
-
-
-//
-
-
-
-
-
-
-
-
-
-
-
-
-//
-
-//
-
-
-
-//
-
-
-
-
-//
-
 
+func GetGatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway [gateway-address]",
+		Short: "Get a registered gateway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGatewayRequest{
+				GatewayAddress: args[0],
+			}
+
+			res, err := queryClient.Gateway(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetGatewaysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateways",
+		Short: "Gets registered gateways",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGatewaysRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Gateways(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gateways")
+	return cmd
+}
 
 
+func GetGatewayByNumberCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-by-number [number]",
+		Short: "Get the gateway a mobile number belongs to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGatewayByNumberRequest{
+				Number: args[0],
+			}
+
+			res, err := queryClient.GatewayByNumber(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetNumbersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "numbers",
+		Short: "Gets registered number segments, optionally of a single gateway",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			gatewayAddress, _ := cmd.Flags().GetString(FlagGatewayAddress)
+
+			req := &types.QueryNumbersRequest{
+				GatewayAddress: gatewayAddress,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.Numbers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	cmd.Flags().String(FlagGatewayAddress, "", "Only list the number segments of this gateway")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "numbers")
+	return cmd
+}
 
 
+func GetGatewayBonusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-bonus [gateway-address]",
+		Short: "Get the bonus a gateway can withdraw",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGatewayBonusRequest{
+				GatewayAddress: args[0],
+			}
+
+			res, err := queryClient.GatewayBonus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "Get the open premium number segment auctions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuctionsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Auctions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+	return cmd
+}
 
-//
 
-//
+func GetAuctionBidsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-bids [number-index]",
+		Short: "Get an open premium number segment auction and its bid history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAuctionBidsRequest{
+				NumberIndex: args[0],
+			}
+
+			res, err := queryClient.AuctionBids(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetGatewayUptimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-uptime [gateway-address]",
+		Short: "Get the heartbeat liveness and uptime of a gateway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGatewayUptimeRequest{
+				GatewayAddress: args[0],
+			}
+
+			res, err := queryClient.GatewayUptime(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetGatewayEndpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-endpoints [number-index]",
+		Short: "Get the endpoints of the gateway hosting a number segment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGatewayEndpointsRequest{
+				NumberIndex: args[0],
+			}
+
+			res, err := queryClient.GatewayEndpoints(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
-//
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
 
 
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets comm params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,25 +1,300 @@
 package cli
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"freemasonry.cc/blockchain/x/comm/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+)
+
+const (
+	FlagGatewayName      = "name"
+	FlagGatewayUrl       = "url"
+	FlagDelegation       = "delegation"
+	FlagIndexNumber      = "index-number"
+	FlagPrivValidatorKey = "priv-validator-key"
+	FlagTLSFingerprint   = "tls-fingerprint"
+	FlagTransportPubKey  = "transport-pub-key"
 )
 
+const (
+	defaultCommissionRate          = "0.1"
+	defaultCommissionMaxRate       = "0.2"
+	defaultCommissionMaxChangeRate = "0.01"
+)
 
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
-		Short:                      "erc20 subcommands",
+		Short:                      "comm subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
 
 	txCmd.AddCommand(
-	
-	
+		NewGatewayRegisterCmd(),
+		NewGatewayDelegateCmd(),
+		NewGatewayUndelegateCmd(),
+		NewWithdrawGatewayBonusCmd(),
+		NewBidCmd(),
+		NewGatewayHeartbeatCmd(),
+		NewGatewayEditEndpointsCmd(),
 	)
 	return txCmd
 }
+
+func NewGatewayRegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-gateway",
+		Short: "Register a gateway, creating its validator from the node consensus key",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagGatewayName)
+			url, _ := cmd.Flags().GetString(FlagGatewayUrl)
+			indexNumber, _ := cmd.Flags().GetStringSlice(FlagIndexNumber)
+
+			delegation, _ := cmd.Flags().GetString(FlagDelegation)
+			if _, ok := sdk.NewIntFromString(delegation); !ok {
+				return fmt.Errorf("invalid delegation %s", delegation)
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagPrivValidatorKey)
+			if keyFile == "" {
+				keyFile = filepath.Join(cliCtx.HomeDir, "config", "priv_validator_key.json")
+			}
+			pubKey, err := ReadValidatorPubKey(keyFile)
+			if err != nil {
+				return err
+			}
+
+			rate, _ := cmd.Flags().GetString(stakingcli.FlagCommissionRate)
+			maxRate, _ := cmd.Flags().GetString(stakingcli.FlagCommissionMaxRate)
+			maxChangeRate, _ := cmd.Flags().GetString(stakingcli.FlagCommissionMaxChangeRate)
+			commission, err := BuildCommissionRates(rate, maxRate, maxChangeRate)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGatewayRegister(cliCtx.GetFromAddress().String(), name, url, delegation, pubKey, indexNumber, commission)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGatewayName, "", "The gateway name")
+	cmd.Flags().String(FlagGatewayUrl, "", "The gateway url")
+	cmd.Flags().String(FlagDelegation, "", "The self delegation amount in the bond denom")
+	cmd.Flags().StringSlice(FlagIndexNumber, []string{}, "The number segments to register, comma separated")
+	cmd.Flags().String(FlagPrivValidatorKey, "", "Path of priv_validator_key.json, defaults to <home>/config/priv_validator_key.json")
+	cmd.Flags().String(stakingcli.FlagCommissionRate, defaultCommissionRate, "The initial commission rate percentage")
+	cmd.Flags().String(stakingcli.FlagCommissionMaxRate, defaultCommissionMaxRate, "The maximum commission rate percentage")
+	cmd.Flags().String(stakingcli.FlagCommissionMaxChangeRate, defaultCommissionMaxChangeRate, "The maximum commission change rate percentage (per day)")
+	_ = cmd.MarkFlagRequired(FlagGatewayName)
+	_ = cmd.MarkFlagRequired(FlagDelegation)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGatewayDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-address] [amount]",
+		Short: "Delegate to a gateway, registering number segments when delegating to your own gateway",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			indexNumber, _ := cmd.Flags().GetStringSlice(FlagIndexNumber)
+
+			msg := types.NewMsgGatewayDelegation(cliCtx.GetFromAddress().String(), args[0], amount, indexNumber)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagIndexNumber, []string{}, "The number segments to register, comma separated")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGatewayUndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [validator-address] [amount]",
+		Short: "Undelegate from a gateway, releasing number segments when undelegating from your own gateway",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			indexNumber, _ := cmd.Flags().GetStringSlice(FlagIndexNumber)
+
+			msg := types.NewMsgGatewayUndelegation(cliCtx.GetFromAddress().String(), args[0], amount, indexNumber)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagIndexNumber, []string{}, "The number segments to release, comma separated")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewWithdrawGatewayBonusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-gateway-bonus",
+		Short: "Withdraw the bonus accrued by your own gateway",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawGatewayBonus(cliCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [number-index] [amount]",
+		Short: "Bid for a premium number segment with your own gateway",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBid(cliCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewGatewayHeartbeatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-heartbeat",
+		Short: "Report that your own gateway is online for the current heartbeat window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGatewayHeartbeat(cliCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+
+func NewGatewayEditEndpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-endpoints [transport=address]...",
+		Short: "Replace the endpoints, TLS fingerprint and transport key published by your own gateway",
+		Example: fmt.Sprintf("$ %s tx comm edit-endpoints ws=wss://gw.example.com/ws https=https://gw.example.com p2p=/dns4/gw.example.com/tcp/26656/p2p/<peer-id> --%s=<sha256-hex> --%s=<base64-key> --from=<key_or_address>",
+			version.AppName, FlagTLSFingerprint, FlagTransportPubKey),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			endpoints := make([]types.GatewayEndpoint, 0, len(args))
+			for _, arg := range args {
+				parts := strings.SplitN(arg, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid endpoint %s, expected transport=address", arg)
+				}
+				endpoints = append(endpoints, types.GatewayEndpoint{Transport: parts[0], Address: parts[1]})
+			}
+			tlsFingerprint, _ := cmd.Flags().GetString(FlagTLSFingerprint)
+			transportPubKey, _ := cmd.Flags().GetString(FlagTransportPubKey)
+
+			msg := types.NewMsgGatewayEditEndpoints(cliCtx.GetFromAddress().String(), endpoints, tlsFingerprint, transportPubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTLSFingerprint, "", "The lowercase hex SHA-256 fingerprint of the gateway TLS certificate")
+	cmd.Flags().String(FlagTransportPubKey, "", "The base64 public key used for message transport encryption")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
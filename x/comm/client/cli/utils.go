@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"encoding/base64"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/privval"
 )
 
 
@@ -23,3 +29,41 @@ func ParseMetadata(cdc codec.JSONCodec, metadataFile string) (banktypes.Metadata
 
 	return metadata, nil
 }
+
+
+func ReadValidatorPubKey(keyFile string) (string, error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(keyFile))
+	if err != nil {
+		return "", err
+	}
+
+	var pvKey privval.FilePVKey
+	if err = tmjson.Unmarshal(contents, &pvKey); err != nil {
+		return "", err
+	}
+	if pvKey.PubKey == nil {
+		return "", fmt.Errorf("no pub_key in %s", keyFile)
+	}
+
+	return base64.StdEncoding.EncodeToString(pvKey.PubKey.Bytes()), nil
+}
+
+
+func BuildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (stakingtypes.CommissionRates, error) {
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return stakingtypes.CommissionRates{}, err
+	}
+
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return stakingtypes.CommissionRates{}, err
+	}
+
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return stakingtypes.CommissionRates{}, err
+	}
+
+	return stakingtypes.NewCommissionRates(rate, maxRate, maxChangeRate), nil
+}
@@ -16,12 +16,94 @@ func InitGenesis(
 	data types.GenesisState,
 ) {
 	k.SetParams(ctx, data.Params)
+
+	for _, gateway := range data.Gateways {
+		if err := k.UpdateGatewayInfo(ctx, gateway); err != nil {
+			panic(err)
+		}
+	}
+
+	if len(data.GatewayNums) > 0 {
+		if err := k.SetGatewayNum(ctx, data.GatewayNums); err != nil {
+			panic(err)
+		}
+	}
+
+	if len(data.GatewayRedeemNums) > 0 {
+		if err := k.SetGatewayRedeemNum(ctx, data.GatewayRedeemNums); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, lastTime := range data.DelegateLastTimes {
+		if err := k.SetGatewayDelegateLastHeight(ctx, lastTime.DelegatorAddress, lastTime.ValidatorAddress, lastTime.Height); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, gatewayBonus := range data.GatewayBonuses {
+		k.SetGatewayBonus(ctx, gatewayBonus)
+	}
+
+	for _, auction := range data.PrefixAuctions {
+		k.SetPrefixAuction(ctx, auction)
+	}
+
+	bidCounts := make(map[string]uint64)
+	for _, bid := range data.PrefixBids {
+		k.SetPrefixBid(ctx, bidCounts[bid.NumberIndex], bid)
+		bidCounts[bid.NumberIndex]++
+	}
+
+	for _, userCount := range data.GatewayUserCounts {
+		k.SetGatewayUserCount(ctx, userCount.GatewayAddress, userCount.Count)
+	}
+
+	for _, gatewayAddress := range data.SlashedGateways {
+		k.SetSlashedGateway(ctx, gatewayAddress)
+	}
+
+	for _, liveness := range data.GatewayLivenesses {
+		k.SetGatewayLiveness(ctx, liveness)
+	}
+	for _, gateway := range data.Gateways {
+		k.InitGatewayLiveness(ctx, gateway.GatewayAddress)
+	}
 }
 
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	gateways, err := k.GetGatewayList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gatewayNums, err := k.GetGatewayNumList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gatewayRedeemNums, err := k.GetGatewayRedeemNumList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	delegateLastTimes, err := k.GetAllGatewayDelegateLastTime(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		
-		
+		Params:            k.GetParams(ctx),
+		Gateways:          gateways,
+		GatewayNums:       gatewayNums,
+		GatewayRedeemNums: gatewayRedeemNums,
+		DelegateLastTimes: delegateLastTimes,
+		GatewayBonuses:    k.GetAllGatewayBonus(ctx),
+		PrefixAuctions:    k.GetAllPrefixAuction(ctx),
+		PrefixBids:        k.GetAllPrefixBid(ctx),
+		GatewayLivenesses: k.GetAllGatewayLiveness(ctx),
+		GatewayUserCounts: k.GetAllGatewayUserCount(ctx),
+		SlashedGateways:   k.GetAllSlashedGateway(ctx),
 	}
 }
@@ -0,0 +1,63 @@
+package comm_test
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/comm"
+	"freemasonry.cc/blockchain/x/comm/keeper"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	config.SetBech32Prefixes(sdk.GetConfig())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey).WithBlockHeight(20)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName)
+	k := keeper.NewKeeper(storeKey, cdc, paramstore, nil, nil, nil)
+
+	gateway := sdk.ValAddress([]byte("gateway_____________")).String()
+	slashed := sdk.ValAddress([]byte("slashed_____________")).String()
+	bidder := sdk.AccAddress([]byte("gateway_____________")).String()
+	gatewayNum := types.GatewayNumIndex{GatewayAddress: gateway, NumberIndex: "1001", NumberEnd: []string{"100100001"}}
+	redeemNum := types.GatewayNumIndex{GatewayAddress: gateway, NumberIndex: "1002", Status: 1, Validity: 30}
+	bid := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))
+
+	genesis := types.GenesisState{
+		Params:            types.DefaultParams(),
+		Gateways:          []types.Gateway{{GatewayAddress: gateway, GatewayName: "gateway", GatewayQuota: 2, GatewayNum: []types.GatewayNumIndex{gatewayNum}}},
+		GatewayNums:       []types.GatewayNumIndex{gatewayNum, redeemNum},
+		GatewayRedeemNums: []types.GatewayNumIndex{redeemNum},
+		DelegateLastTimes: []types.DelegateLastTime{{DelegatorAddress: bidder, ValidatorAddress: gateway, Height: 10}},
+		GatewayBonuses:    []types.GatewayBonus{{GatewayAddress: gateway, Amount: bid}},
+		PrefixAuctions:    []types.PrefixAuction{{NumberIndex: "888888", Bidder: bidder, Amount: bid, StartHeight: 15, EndHeight: 25, BidCount: 1}},
+		PrefixBids:        []types.PrefixBid{{NumberIndex: "888888", Bidder: bidder, Amount: bid, Height: 15}},
+		GatewayLivenesses: []types.GatewayLiveness{{GatewayAddress: gateway, StartHeight: 5, LastHeartbeat: 18, HeartbeatCount: 3}},
+		GatewayUserCounts: []types.GatewayUserCount{{GatewayAddress: gateway, Count: 7}},
+		SlashedGateways:   []string{slashed},
+	}
+	require.NoError(t, genesis.Validate())
+
+	comm.InitGenesis(ctx, k, authkeeper.AccountKeeper{}, genesis)
+	require.Equal(t, uint64(7), k.GetGatewayUserCount(ctx, gateway))
+	require.Equal(t, []string{slashed}, k.GetAllSlashedGateway(ctx))
+
+	exported := comm.ExportGenesis(ctx, k)
+	require.Equal(t, string(cdc.MustMarshalJSON(&genesis)), string(cdc.MustMarshalJSON(exported)))
+
+	duplicate := genesis
+	duplicate.SlashedGateways = []string{slashed, slashed}
+	require.Error(t, duplicate.Validate())
+	duplicate = genesis
+	duplicate.GatewayUserCounts = append(duplicate.GatewayUserCounts, types.GatewayUserCount{GatewayAddress: gateway, Count: 1})
+	require.Error(t, duplicate.Validate())
+}
@@ -25,6 +25,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgGatewayUndelegate:
 			res, err := msgServer.GatewayUndelegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawGatewayBonus:
+			res, err := msgServer.WithdrawGatewayBonus(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBid:
+			res, err := msgServer.Bid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgGatewayHeartbeat:
+			res, err := msgServer.GatewayHeartbeat(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgGatewayEditEndpoints:
+			res, err := msgServer.GatewayEditEndpoints(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
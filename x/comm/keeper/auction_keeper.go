@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"strconv"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+
+func (k Keeper) PlacePrefixBid(ctx sdk.Context, bidder sdk.AccAddress, numberIndex string, amount sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if !params.IsPremiumPrefix(numberIndex) {
+		return types.ErrNotPremiumPrefix
+	}
+	_, isRegister, err := k.GetGatewayNum(ctx, numberIndex)
+	if err != nil {
+		return err
+	}
+	if !isRegister {
+		return types.ErrGatewayNumber
+	}
+	if _, err := k.GetGatewayInfo(ctx, sdk.ValAddress(bidder).String()); err != nil {
+		return err
+	}
+	if amount.Denom != sdk.DefaultBondDenom {
+		return sdkerrors.Wrapf(types.ErrDelegationCoin, "invalid bid denomination: got %s, expected %s", amount.Denom, sdk.DefaultBondDenom)
+	}
+	if amount.Amount.LT(params.AuctionMinBid) {
+		return sdkerrors.Wrapf(types.ErrBidTooLow, "minimum bid is %s", params.AuctionMinBid)
+	}
+
+	auction, found := k.GetPrefixAuction(ctx, numberIndex)
+	if found && !amount.Amount.GT(auction.Amount.Amount) {
+		return sdkerrors.Wrapf(types.ErrBidTooLow, "highest bid is %s", auction.Amount)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	if found {
+		previousBidder, err := sdk.AccAddressFromBech32(auction.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, previousBidder, sdk.NewCoins(auction.Amount))
+		if err != nil {
+			return err
+		}
+	} else {
+		auction = types.PrefixAuction{
+			NumberIndex: numberIndex,
+			StartHeight: ctx.BlockHeight(),
+			EndHeight:   ctx.BlockHeight() + params.AuctionPeriod,
+		}
+	}
+
+	k.SetPrefixBid(ctx, auction.BidCount, types.PrefixBid{
+		NumberIndex: numberIndex,
+		Bidder:      bidder.String(),
+		Amount:      amount,
+		Height:      ctx.BlockHeight(),
+	})
+	auction.Bidder = bidder.String()
+	auction.Amount = amount
+	auction.BidCount++
+	k.SetPrefixAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePrefixBid,
+			sdk.NewAttribute(types.AttributeKeyNumberIndex, numberIndex),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(auction.EndHeight, 10)),
+		),
+	)
+	return nil
+}
+
+
+func (k Keeper) SettlePrefixAuctions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixAuctionQueue, types.GetAuctionQueuePrefix(ctx.BlockHeight()+1))
+	numberIndexes := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		_, numberIndex := types.ParseAuctionQueueKey(iterator.Key())
+		numberIndexes = append(numberIndexes, numberIndex)
+	}
+	iterator.Close()
+
+	for _, numberIndex := range numberIndexes {
+		auction, found := k.GetPrefixAuction(ctx, numberIndex)
+		if !found {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.settlePrefixAuction(cacheCtx, auction)
+		if err != nil {
+			k.Logger(ctx).Error("settle prefix auction failed", "number_index", numberIndex, "err", err.Error())
+			continue
+		}
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	}
+}
+
+
+func (k Keeper) settlePrefixAuction(ctx sdk.Context, auction types.PrefixAuction) error {
+	bidder, err := sdk.AccAddressFromBech32(auction.Bidder)
+	if err != nil {
+		return err
+	}
+	gatewayAddress := sdk.ValAddress(bidder).String()
+
+	gateway, awarded, err := k.prefixAuctionWinner(ctx, gatewayAddress, auction.NumberIndex)
+	if err != nil {
+		return err
+	}
+	if awarded {
+		gatewayNum, _, err := k.GetGatewayNum(ctx, auction.NumberIndex)
+		if err != nil {
+			return err
+		}
+		if gatewayNum == nil {
+			gatewayNum = &types.GatewayNumIndex{NumberIndex: auction.NumberIndex}
+		}
+		gatewayNum.GatewayAddress = gatewayAddress
+		gatewayNum.Status = 0
+		gatewayNum.Validity = 0
+		gatewayNums := []types.GatewayNumIndex{*gatewayNum}
+
+		err = k.SetGatewayNum(ctx, gatewayNums)
+		if err != nil {
+			return err
+		}
+		err = k.GatewayRedeemNumFilter(ctx, gatewayNums)
+		if err != nil {
+			return err
+		}
+		gateway.GatewayNum = append(gateway.GatewayNum, *gatewayNum)
+		err = k.UpdateGatewayInfo(ctx, *gateway)
+		if err != nil {
+			return err
+		}
+
+		coins := sdk.NewCoins(auction.Amount)
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ModuleBurnName, coins)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleBurnName, coins)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(auction.Amount))
+		if err != nil {
+			return err
+		}
+	}
+
+	k.deletePrefixAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePrefixAuctionSettle,
+			sdk.NewAttribute(types.AttributeKeyNumberIndex, auction.NumberIndex),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder),
+			sdk.NewAttribute(types.AttributeKeyGateway, gatewayAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, auction.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAwarded, strconv.FormatBool(awarded)),
+		),
+	)
+	return nil
+}
+
+
+func (k Keeper) prefixAuctionWinner(ctx sdk.Context, gatewayAddress, numberIndex string) (*types.Gateway, bool, error) {
+	_, isRegister, err := k.GetGatewayNum(ctx, numberIndex)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isRegister {
+		return nil, false, nil
+	}
+	gateway, err := k.GetGatewayInfo(ctx, gatewayAddress)
+	if err != nil {
+		return nil, false, nil
+	}
+	if int64(len(gateway.GatewayNum)) >= gateway.GatewayQuota {
+		return gateway, false, nil
+	}
+	return gateway, true, nil
+}
+
+
+func (k Keeper) GetPrefixAuction(ctx sdk.Context, numberIndex string) (types.PrefixAuction, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPrefixAuctionKey(numberIndex))
+	if bz == nil {
+		return types.PrefixAuction{}, false
+	}
+	var auction types.PrefixAuction
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+func (k Keeper) SetPrefixAuction(ctx sdk.Context, auction types.PrefixAuction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPrefixAuctionKey(auction.NumberIndex), k.cdc.MustMarshal(&auction))
+	store.Set(types.GetAuctionQueueKey(auction.EndHeight, auction.NumberIndex), []byte{})
+}
+
+
+func (k Keeper) GetAllPrefixAuction(ctx sdk.Context) []types.PrefixAuction {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixPrefixAuction)
+	defer iterator.Close()
+
+	auctions := make([]types.PrefixAuction, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.PrefixAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+
+func (k Keeper) SetPrefixBid(ctx sdk.Context, seq uint64, bid types.PrefixBid) {
+	ctx.KVStore(k.storeKey).Set(types.GetPrefixBidKey(bid.NumberIndex, seq), k.cdc.MustMarshal(&bid))
+}
+
+
+func (k Keeper) GetPrefixBids(ctx sdk.Context, numberIndex string) []types.PrefixBid {
+	return k.getPrefixBids(ctx, types.GetPrefixBidPrefix(numberIndex))
+}
+
+
+func (k Keeper) GetAllPrefixBid(ctx sdk.Context) []types.PrefixBid {
+	return k.getPrefixBids(ctx, types.KeyPrefixPrefixBid)
+}
+
+func (k Keeper) getPrefixBids(ctx sdk.Context, prefix []byte) []types.PrefixBid {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	bids := make([]types.PrefixBid, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.PrefixBid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+func (k Keeper) deletePrefixAuction(ctx sdk.Context, auction types.PrefixAuction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPrefixAuctionKey(auction.NumberIndex))
+	store.Delete(types.GetAuctionQueueKey(auction.EndHeight, auction.NumberIndex))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPrefixBidPrefix(auction.NumberIndex))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
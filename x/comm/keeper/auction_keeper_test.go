@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixAuction(t *testing.T) {
+	k, _, bankKeeper, ctx := setupHooksKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := NewMsgServerImpl(k)
+	params := k.GetParams(ctx)
+	params.PremiumPrefixes = []string{"888888", "666666"}
+	params.AuctionPeriod = 10
+	params.AuctionMinBid = sdk.NewInt(100)
+	k.SetParams(ctx, params)
+
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 2, []string{"100100"})
+	alice := sdk.AccAddress(valAddress)
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	require.NoError(t, k.UpdateGatewayInfo(ctx, types.Gateway{GatewayAddress: sdk.ValAddress(bob).String()}))
+
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	for _, addr := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, bankKeeper.MintCoins(ctx, mintModuleName, funds))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, addr, funds))
+	}
+	bid := func(addr sdk.AccAddress, numberIndex string, amount int64) error {
+		_, err := msgServer.Bid(goCtx, types.NewMsgBid(addr.String(), numberIndex, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))))
+		return err
+	}
+	balance := func(addr sdk.AccAddress) int64 {
+		return bankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+	}
+
+	_, err := k.GatewayNumFilter(ctx, valAddress.String(), []string{"888888"})
+	require.ErrorIs(t, err, types.ErrPremiumPrefix)
+	require.ErrorIs(t, bid(alice, "777777", 100), types.ErrNotPremiumPrefix)
+	require.ErrorIs(t, bid(alice, "100100", 100), types.ErrNotPremiumPrefix)
+	require.ErrorIs(t, bid(alice, "888888", 50), types.ErrBidTooLow)
+
+	require.NoError(t, bid(alice, "888888", 100))
+	require.ErrorIs(t, bid(bob, "888888", 100), types.ErrBidTooLow)
+	require.NoError(t, bid(bob, "888888", 150))
+	require.Equal(t, int64(1000), balance(alice))
+	require.Equal(t, int64(850), balance(bob))
+	require.NoError(t, bid(alice, "888888", 200))
+	require.Equal(t, int64(800), balance(alice))
+	require.Equal(t, int64(1000), balance(bob))
+	require.NoError(t, bid(bob, "666666", 300))
+
+	auctions, err := k.Auctions(goCtx, &types.QueryAuctionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, auctions.Auctions, 2)
+	bids, err := k.AuctionBids(goCtx, &types.QueryAuctionBidsRequest{NumberIndex: "888888"})
+	require.NoError(t, err)
+	require.Equal(t, alice.String(), bids.Auction.Bidder)
+	require.Equal(t, ctx.BlockHeight()+10, bids.Auction.EndHeight)
+	require.Len(t, bids.Bids, 3)
+	require.Equal(t, bob.String(), bids.Bids[1].Bidder)
+
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	require.Equal(t, int64(500), balance(moduleAddress))
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	k.SettlePrefixAuctions(ctx.WithBlockHeight(ctx.BlockHeight() + 9))
+	require.Len(t, k.GetAllPrefixAuction(ctx), 2)
+
+	supply := bankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+	k.SettlePrefixAuctions(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	require.Empty(t, k.GetAllPrefixAuction(ctx))
+	require.Empty(t, k.GetAllPrefixBid(ctx))
+	require.Equal(t, supply.SubRaw(200), bankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, int64(0), balance(moduleAddress))
+	require.Equal(t, int64(1000), balance(bob))
+
+	gatewayNum, _, err := k.GetGatewayNum(ctx, "888888")
+	require.NoError(t, err)
+	require.Equal(t, valAddress.String(), gatewayNum.GatewayAddress)
+	require.Equal(t, int64(0), gatewayNum.Status)
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Len(t, gateway.GatewayNum, 2)
+	gatewayNum, _, err = k.GetGatewayNum(ctx, "666666")
+	require.NoError(t, err)
+	require.Nil(t, gatewayNum)
+
+	require.ErrorIs(t, bid(bob, "888888", 500), types.ErrGatewayNumber)
+	_, broken = AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestSettlePrefixAuctionsSkipsFailures(t *testing.T) {
+	k, _, bankKeeper, ctx := setupHooksKeeper(t)
+	params := k.GetParams(ctx)
+	params.PremiumPrefixes = []string{"888888", "666666"}
+	params.AuctionPeriod = 10
+	params.AuctionMinBid = sdk.NewInt(100)
+	k.SetParams(ctx, params)
+
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 2, []string{"100100"})
+	alice := sdk.AccAddress(valAddress)
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	require.NoError(t, k.UpdateGatewayInfo(ctx, types.Gateway{GatewayAddress: sdk.ValAddress(bob).String()}))
+
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, mintModuleName, funds))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, alice, funds))
+	require.NoError(t, k.PlacePrefixBid(ctx, alice, "888888", sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))))
+
+	unfunded := types.PrefixAuction{
+		NumberIndex: "666666",
+		Bidder:      bob.String(),
+		Amount:      sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)),
+		StartHeight: ctx.BlockHeight(),
+		EndHeight:   ctx.BlockHeight() + 10,
+		BidCount:    1,
+	}
+	k.SetPrefixAuction(ctx, unfunded)
+	k.SetPrefixBid(ctx, 0, types.PrefixBid{NumberIndex: "666666", Bidder: bob.String(), Amount: unfunded.Amount, Height: ctx.BlockHeight()})
+
+	settleCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+	k.SettlePrefixAuctions(settleCtx)
+
+	require.Equal(t, []types.PrefixAuction{unfunded}, k.GetAllPrefixAuction(ctx))
+	require.Len(t, k.GetPrefixBids(ctx, "666666"), 1)
+	require.Empty(t, k.GetPrefixBids(ctx, "888888"))
+	require.True(t, bankKeeper.GetBalance(ctx, bob, sdk.DefaultBondDenom).IsZero())
+	settled := make([]string, 0)
+	for _, event := range settleCtx.EventManager().Events() {
+		if event.Type == types.EventTypePrefixAuctionSettle {
+			settled = append(settled, string(event.Attributes[0].Value))
+		}
+	}
+	require.Equal(t, []string{"888888"}, settled)
+
+	gatewayNum, _, err := k.GetGatewayNum(ctx, "888888")
+	require.NoError(t, err)
+	require.Equal(t, valAddress.String(), gatewayNum.GatewayAddress)
+	gatewayNum, _, err = k.GetGatewayNum(ctx, "666666")
+	require.NoError(t, err)
+	require.Nil(t, gatewayNum)
+}
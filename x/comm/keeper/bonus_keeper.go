@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func (k Keeper) GetBonusEmission(ctx sdk.Context, params types.Params) sdk.Int {
+	index := uint64(ctx.BlockHeight() / params.BonusHalve)
+	return params.Bonus.ToDec().Mul(params.BonusDecay.Power(index)).TruncateInt()
+}
+
+
+func (k Keeper) DistributeGatewayBonus(ctx sdk.Context, params types.Params) error {
+	emission := k.GetBonusEmission(ctx, params)
+	balance := k.bankKeeper.GetBalance(ctx, core.ContractGatewayBonus, sdk.DefaultBondDenom)
+	if balance.Amount.LT(emission) {
+		emission = balance.Amount
+	}
+	if !emission.IsPositive() {
+		return nil
+	}
+
+	gateways, err := k.GetGatewayList(ctx)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, 0, len(gateways))
+	nums := make(map[string]int64)
+	users := make(map[string]uint64)
+	totalNums, totalUsers := int64(0), uint64(0)
+	for _, gateway := range gateways {
+		gatewayNums, err := k.GetGatewayNumsByGateway(ctx, gateway.GatewayAddress)
+		if err != nil {
+			return err
+		}
+		active := false
+		for _, gatewayNum := range gatewayNums {
+			if gatewayNum.Status == 0 {
+				active = true
+				nums[gateway.GatewayAddress] += int64(len(gatewayNum.NumberEnd))
+			}
+		}
+		if !active {
+			continue
+		}
+		addresses = append(addresses, gateway.GatewayAddress)
+		users[gateway.GatewayAddress] = k.GetGatewayUserCount(ctx, gateway.GatewayAddress)
+		totalNums += nums[gateway.GatewayAddress]
+		totalUsers += users[gateway.GatewayAddress]
+	}
+
+	if totalNums == 0 && totalUsers == 0 {
+		return k.bankKeeper.SendCoins(ctx, core.ContractGatewayBonus, core.ContractAddressFee, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, emission)))
+	}
+
+	userWeight := params.BonusUserWeight
+	if totalUsers == 0 {
+		userWeight = sdk.ZeroDec()
+	} else if totalNums == 0 {
+		userWeight = sdk.OneDec()
+	}
+
+	total := sdk.ZeroInt()
+	shares := make(map[string]sdk.Int)
+	for _, address := range addresses {
+		weight := sdk.ZeroDec()
+		if totalNums > 0 {
+			weight = sdk.OneDec().Sub(userWeight).MulInt64(nums[address]).QuoInt64(totalNums)
+		}
+		if totalUsers > 0 {
+			weight = weight.Add(userWeight.Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(users[address]))).Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(totalUsers))))
+		}
+		share := emission.ToDec().Mul(weight).TruncateInt()
+		if !share.IsPositive() {
+			continue
+		}
+		shares[address] = share
+		total = total.Add(share)
+	}
+	if !total.IsPositive() {
+		return nil
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, core.ContractGatewayBonus, k.accountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, total)))
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		share, ok := shares[address]
+		if !ok {
+			continue
+		}
+		amount := k.GetGatewayBonus(ctx, address).Add(sdk.NewCoin(sdk.DefaultBondDenom, share))
+		k.SetGatewayBonus(ctx, types.GatewayBonus{GatewayAddress: address, Amount: amount})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGatewayBonus,
+				sdk.NewAttribute(types.AttributeKeyGateway, address),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+	return nil
+}
+
+
+func (k Keeper) ClaimGatewayBonus(ctx sdk.Context, gatewayAddress sdk.ValAddress) (sdk.Coin, error) {
+	amount := k.GetGatewayBonus(ctx, gatewayAddress.String())
+	if !amount.IsPositive() {
+		return amount, types.ErrNoGatewayBonus
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(gatewayAddress), sdk.NewCoins(amount))
+	if err != nil {
+		return amount, err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetGatewayBonusKey(gatewayAddress.String()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawGatewayBonus,
+			sdk.NewAttribute(types.AttributeKeyGateway, gatewayAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return amount, nil
+}
+
+
+func (k Keeper) GetGatewayBonus(ctx sdk.Context, gatewayAddress string) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGatewayBonusKey(gatewayAddress))
+	if bz == nil {
+		return sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+	}
+	var gatewayBonus types.GatewayBonus
+	k.cdc.MustUnmarshal(bz, &gatewayBonus)
+	return gatewayBonus.Amount
+}
+
+func (k Keeper) SetGatewayBonus(ctx sdk.Context, gatewayBonus types.GatewayBonus) {
+	ctx.KVStore(k.storeKey).Set(types.GetGatewayBonusKey(gatewayBonus.GatewayAddress), k.cdc.MustMarshal(&gatewayBonus))
+}
+
+
+func (k Keeper) GetAllGatewayBonus(ctx sdk.Context) []types.GatewayBonus {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixGatewayBonus)
+	defer iterator.Close()
+
+	gatewayBonuses := make([]types.GatewayBonus, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var gatewayBonus types.GatewayBonus
+		k.cdc.MustUnmarshal(iterator.Value(), &gatewayBonus)
+		gatewayBonuses = append(gatewayBonuses, gatewayBonus)
+	}
+	return gatewayBonuses
+}
+
+
+func (k Keeper) GetGatewayUserCount(ctx sdk.Context, gatewayAddress string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGatewayUserCountKey(gatewayAddress))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetGatewayUserCount(ctx sdk.Context, gatewayAddress string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetGatewayUserCountKey(gatewayAddress))
+		return
+	}
+	store.Set(types.GetGatewayUserCountKey(gatewayAddress), sdk.Uint64ToBigEndian(count))
+}
+
+
+func (k Keeper) GetAllGatewayUserCount(ctx sdk.Context) []types.GatewayUserCount {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixGatewayUserCount)
+	defer iterator.Close()
+
+	userCounts := make([]types.GatewayUserCount, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		userCounts = append(userCounts, types.GatewayUserCount{
+			GatewayAddress: string(iterator.Key()[len(types.KeyPrefixGatewayUserCount):]),
+			Count:          sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return userCounts
+}
+
+
+func (k Keeper) MoveGatewayUser(ctx sdk.Context, fromGateway, toGateway string) {
+	if fromGateway == toGateway {
+		return
+	}
+	if fromGateway != "" {
+		if count := k.GetGatewayUserCount(ctx, fromGateway); count > 0 {
+			k.SetGatewayUserCount(ctx, fromGateway, count-1)
+		}
+	}
+	if toGateway != "" {
+		k.SetGatewayUserCount(ctx, toGateway, k.GetGatewayUserCount(ctx, toGateway)+1)
+	}
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/core"
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayBonusEmission(t *testing.T) {
+	k, _, _, ctx := setupHooksKeeper(t)
+	params := k.GetParams(ctx)
+	params.Bonus = sdk.NewInt(1000)
+	params.BonusHalve = 100
+
+	require.Equal(t, sdk.NewInt(1000), k.GetBonusEmission(ctx.WithBlockHeight(99), params))
+	require.Equal(t, sdk.NewInt(500), k.GetBonusEmission(ctx.WithBlockHeight(100), params))
+	require.Equal(t, sdk.NewInt(250), k.GetBonusEmission(ctx.WithBlockHeight(250), params))
+	require.True(t, k.GetBonusEmission(ctx.WithBlockHeight(100*70), params).IsZero())
+
+	params.BonusDecay = sdk.OneDec()
+	require.Equal(t, sdk.NewInt(1000), k.GetBonusEmission(ctx.WithBlockHeight(100*70), params))
+}
+
+func TestDistributeGatewayBonus(t *testing.T) {
+	k, _, bankKeeper, ctx := setupHooksKeeper(t)
+	msgServer := NewMsgServerImpl(k)
+	params := k.GetParams(ctx)
+	params.Bonus = sdk.NewInt(1000)
+	params.BonusCycle = 10
+	params.BonusHalve = 100
+	k.SetParams(ctx, params)
+
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, mintModuleName, funds))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, core.ContractGatewayBonus, funds))
+
+	require.NoError(t, k.GatewayBonusCheck(ctx.WithBlockHeight(10)))
+	feeCollector := bankKeeper.GetBalance(ctx, core.ContractAddressFee, sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(1000), feeCollector.Amount)
+
+	gatewayA := sdk.ValAddress([]byte("gateway_a___________"))
+	gatewayB := sdk.ValAddress([]byte("gateway_b___________"))
+	gatewayC := sdk.ValAddress([]byte("gateway_c___________"))
+	for _, gateway := range []sdk.ValAddress{gatewayA, gatewayB, gatewayC} {
+		require.NoError(t, k.UpdateGatewayInfo(ctx, types.Gateway{GatewayAddress: gateway.String()}))
+	}
+	require.NoError(t, k.SetGatewayNum(ctx, []types.GatewayNumIndex{
+		{GatewayAddress: gatewayA.String(), NumberIndex: "1001", NumberEnd: []string{"100100000", "100100001", "100100002"}},
+		{GatewayAddress: gatewayB.String(), NumberIndex: "2002", NumberEnd: []string{"200200000"}},
+		{GatewayAddress: gatewayC.String(), NumberIndex: "3003", NumberEnd: []string{"300300000"}, Status: 1},
+	}))
+	k.MoveGatewayUser(ctx, "", gatewayA.String())
+	k.MoveGatewayUser(ctx, "", gatewayC.String())
+	k.MoveGatewayUser(ctx, gatewayC.String(), gatewayB.String())
+	require.Equal(t, uint64(0), k.GetGatewayUserCount(ctx, gatewayC.String()))
+	require.Equal(t, uint64(1), k.GetGatewayUserCount(ctx, gatewayB.String()))
+
+	require.NoError(t, k.GatewayBonusCheck(ctx.WithBlockHeight(11)))
+	require.True(t, k.GetGatewayBonus(ctx, gatewayA.String()).IsZero())
+
+	require.NoError(t, k.GatewayBonusCheck(ctx.WithBlockHeight(20)))
+	require.Equal(t, sdk.NewInt(625), k.GetGatewayBonus(ctx, gatewayA.String()).Amount)
+	require.Equal(t, sdk.NewInt(375), k.GetGatewayBonus(ctx, gatewayB.String()).Amount)
+	require.True(t, k.GetGatewayBonus(ctx, gatewayC.String()).IsZero())
+
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	require.Equal(t, sdk.NewInt(1000), bankKeeper.GetBalance(ctx, moduleAddress, sdk.DefaultBondDenom).Amount)
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	res, err := k.GatewayBonus(sdk.WrapSDKContext(ctx), &types.QueryGatewayBonusRequest{GatewayAddress: gatewayA.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(625), res.Amount.Amount)
+
+	_, err = msgServer.WithdrawGatewayBonus(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawGatewayBonus(sdk.AccAddress(gatewayA).String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(625), bankKeeper.GetBalance(ctx, sdk.AccAddress(gatewayA), sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(375), bankKeeper.GetBalance(ctx, moduleAddress, sdk.DefaultBondDenom).Amount)
+	require.Len(t, k.GetAllGatewayBonus(ctx), 1)
+
+	_, err = msgServer.WithdrawGatewayBonus(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawGatewayBonus(sdk.AccAddress(gatewayA).String()))
+	require.ErrorIs(t, err, types.ErrNoGatewayBonus)
+
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
@@ -1,26 +1,21 @@
 package keeper
 
 import (
-	"freemasonry.cc/blockchain/util"
 	"freemasonry.cc/blockchain/x/comm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strconv"
 )
 
-const (
-	GatewayKey = "gateway_" 
-
-	GatewayNumKey = "gateway_num" 
-
-	GatewayRedeemNumKey = "gateway_redeem_num" 
-)
-
-func (k Keeper) SetGateway(ctx sdk.Context, msg types.MsgGatewayRegister, coin sdk.Coin, valAddress string) error {
-	kvStore := k.KVHelper(ctx)
-	params := k.GetParams(ctx)
-	num := coin.Amount.Quo(params.MinDelegate)
+func (k Keeper) SetGateway(ctx sdk.Context, msg types.MsgGatewayRegister, valAddress string) error {
+	valAddr, err := sdk.ValAddressFromBech32(valAddress)
+	if err != nil {
+		return err
+	}
+	num := sdk.NewInt(k.GetGatewayQuota(ctx, valAddr))
 	gatewayInfo := types.Gateway{}
-	if kvStore.Has(GatewayKey + valAddress) {
-		err := kvStore.GetUnmarshal(GatewayKey+valAddress, &gatewayInfo)
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGatewayKey(valAddress))
+	if bz != nil {
+		err := k.cdc.Unmarshal(bz, &gatewayInfo)
 		if err != nil {
 			return err
 		}
@@ -49,14 +44,178 @@ func (k Keeper) SetGateway(ctx sdk.Context, msg types.MsgGatewayRegister, coin s
 			return err
 		}
 	}
-	
-	return kvStore.Set(GatewayKey+valAddress, gatewayInfo)
+	k.InitGatewayLiveness(ctx, valAddress)
+	return k.UpdateGatewayInfo(ctx, gatewayInfo)
+}
+
+
+func (k Keeper) SetGatewayEndpoints(ctx sdk.Context, valAddress sdk.ValAddress, endpoints []types.GatewayEndpoint, tlsFingerprint, transportPubKey string) error {
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	if err != nil {
+		return err
+	}
+	gateway.Endpoints = endpoints
+	gateway.TlsFingerprint = tlsFingerprint
+	gateway.TransportPubKey = transportPubKey
+	err = k.UpdateGatewayInfo(ctx, *gateway)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGatewayEditEndpoints,
+			sdk.NewAttribute(types.AttributeKeyGateway, gateway.GatewayAddress),
+			sdk.NewAttribute(types.AttributeKeyEndpoints, strconv.Itoa(len(endpoints))),
+		),
+	)
+	return nil
+}
+
+
+func (k Keeper) GetGatewayQuota(ctx sdk.Context, valAddress sdk.ValAddress) int64 {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if !found {
+		return 0
+	}
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddress), valAddress)
+	if !found {
+		return 0
+	}
+	return validator.TokensFromShares(delegation.Shares).QuoInt(k.GetMinDelegate(ctx)).TruncateInt64()
+}
+
+
+func (k Keeper) SyncGatewayQuota(ctx sdk.Context, valAddress sdk.ValAddress, quota int64) error {
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	if err != nil {
+		if err == types.ErrGatewayNotExist {
+			return nil
+		}
+		return err
+	}
+	if gateway.GatewayQuota == quota && int64(len(gateway.GatewayNum)) <= quota {
+		return nil
+	}
+
+	gateway.GatewayQuota = quota
+	if int64(len(gateway.GatewayNum)) > quota {
+		err = k.redeemGatewayNums(ctx, gateway, quota)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGatewayQuotaSync,
+			sdk.NewAttribute(types.AttributeKeyGateway, gateway.GatewayAddress),
+			sdk.NewAttribute(types.AttributeKeyGatewayQuota, strconv.FormatInt(quota, 10)),
+		),
+	)
+	return k.UpdateGatewayInfo(ctx, *gateway)
+}
+
+
+func (k Keeper) redeemGatewayNums(ctx sdk.Context, gateway *types.Gateway, keep int64) error {
+	validity := ctx.BlockHeight() + k.GetValidity(ctx)
+
+	var indexNumArray []types.GatewayNumIndex
+	for _, val := range gateway.GatewayNum[keep:] {
+		indexNum, _, err := k.GetGatewayNum(ctx, val.NumberIndex)
+		if err != nil {
+			return err
+		}
+		if indexNum == nil {
+			return types.ErrGatewayNumNotFound
+		}
+		indexNum.Status = 1
+		indexNum.Validity = validity
+		indexNumArray = append(indexNumArray, *indexNum)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGatewayNumRedeem,
+				sdk.NewAttribute(types.AttributeKeyGateway, gateway.GatewayAddress),
+				sdk.NewAttribute(types.AttributeKeyNumberIndex, indexNum.NumberIndex),
+				sdk.NewAttribute(types.AttributeKeyValidity, strconv.FormatInt(validity, 10)),
+			),
+		)
+	}
+	gateway.GatewayNum = gateway.GatewayNum[:keep]
+
+	err := k.SetGatewayNum(ctx, indexNumArray)
+	if err != nil {
+		return err
+	}
+	return k.SetGatewayRedeemNum(ctx, indexNumArray)
+}
+
+
+func (k Keeper) syncGatewayQuotaCached(ctx sdk.Context, valAddress sdk.ValAddress, quota int64) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.SyncGatewayQuota(cacheCtx, valAddress, quota)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+	return nil
+}
+
+
+func (k Keeper) SetSlashedGateway(ctx sdk.Context, gatewayAddress string) {
+	ctx.KVStore(k.storeKey).Set(types.GetSlashedGatewayKey(gatewayAddress), []byte{})
+}
+
+
+func (k Keeper) GetAllSlashedGateway(ctx sdk.Context) []string {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSlashedGateway)
+	defer iterator.Close()
+
+	gateways := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		gateways = append(gateways, string(iterator.Key()[len(types.KeyPrefixSlashedGateway):]))
+	}
+	return gateways
+}
+
+
+func (k Keeper) SyncSlashedGatewayQuotas(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSlashedGateway)
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		gatewayAddress := string(key[len(types.KeyPrefixSlashedGateway):])
+		valAddress, err := sdk.ValAddressFromBech32(gatewayAddress)
+		if err != nil {
+			k.Logger(ctx).Error("invalid slashed gateway", "gateway", gatewayAddress, "error", err.Error())
+			store.Delete(key)
+			continue
+		}
+		err = k.syncGatewayQuotaCached(ctx, valAddress, k.GetGatewayQuota(ctx, valAddress))
+		if err != nil {
+			k.Logger(ctx).Error("failed to sync slashed gateway quota", "gateway", gatewayAddress, "error", err.Error())
+			continue
+		}
+		store.Delete(key)
+	}
 }
 
 
 func (k Keeper) GatewayNumFilter(ctx sdk.Context, validatorAddress string, indexNum []string) ([]types.GatewayNumIndex, error) {
+	params := k.GetParams(ctx)
 	var gatewayNumArray []types.GatewayNumIndex
 	for _, val := range indexNum {
+		if params.IsPremiumPrefix(val) {
+			return nil, types.ErrPremiumPrefix
+		}
 		
 		gatewayNum, isRegister, err := k.GetGatewayNum(ctx, val)
 		if err != nil {
@@ -80,177 +239,266 @@ func (k Keeper) GatewayNumFilter(ctx sdk.Context, validatorAddress string, index
 
 
 func (k Keeper) UpdateGatewayInfo(ctx sdk.Context, gateway types.Gateway) error {
-	kvStore := k.KVHelper(ctx)
-	keys := GatewayKey + gateway.GatewayAddress
-	err := kvStore.Set(keys, gateway)
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&gateway)
 	if err != nil {
 		return err
 	}
+	store.Set(types.GetGatewayKey(gateway.GatewayAddress), bz)
 	return nil
 }
 
 
-func (k Keeper) SetGatewayNum(ctx sdk.Context, gatewayNumArray []types.GatewayNumIndex) error {
-	kvStore := k.KVHelper(ctx)
-	gatewayMap := make(map[string]types.GatewayNumIndex)
-	if kvStore.Has(GatewayNumKey) {
-		err := kvStore.GetUnmarshal(GatewayNumKey, &gatewayMap)
+func (k Keeper) GetGatewayList(ctx sdk.Context) ([]types.Gateway, error) {
+	store := ctx.KVStore(k.storeKey)
+	gatewayArray := []types.Gateway{}
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixGateway)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var gateway types.Gateway
+		err := k.cdc.Unmarshal(iterator.Value(), &gateway)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		if gateway.GatewayAddress == "" {
+			continue
+		}
+		gatewayArray = append(gatewayArray, gateway)
 	}
-	for _, val := range gatewayNumArray {
-		gatewayMap[val.NumberIndex] = val
+	return gatewayArray, nil
+}
+
+
+func (k Keeper) GetGatewayInfo(ctx sdk.Context, gatewayAddress string) (*types.Gateway, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGatewayKey(gatewayAddress))
+	if bz == nil {
+		return nil, types.ErrGatewayNotExist
 	}
-	err := kvStore.Set(GatewayNumKey, gatewayMap)
+	gateway := new(types.Gateway)
+	err := k.cdc.Unmarshal(bz, gateway)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return gateway, nil
 }
 
 
-func (k Keeper) SetGatewayRedeemNum(ctx sdk.Context, gatewayNumArray []types.GatewayNumIndex) error {
-	kvStore := k.KVHelper(ctx)
-	gatewayMap := make(map[string]types.GatewayNumIndex)
-	if kvStore.Has(GatewayRedeemNumKey) {
-		err := kvStore.GetUnmarshal(GatewayRedeemNumKey, &gatewayMap)
+func (k Keeper) SetGatewayNum(ctx sdk.Context, gatewayNumArray []types.GatewayNumIndex) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, val := range gatewayNumArray {
+		bz := store.Get(types.GetGatewayNumKey(val.NumberIndex))
+		if bz != nil {
+			var old types.GatewayNumIndex
+			err := k.cdc.Unmarshal(bz, &old)
+			if err != nil {
+				return err
+			}
+			if old.GatewayAddress != "" {
+				store.Delete(types.GetGatewayNumByGatewayKey(old.GatewayAddress, old.NumberIndex))
+			}
+			for _, mobile := range old.NumberEnd {
+				if types.IsPortedNum(old.NumberIndex, mobile) && string(store.Get(types.GetPortedNumKey(mobile))) == old.NumberIndex {
+					store.Delete(types.GetPortedNumKey(mobile))
+				}
+			}
+		}
+
+		gatewayNum := val
+		bz, err := k.cdc.Marshal(&gatewayNum)
 		if err != nil {
 			return err
 		}
+		store.Set(types.GetGatewayNumKey(val.NumberIndex), bz)
+		if val.GatewayAddress != "" {
+			store.Set(types.GetGatewayNumByGatewayKey(val.GatewayAddress, val.NumberIndex), []byte{})
+		}
+		for _, mobile := range val.NumberEnd {
+			if types.IsPortedNum(val.NumberIndex, mobile) {
+				store.Set(types.GetPortedNumKey(mobile), []byte(val.NumberIndex))
+			}
+		}
 	}
-	for _, val := range gatewayNumArray {
-		gatewayMap[val.NumberIndex] = val
-	}
-	err := kvStore.Set(GatewayRedeemNumKey, gatewayMap)
+	return nil
+}
+
+
+func (k Keeper) SetGatewayRedeemNum(ctx sdk.Context, gatewayNumArray []types.GatewayNumIndex) error {
+	err := k.GatewayRedeemNumFilter(ctx, gatewayNumArray)
 	if err != nil {
 		return err
 	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, val := range gatewayNumArray {
+		gatewayNum := val
+		bz, err := k.cdc.Marshal(&gatewayNum)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetGatewayRedeemNumKey(val.NumberIndex), bz)
+		store.Set(types.GetGatewayRedeemQueueKey(val.Validity, val.NumberIndex), []byte{})
+	}
 	return nil
 }
 
 
 func (k Keeper) GatewayRedeemNumFilter(ctx sdk.Context, gatewayNumArray []types.GatewayNumIndex) error {
-	kvStore := k.KVHelper(ctx)
-	if !kvStore.Has(GatewayRedeemNumKey) {
-		return nil
-	}
-	gatewayNumMap := make(map[string]types.GatewayNumIndex)
-	err := kvStore.GetUnmarshal(GatewayRedeemNumKey, &gatewayNumMap)
-	if err != nil {
-		return err
-	}
+	store := ctx.KVStore(k.storeKey)
 	for _, val := range gatewayNumArray {
-		if _, ok := gatewayNumMap[val.NumberIndex]; ok {
-			delete(gatewayNumMap, val.NumberIndex)
+		key := types.GetGatewayRedeemNumKey(val.NumberIndex)
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		var old types.GatewayNumIndex
+		err := k.cdc.Unmarshal(bz, &old)
+		if err != nil {
+			return err
 		}
+		store.Delete(types.GetGatewayRedeemQueueKey(old.Validity, old.NumberIndex))
+		store.Delete(key)
 	}
-	return kvStore.Set(GatewayRedeemNumKey, gatewayNumMap)
+	return nil
 }
 
 
 func (k Keeper) GetGatewayRedeemNum(ctx sdk.Context) (map[string]types.GatewayNumIndex, error) {
-	kvStore := k.KVHelper(ctx)
-	if !kvStore.Has(GatewayRedeemNumKey) {
-		return nil, nil
-	}
-	gatewayNumMap := make(map[string]types.GatewayNumIndex)
-	err := kvStore.GetUnmarshal(GatewayRedeemNumKey, &gatewayNumMap)
+	gatewayNumArray, err := k.GetGatewayRedeemNumList(ctx)
 	if err != nil {
 		return nil, err
 	}
+	gatewayNumMap := make(map[string]types.GatewayNumIndex)
+	for _, val := range gatewayNumArray {
+		gatewayNumMap[val.NumberIndex] = val
+	}
 	return gatewayNumMap, nil
 }
 
 
-func (k Keeper) GetGatewayList(ctx sdk.Context) ([]types.Gateway, error) {
-	store := k.KVHelper(ctx)
-	gatewayArray := []types.Gateway{}
-	iterator := store.KVStorePrefixIterator(GatewayKey)
+func (k Keeper) GetGatewayRedeemNumList(ctx sdk.Context) ([]types.GatewayNumIndex, error) {
+	return k.getGatewayNumList(ctx, types.KeyPrefixGatewayRedeemNum)
+}
+
+
+func (k Keeper) GetMatureGatewayRedeemNum(ctx sdk.Context, height int64) ([]types.GatewayNumIndex, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixGatewayRedeemQueue, types.GetGatewayRedeemQueuePrefix(height+1))
 	defer iterator.Close()
+
+	gatewayNumArray := make([]types.GatewayNumIndex, 0)
 	for ; iterator.Valid(); iterator.Next() {
-		var gateway types.Gateway
-		err := util.Json.Unmarshal(iterator.Value(), &gateway)
+		_, numberIndex := types.ParseGatewayRedeemQueueKey(iterator.Key())
+		bz := store.Get(types.GetGatewayRedeemNumKey(numberIndex))
+		if bz == nil {
+			continue
+		}
+		var gatewayNum types.GatewayNumIndex
+		err := k.cdc.Unmarshal(bz, &gatewayNum)
 		if err != nil {
 			return nil, err
 		}
-		if gateway.GatewayAddress == "" {
-			continue
-		}
-		gatewayArray = append(gatewayArray, gateway)
+		gatewayNumArray = append(gatewayNumArray, gatewayNum)
 	}
-	return gatewayArray, nil
-}
-
-
-func (k Keeper) GetGatewayInfo(ctx sdk.Context, gatewayAddress string) (*types.Gateway, error) {
-	kvStore := k.KVHelper(ctx)
-	keys := GatewayKey + gatewayAddress
-	if !kvStore.Has(keys) {
-		return nil, types.ErrGatewayNotExist
-	}
-	gateway := new(types.Gateway)
-	err := kvStore.GetUnmarshal(keys, gateway)
-	if err != nil {
-		return nil, err
-	}
-	return gateway, nil
+	return gatewayNumArray, nil
 }
 
 
 func (k Keeper) GetGatewayInfoByNum(ctx sdk.Context, gatewayNum string) (*types.Gateway, error) {
-	kvStore := k.KVHelper(ctx)
-	if !kvStore.Has(GatewayNumKey) {
-		return nil, nil
-	}
-	
-	gatewayNumMap, err := k.GetGatewayNumMap(ctx)
+	gatewayNumIndex, _, err := k.GetGatewayNum(ctx, gatewayNum)
 	if err != nil {
 		return nil, err
 	}
-	if gatewayNumMap == nil {
-		return nil, nil
-	}
-	var gatewayAddress string
-	if _, ok := gatewayNumMap[gatewayNum]; ok {
-		gatewayAddress = gatewayNumMap[gatewayNum].GatewayAddress
-	}
-	if gatewayAddress == "" {
+	if gatewayNumIndex == nil || gatewayNumIndex.GatewayAddress == "" {
 		return nil, nil
 	}
-	return k.GetGatewayInfo(ctx, gatewayAddress)
+	return k.GetGatewayInfo(ctx, gatewayNumIndex.GatewayAddress)
 }
 
 
 func (k Keeper) GetGatewayNumMap(ctx sdk.Context) (map[string]types.GatewayNumIndex, error) {
-	kvStore := k.KVHelper(ctx)
-	if !kvStore.Has(GatewayNumKey) {
-		return nil, nil
-	}
-	gatewayNumMap := make(map[string]types.GatewayNumIndex)
-	err := kvStore.GetUnmarshal(GatewayNumKey, &gatewayNumMap)
+	gatewayNumArray, err := k.GetGatewayNumList(ctx)
 	if err != nil {
 		return nil, err
 	}
+	gatewayNumMap := make(map[string]types.GatewayNumIndex)
+	for _, val := range gatewayNumArray {
+		gatewayNumMap[val.NumberIndex] = val
+	}
 	return gatewayNumMap, nil
 }
 
 
+func (k Keeper) GetGatewayNumList(ctx sdk.Context) ([]types.GatewayNumIndex, error) {
+	return k.getGatewayNumList(ctx, types.KeyPrefixGatewayNum)
+}
+
+
+func (k Keeper) GetGatewayNumsByGateway(ctx sdk.Context, gatewayAddress string) ([]types.GatewayNumIndex, error) {
+	store := ctx.KVStore(k.storeKey)
+	gatewayPrefix := types.GetGatewayNumByGatewayPrefix(gatewayAddress)
+	iterator := sdk.KVStorePrefixIterator(store, gatewayPrefix)
+	defer iterator.Close()
+
+	gatewayNumArray := make([]types.GatewayNumIndex, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		numberIndex := string(iterator.Key()[len(gatewayPrefix):])
+		gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+		if err != nil {
+			return nil, err
+		}
+		if gatewayNum != nil {
+			gatewayNumArray = append(gatewayNumArray, *gatewayNum)
+		}
+	}
+	return gatewayNumArray, nil
+}
+
+
 func (k Keeper) GetGatewayNum(ctx sdk.Context, gatewayNum string) (*types.GatewayNumIndex, bool, error) {
-	kvStore := k.KVHelper(ctx)
-	if !kvStore.Has(GatewayNumKey) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGatewayNumKey(gatewayNum))
+	if bz == nil {
 		return nil, true, nil
 	}
-	gatewayNumMap := make(map[string]types.GatewayNumIndex)
-	err := kvStore.GetUnmarshal(GatewayNumKey, &gatewayNumMap)
+	var val types.GatewayNumIndex
+	err := k.cdc.Unmarshal(bz, &val)
 	if err != nil {
 		return nil, false, err
 	}
-	if val, ok := gatewayNumMap[gatewayNum]; ok {
-		if val.Status != 2 {
-			return &val, false, nil
+	if val.Status != 2 {
+		return &val, false, nil
+	}
+	return &val, true, nil
+}
+
+func (k Keeper) GetGatewayNumByMobile(ctx sdk.Context, mobile string) (*types.GatewayNumIndex, error) {
+	numberIndex := ""
+	if bz := ctx.KVStore(k.storeKey).Get(types.GetPortedNumKey(mobile)); bz != nil {
+		numberIndex = string(bz)
+	} else if len(mobile) > types.NumberSuffixLength {
+		numberIndex = mobile[:len(mobile)-types.NumberSuffixLength]
+	} else {
+		return nil, nil
+	}
+
+	gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+	return gatewayNum, err
+}
+
+func (k Keeper) getGatewayNumList(ctx sdk.Context, prefix []byte) ([]types.GatewayNumIndex, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	gatewayNumArray := make([]types.GatewayNumIndex, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var gatewayNum types.GatewayNumIndex
+		err := k.cdc.Unmarshal(iterator.Value(), &gatewayNum)
+		if err != nil {
+			return nil, err
 		}
-		return &val, true, nil
+		gatewayNumArray = append(gatewayNumArray, gatewayNum)
 	}
-	return nil, true, nil
+	return gatewayNumArray, nil
 }
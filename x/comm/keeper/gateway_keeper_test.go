@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayEndpoints(t *testing.T) {
+	k, _, bankKeeper, ctx := setupHooksKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := NewMsgServerImpl(k)
+
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 1, []string{"1001"})
+	endpoints := []types.GatewayEndpoint{
+		{Transport: types.EndpointTransportWebsocket, Address: "wss://gw.example.com/ws"},
+		{Transport: types.EndpointTransportP2P, Address: "/dns4/gw.example.com/tcp/26656/p2p/12D3KooW"},
+	}
+	fingerprint := strings.Repeat("0f", types.TLSFingerprintLength)
+	pubKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	stranger := sdk.AccAddress([]byte("stranger____________"))
+	_, err := msgServer.GatewayEditEndpoints(goCtx, types.NewMsgGatewayEditEndpoints(stranger.String(), endpoints, fingerprint, pubKey))
+	require.ErrorIs(t, err, types.ErrGatewayNotExist)
+
+	_, err = msgServer.GatewayEditEndpoints(goCtx, types.NewMsgGatewayEditEndpoints(sdk.AccAddress(valAddress).String(), endpoints, fingerprint, pubKey))
+	require.NoError(t, err)
+
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, endpoints, gateway.Endpoints)
+	require.Len(t, gateway.GatewayNum, 1)
+
+	res, err := k.GatewayEndpoints(goCtx, &types.QueryGatewayEndpointsRequest{NumberIndex: "1001"})
+	require.NoError(t, err)
+	require.Equal(t, valAddress.String(), res.GatewayAddress)
+	require.Equal(t, endpoints, res.Endpoints)
+	require.Equal(t, fingerprint, res.TlsFingerprint)
+	require.Equal(t, pubKey, res.TransportPubKey)
+
+	_, err = k.GatewayEndpoints(goCtx, &types.QueryGatewayEndpointsRequest{NumberIndex: "2002"})
+	require.ErrorIs(t, err, types.ErrGatewayNumNotFound)
+
+	_, err = msgServer.GatewayRegister(goCtx, &types.MsgGatewayRegister{Address: sdk.AccAddress(valAddress).String(), GatewayName: "renamed", Delegation: "0"})
+	require.NoError(t, err)
+	gateway, err = k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, "renamed", gateway.GatewayName)
+	require.Equal(t, endpoints, gateway.Endpoints)
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Gateway(goCtx context.Context, req *types.QueryGatewayRequest) (*types.QueryGatewayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gateway, err := k.GetGatewayInfo(ctx, req.GatewayAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGatewayResponse{Gateway: *gateway}, nil
+}
+
+func (k Keeper) Gateways(goCtx context.Context, req *types.QueryGatewaysRequest) (*types.QueryGatewaysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gatewayStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGateway)
+	gateways := make([]types.Gateway, 0)
+	pageRes, err := query.FilteredPaginate(gatewayStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var gateway types.Gateway
+		if err := k.cdc.Unmarshal(value, &gateway); err != nil || gateway.GatewayAddress == "" {
+			return false, nil
+		}
+		if accumulate {
+			gateways = append(gateways, gateway)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGatewaysResponse{Gateways: gateways, Pagination: pageRes}, nil
+}
+
+func (k Keeper) GatewayByNumber(goCtx context.Context, req *types.QueryGatewayByNumberRequest) (*types.QueryGatewayByNumberResponse, error) {
+	if req == nil || len(req.Number) <= types.NumberSuffixLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid number")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	numberIndex := req.Number[:len(req.Number)-types.NumberSuffixLength]
+	gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+	if err != nil {
+		return nil, err
+	}
+	if gatewayNum == nil || gatewayNum.GatewayAddress == "" {
+		return nil, types.ErrGatewayNumNotFound
+	}
+
+	gateway, err := k.GetGatewayInfo(ctx, gatewayNum.GatewayAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGatewayByNumberResponse{Gateway: *gateway}, nil
+}
+
+func (k Keeper) NumberIndex(goCtx context.Context, req *types.QueryNumberIndexRequest) (*types.QueryNumberIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gatewayNum, _, err := k.GetGatewayNum(ctx, req.NumberIndex)
+	if err != nil {
+		return nil, err
+	}
+	if gatewayNum == nil {
+		return nil, types.ErrGatewayNumNotFound
+	}
+
+	return &types.QueryNumberIndexResponse{NumberIndex: *gatewayNum}, nil
+}
+
+func (k Keeper) Numbers(goCtx context.Context, req *types.QueryNumbersRequest) (*types.QueryNumbersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	numberPrefix := types.KeyPrefixGatewayNum
+	if req.GatewayAddress != "" {
+		numberPrefix = types.GetGatewayNumByGatewayPrefix(req.GatewayAddress)
+	}
+
+	numberStore := prefix.NewStore(ctx.KVStore(k.storeKey), numberPrefix)
+	numbers := make([]types.GatewayNumIndex, 0)
+	pageRes, err := query.Paginate(numberStore, req.Pagination, func(key []byte, value []byte) error {
+		if req.GatewayAddress != "" {
+			gatewayNum, _, err := k.GetGatewayNum(ctx, string(key))
+			if err != nil {
+				return err
+			}
+			if gatewayNum != nil {
+				numbers = append(numbers, *gatewayNum)
+			}
+			return nil
+		}
+		var gatewayNum types.GatewayNumIndex
+		if err := k.cdc.Unmarshal(value, &gatewayNum); err != nil {
+			return err
+		}
+		numbers = append(numbers, gatewayNum)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNumbersResponse{Numbers: numbers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RedeemingNumbers(goCtx context.Context, req *types.QueryRedeemingNumbersRequest) (*types.QueryRedeemingNumbersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	numbers, err := k.GetGatewayRedeemNumList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedeemingNumbersResponse{Numbers: numbers}, nil
+}
+
+func (k Keeper) GatewayBonus(goCtx context.Context, req *types.QueryGatewayBonusRequest) (*types.QueryGatewayBonusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.GatewayAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryGatewayBonusResponse{Amount: k.GetGatewayBonus(ctx, req.GatewayAddress)}, nil
+}
+
+func (k Keeper) Auctions(goCtx context.Context, req *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auctionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrefixAuction)
+	auctions := make([]types.PrefixAuction, 0)
+	pageRes, err := query.Paginate(auctionStore, req.Pagination, func(key []byte, value []byte) error {
+		var auction types.PrefixAuction
+		if err := k.cdc.Unmarshal(value, &auction); err != nil {
+			return err
+		}
+		auctions = append(auctions, auction)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AuctionBids(goCtx context.Context, req *types.QueryAuctionBidsRequest) (*types.QueryAuctionBidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetPrefixAuction(ctx, req.NumberIndex)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no open auction for number index %s", req.NumberIndex)
+	}
+
+	return &types.QueryAuctionBidsResponse{Auction: auction, Bids: k.GetPrefixBids(ctx, req.NumberIndex)}, nil
+}
+
+func (k Keeper) GatewayUptime(goCtx context.Context, req *types.QueryGatewayUptimeRequest) (*types.QueryGatewayUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.GatewayAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liveness, found := k.GetGatewayLiveness(ctx, req.GatewayAddress)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no liveness record for gateway %s", req.GatewayAddress)
+	}
+	windows, uptime := k.GetGatewayUptime(ctx, liveness)
+
+	return &types.QueryGatewayUptimeResponse{Liveness: liveness, Windows: windows, Uptime: uptime}, nil
+}
+
+func (k Keeper) GatewayEndpoints(goCtx context.Context, req *types.QueryGatewayEndpointsRequest) (*types.QueryGatewayEndpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gateway, err := k.GetGatewayInfoByNum(ctx, req.NumberIndex)
+	if err != nil {
+		return nil, err
+	}
+	if gateway == nil {
+		return nil, types.ErrGatewayNumNotFound
+	}
+
+	return &types.QueryGatewayEndpointsResponse{
+		GatewayAddress:  gateway.GatewayAddress,
+		Endpoints:       gateway.Endpoints,
+		TlsFingerprint:  gateway.TlsFingerprint,
+		TransportPubKey: gateway.TransportPubKey,
+	}, nil
+}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingTypes.StakingHooks = Keeper{}
+
+
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if !delAddr.Equals(valAddr) {
+		return
+	}
+	if _, err := k.GetGatewayInfo(ctx, valAddr.String()); err != nil {
+		return
+	}
+	err := k.syncGatewayQuotaCached(ctx, valAddr, k.GetGatewayQuota(ctx, valAddr))
+	if err != nil {
+		k.Logger(ctx).Error("failed to sync gateway quota", "gateway", valAddr.String(), "error", err.Error())
+	}
+}
+
+
+func (k Keeper) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if !delAddr.Equals(valAddr) {
+		return
+	}
+	err := k.syncGatewayQuotaCached(ctx, valAddr, 0)
+	if err != nil {
+		k.Logger(ctx).Error("failed to sync gateway quota", "gateway", valAddr.String(), "error", err.Error())
+	}
+}
+
+
+func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) {
+	if _, err := k.GetGatewayInfo(ctx, valAddr.String()); err != nil {
+		return
+	}
+	k.SetSlashedGateway(ctx, valAddr.String())
+}
+
+func (k Keeper) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)   {}
+func (k Keeper) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {}
+func (k Keeper) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+}
+func (k Keeper) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+}
+func (k Keeper) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+}
+func (k Keeper) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+}
+func (k Keeper) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"freemasonry.cc/blockchain/cmd/config"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const mintModuleName = "mint"
+
+func setupHooksKeeper(t *testing.T) (Keeper, *stakingkeeper.Keeper, bankkeeper.Keeper, sdk.Context) {
+	k, stakingKeeper, bankKeeper, ctx, _ := setupHooksKeeperWithParamsKey(t)
+	return k, stakingKeeper, bankKeeper, ctx
+}
+
+func setupHooksKeeperWithParamsKey(t *testing.T) (Keeper, *stakingkeeper.Keeper, bankkeeper.Keeper, sdk.Context, sdk.StoreKey) {
+	config.SetBech32Prefixes(sdk.GetConfig())
+
+	authKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	stakingKey := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	for _, key := range []sdk.StoreKey{authKey, bankKey, stakingKey, storeKey, paramsKey} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	amino := codec.NewLegacyAmino()
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1, Time: time.Unix(1600000000, 0)}, false, log.NewNopLogger())
+
+	maccPerms := map[string][]string{
+		authtypes.FeeCollectorName:     nil,
+		types.ModuleName:               nil,
+		types.ModuleBurnName:           {authtypes.Burner},
+		mintModuleName:                 {authtypes.Minter},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}
+	accountKeeper := authkeeper.NewAccountKeeper(cdc, authKey, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms)
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	bankKeeper := bankkeeper.NewBaseKeeper(cdc, bankKey, accountKeeper, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, banktypes.ModuleName), nil)
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+
+	stakingKeeper := stakingkeeper.NewKeeper(cdc, stakingKey, accountKeeper, bankKeeper, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, stakingtypes.ModuleName))
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = sdk.DefaultBondDenom
+	stakingKeeper.SetParams(ctx, stakingParams)
+
+	k := NewKeeper(storeKey, cdc, paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, types.ModuleName), accountKeeper, bankKeeper, &stakingKeeper)
+	params := types.DefaultParams()
+	params.MinDelegate = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	params.Validity = 10
+	k.SetParams(ctx, params)
+	stakingKeeper.SetHooks(k)
+
+	return k, &stakingKeeper, bankKeeper, ctx, paramsKey
+}
+
+func registerHooksGateway(t *testing.T, k Keeper, bankKeeper bankkeeper.Keeper, ctx sdk.Context, quota int64, indexNumber []string) sdk.ValAddress {
+	operator := sdk.AccAddress([]byte("gateway_____________"))
+	amount := k.GetParams(ctx).MinDelegate.MulRaw(quota)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, mintModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, operator, coins))
+
+	_, err := NewMsgServerImpl(k).GatewayRegister(sdk.WrapSDKContext(ctx), &types.MsgGatewayRegister{
+		Address:     operator.String(),
+		GatewayName: "gateway",
+		Delegation:  amount.String(),
+		IndexNumber: indexNumber,
+		PubKey:      base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()),
+		Commission:  stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+	})
+	require.NoError(t, err)
+	return sdk.ValAddress(operator)
+}
+
+func TestStakingHooksUndelegate(t *testing.T) {
+	k, stakingKeeper, bankKeeper, ctx := setupHooksKeeper(t)
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 3, []string{"1001", "1002", "1003"})
+	minDelegate := k.GetParams(ctx).MinDelegate
+
+	_, err := NewMsgServerImpl(k).GatewayUndelegate(sdk.WrapSDKContext(ctx), types.NewMsgGatewayUndelegation(
+		sdk.AccAddress(valAddress).String(), valAddress.String(), sdk.NewCoin(sdk.DefaultBondDenom, minDelegate), nil,
+	))
+	require.ErrorIs(t, err, types.ErrGatewayNum)
+
+	_, err = stakingKeeper.Undelegate(ctx, sdk.AccAddress(valAddress), valAddress, minDelegate.MulRaw(2).ToDec())
+	require.NoError(t, err)
+
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), gateway.GatewayQuota)
+	require.Len(t, gateway.GatewayNum, 1)
+	require.Equal(t, "1001", gateway.GatewayNum[0].NumberIndex)
+
+	redeemNums, err := k.GetGatewayRedeemNum(ctx)
+	require.NoError(t, err)
+	require.Len(t, redeemNums, 2)
+	for _, numberIndex := range []string{"1002", "1003"} {
+		gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), gatewayNum.Status)
+		require.Equal(t, ctx.BlockHeight()+10, gatewayNum.Validity)
+	}
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	_, err = stakingKeeper.Undelegate(ctx, sdk.AccAddress(valAddress), valAddress, minDelegate.ToDec())
+	require.NoError(t, err)
+	gateway, err = k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), gateway.GatewayQuota)
+	require.Empty(t, gateway.GatewayNum)
+
+	_, broken = AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestStakingHooksSlash(t *testing.T) {
+	k, stakingKeeper, bankKeeper, ctx := setupHooksKeeper(t)
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 4, []string{"1001", "1002", "1003", "1004"})
+
+	stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := stakingKeeper.GetValidator(ctx, valAddress)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	consAddress, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	stakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), validator.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(5, 1))
+
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(4), gateway.GatewayQuota)
+
+	k.SyncSlashedGatewayQuotas(ctx)
+	gateway, err = k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), gateway.GatewayQuota)
+	require.Len(t, gateway.GatewayNum, 2)
+
+	redeemNums, err := k.GetGatewayRedeemNum(ctx)
+	require.NoError(t, err)
+	require.Len(t, redeemNums, 2)
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.GetSlashedGatewayKey(valAddress.String())))
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestGatewayDelegationAfterSlash(t *testing.T) {
+	k, stakingKeeper, bankKeeper, ctx := setupHooksKeeper(t)
+	msgServer := NewMsgServerImpl(k)
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 4, []string{"1001", "1002", "1003", "1004"})
+	minDelegate := k.GetParams(ctx).MinDelegate
+
+	stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := stakingKeeper.GetValidator(ctx, valAddress)
+	require.True(t, found)
+	consAddress, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	stakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), validator.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(5, 1))
+	k.SyncSlashedGatewayQuotas(ctx)
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, minDelegate))
+	require.NoError(t, bankKeeper.MintCoins(ctx, mintModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintModuleName, sdk.AccAddress(valAddress), coins))
+
+	_, err = msgServer.GatewayDelegation(sdk.WrapSDKContext(ctx), types.NewMsgGatewayDelegation(
+		sdk.AccAddress(valAddress).String(), valAddress.String(), sdk.NewCoin(sdk.DefaultBondDenom, minDelegate), []string{"1005"},
+	))
+	require.NoError(t, err)
+
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), gateway.GatewayQuota)
+	require.Len(t, gateway.GatewayNum, 3)
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	_, err = msgServer.GatewayDelegation(sdk.WrapSDKContext(ctx), types.NewMsgGatewayDelegation(
+		sdk.AccAddress(valAddress).String(), valAddress.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), []string{"1006"},
+	))
+	require.ErrorIs(t, err, types.ErrGatewayNum)
+}
+
+func TestSyncSlashedGatewayQuotasSkipsFailures(t *testing.T) {
+	k, stakingKeeper, bankKeeper, ctx := setupHooksKeeper(t)
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 4, []string{"1001", "1002", "1003", "1004"})
+
+	broken := sdk.ValAddress(make([]byte, 20))
+	brokenGateway := types.Gateway{
+		GatewayAddress: broken.String(),
+		GatewayQuota:   1,
+		GatewayNum:     []types.GatewayNumIndex{{GatewayAddress: broken.String(), NumberIndex: "2001"}},
+	}
+	require.NoError(t, k.UpdateGatewayInfo(ctx, brokenGateway))
+	k.BeforeValidatorSlashed(ctx, broken, sdk.NewDecWithPrec(5, 1))
+	require.Less(t, string(types.GetSlashedGatewayKey(broken.String())), string(types.GetSlashedGatewayKey(valAddress.String())))
+
+	stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := stakingKeeper.GetValidator(ctx, valAddress)
+	require.True(t, found)
+	consAddress, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	stakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), validator.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(5, 1))
+
+	k.BeforeDelegationRemoved(ctx, sdk.AccAddress(broken), broken)
+	gateway, err := k.GetGatewayInfo(ctx, broken.String())
+	require.NoError(t, err)
+	require.Equal(t, brokenGateway, *gateway)
+
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SyncSlashedGatewayQuotas(nextCtx)
+
+	gateway, err = k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), gateway.GatewayQuota)
+	require.Len(t, gateway.GatewayNum, 2)
+	for _, numberIndex := range []string{"1003", "1004"} {
+		gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), gatewayNum.Status)
+		require.Equal(t, nextCtx.BlockHeight()+k.GetParams(ctx).Validity, gatewayNum.Validity)
+	}
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.GetSlashedGatewayKey(valAddress.String())))
+
+	gateway, err = k.GetGatewayInfo(ctx, broken.String())
+	require.NoError(t, err)
+	require.Equal(t, brokenGateway, *gateway)
+	require.True(t, ctx.KVStore(k.storeKey).Has(types.GetSlashedGatewayKey(broken.String())))
+}
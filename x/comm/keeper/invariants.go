@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "gateway-quota", GatewayQuotaInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "redeem-num", RedeemNumInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := GatewayQuotaInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = RedeemNumInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+
+func GatewayQuotaInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		gateways, err := k.GetGatewayList(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "gateway-quota", err.Error()), true
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, gateway := range gateways {
+			valAddress, err := sdk.ValAddressFromBech32(gateway.GatewayAddress)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tgateway %s has an invalid address: %s\n", gateway.GatewayAddress, err)
+				continue
+			}
+
+			quota := k.GetGatewayQuota(ctx, valAddress)
+			if gateway.GatewayQuota != quota {
+				count++
+				msg += fmt.Sprintf("\tgateway %s quota %d, expected %d from self-delegation\n", gateway.GatewayAddress, gateway.GatewayQuota, quota)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "gateway-quota", fmt.Sprintf(
+			"found %d gateways with a quota not matching their self-delegation\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+
+func RedeemNumInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		redeemNums, err := k.GetGatewayRedeemNum(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "redeem-num", err.Error()), true
+		}
+		gatewayNums, err := k.GetGatewayNumList(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "redeem-num", err.Error()), true
+		}
+		gateways, err := k.GetGatewayList(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "redeem-num", err.Error()), true
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, gatewayNum := range gatewayNums {
+			if _, ok := redeemNums[gatewayNum.NumberIndex]; ok && gatewayNum.Status == 0 {
+				count++
+				msg += fmt.Sprintf("\tnumber index %s is active and in the redeem set\n", gatewayNum.NumberIndex)
+			}
+		}
+		for _, gateway := range gateways {
+			for _, gatewayNum := range gateway.GatewayNum {
+				if _, ok := redeemNums[gatewayNum.NumberIndex]; ok {
+					count++
+					msg += fmt.Sprintf("\tnumber index %s is held by gateway %s and in the redeem set\n", gatewayNum.NumberIndex, gateway.GatewayAddress)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "redeem-num", fmt.Sprintf(
+			"found %d number indexes both active and in the redeem set\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, gatewayBonus := range k.GetAllGatewayBonus(ctx) {
+			expected = expected.Add(gatewayBonus.Amount)
+		}
+		for _, auction := range k.GetAllPrefixAuction(ctx) {
+			expected = expected.Add(auction.Amount)
+		}
+		balances := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		broken := !balances.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf(
+			"\tsum of claimable gateway bonuses and escrowed bids: %s\n\tmodule account balance: %s\n", expected, balances,
+		)), broken
+	}
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"freemasonry.cc/blockchain/core"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -22,7 +21,7 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramstore paramtypes.Subspace
 
-	stakingKeeper stakingKeeper.Keeper
+	stakingKeeper *stakingKeeper.Keeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 }
@@ -34,7 +33,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
-	stakingKeeper stakingKeeper.Keeper,
+	stakingKeeper *stakingKeeper.Keeper,
 ) Keeper {
 
 	if !ps.HasKeyTable() {
@@ -56,69 +55,74 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) KVHelper(ctx sdk.Context) StoreHelper {
-	store := ctx.KVStore(k.storeKey)
-	return StoreHelper{
-		store,
-	}
+func (k Keeper) SetGatewayDelegateLastTime(ctx sdk.Context, delegateAddress, validatorAddress string) error {
+	return k.SetGatewayDelegateLastHeight(ctx, delegateAddress, validatorAddress, ctx.BlockHeight())
 }
 
-func (k Keeper) AddressBookSet(ctx sdk.Context, fromAddress string, AddressBook []string) error {
-	store := k.KVHelper(ctx)
-	key := types.KeyPrefixAddressBook + fromAddress
-	err := store.Set(key, AddressBook)
+func (k Keeper) SetGatewayDelegateLastHeight(ctx sdk.Context, delegateAddress, validatorAddress string, height int64) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&types.DelegateLastTime{
+		DelegatorAddress: delegateAddress,
+		ValidatorAddress: validatorAddress,
+		Height:           height,
+	})
 	if err != nil {
 		return err
 	}
+	store.Set(types.GetDelegateLastTimeKey(delegateAddress, validatorAddress), bz)
 	return nil
 }
 
 
-func (k Keeper) SetGatewayDelegateLastTime(ctx sdk.Context, delegateAddress, validatorAddress string) error {
-	store := k.KVHelper(ctx)
-	key := types.DelegateLastTimeKey + delegateAddress + "_" + validatorAddress
-	err := store.Set(key, ctx.BlockHeight())
-	if err != nil {
-		return err
+func (k Keeper) GetAllGatewayDelegateLastTime(ctx sdk.Context) ([]types.DelegateLastTime, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDelegateLastTime)
+	defer iterator.Close()
+
+	lastTimes := make([]types.DelegateLastTime, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var lastTime types.DelegateLastTime
+		err := k.cdc.Unmarshal(iterator.Value(), &lastTime)
+		if err != nil {
+			return nil, err
+		}
+		lastTimes = append(lastTimes, lastTime)
 	}
-	return nil
+	return lastTimes, nil
 }
 
 
 func (k Keeper) GetGatewayDelegateLastTime(ctx sdk.Context, delegateAddress, validatorAddress string) (int64, error) {
-	store := k.KVHelper(ctx)
-	key := types.DelegateLastTimeKey + delegateAddress + "_" + validatorAddress
-	var lastHeight int64
-	err := store.GetUnmarshal(key, &lastHeight)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelegateLastTimeKey(delegateAddress, validatorAddress))
+	if bz == nil {
+		return 0, types.ErrDelegateLastTime
+	}
+	var lastTime types.DelegateLastTime
+	err := k.cdc.Unmarshal(bz, &lastTime)
 	if err != nil {
 		return 0, err
 	}
-	return lastHeight, nil
+	return lastTime.Height, nil
 }
 
 
-func (k Keeper) GatewayBonus(ctx sdk.Context) error {
+func (k Keeper) GatewayBonusCheck(ctx sdk.Context) error {
 	params := k.GetParams(ctx)
 
 	if ctx.BlockHeight()%params.BonusCycle == 0 {
-
-		index := ctx.BlockHeight() / params.BonusHalve
-		amount := sdk.NewCoin(sdk.DefaultBondDenom, params.Bonus.Quo(sdk.NewInt(1<<index)))
-		if amount.IsZero() {
-			return nil
-		}
-		return k.bankKeeper.SendCoins(ctx, core.ContractGatewayBonus, core.ContractAddressFee, sdk.NewCoins(amount))
+		return k.DistributeGatewayBonus(ctx, params)
 	}
 	return nil
 }
 
 func (k Keeper) RedeemCheck(ctx sdk.Context) error {
-	redeemMap, err := k.GetGatewayRedeemNum(ctx)
+	redeemArray, err := k.GetMatureGatewayRedeemNum(ctx, ctx.BlockHeight())
 	if err != nil {
 		return err
 	}
 	var numArray []types.GatewayNumIndex
-	for _, val := range redeemMap {
+	for _, val := range redeemArray {
 
 		if val.Status == 1 && val.Validity <= ctx.BlockHeight() {
 			val.GatewayAddress = ""
@@ -251,7 +255,11 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.
 			fees := returnAmount.ToDec().Mul(params.RedeemFee)
 			returnAmount = returnAmount.Sub(fees.RoundInt())
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, fees.RoundInt())
-			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, stakingTypes.BondedPoolName, authtypes.FeeCollectorName, sdk.NewCoins(coin))
+			poolName := stakingTypes.NotBondedPoolName
+			if validator.IsBonded() {
+				poolName = stakingTypes.BondedPoolName
+			}
+			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, poolName, authtypes.FeeCollectorName, sdk.NewCoins(coin))
 			if err != nil {
 				panic(err)
 			}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"strconv"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+func (k Keeper) InitGatewayLiveness(ctx sdk.Context, gatewayAddress string) {
+	if _, found := k.GetGatewayLiveness(ctx, gatewayAddress); found {
+		return
+	}
+	k.SetGatewayLiveness(ctx, types.GatewayLiveness{
+		GatewayAddress: gatewayAddress,
+		StartHeight:    ctx.BlockHeight(),
+		LastHeartbeat:  ctx.BlockHeight(),
+		HeartbeatCount: 1,
+	})
+}
+
+
+func (k Keeper) RecordGatewayHeartbeat(ctx sdk.Context, gatewayAddress sdk.ValAddress) error {
+	if _, err := k.GetGatewayInfo(ctx, gatewayAddress.String()); err != nil {
+		return err
+	}
+	window := k.GetParams(ctx).HeartbeatWindow
+	liveness, found := k.GetGatewayLiveness(ctx, gatewayAddress.String())
+	if !found {
+		liveness = types.GatewayLiveness{
+			GatewayAddress: gatewayAddress.String(),
+			StartHeight:    ctx.BlockHeight(),
+		}
+	} else if liveness.LastHeartbeat/window == ctx.BlockHeight()/window {
+		return types.ErrHeartbeatTooSoon
+	}
+
+	if liveness.Inactive {
+		liveness.Inactive = false
+		liveness.InactiveHeight = 0
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGatewayReactivate,
+				sdk.NewAttribute(types.AttributeKeyGateway, liveness.GatewayAddress),
+			),
+		)
+	}
+	liveness.LastHeartbeat = ctx.BlockHeight()
+	liveness.HeartbeatCount++
+	k.SetGatewayLiveness(ctx, liveness)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGatewayHeartbeat,
+			sdk.NewAttribute(types.AttributeKeyGateway, liveness.GatewayAddress),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+	return nil
+}
+
+
+func (k Keeper) GatewayLivenessCheck(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if ctx.BlockHeight()%params.HeartbeatWindow != 0 {
+		return nil
+	}
+
+	for _, liveness := range k.GetAllGatewayLiveness(ctx) {
+		missed := ctx.BlockHeight()/params.HeartbeatWindow - liveness.LastHeartbeat/params.HeartbeatWindow - 1
+		if missed < params.HeartbeatMissLimit {
+			continue
+		}
+		if !liveness.Inactive {
+			liveness.Inactive = true
+			liveness.InactiveHeight = ctx.BlockHeight()
+			k.SetGatewayLiveness(ctx, liveness)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeGatewayInactive,
+					sdk.NewAttribute(types.AttributeKeyGateway, liveness.GatewayAddress),
+					sdk.NewAttribute(types.AttributeKeyMissed, strconv.FormatInt(missed, 10)),
+				),
+			)
+		}
+		if missed < params.HeartbeatMissLimit+params.HeartbeatRedeemWindows {
+			continue
+		}
+
+		gateway, err := k.GetGatewayInfo(ctx, liveness.GatewayAddress)
+		if err != nil {
+			if err == types.ErrGatewayNotExist {
+				continue
+			}
+			return err
+		}
+		if len(gateway.GatewayNum) == 0 {
+			continue
+		}
+		err = k.redeemGatewayNums(ctx, gateway, 0)
+		if err != nil {
+			return err
+		}
+		err = k.UpdateGatewayInfo(ctx, *gateway)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+func (k Keeper) IsGatewayActive(ctx sdk.Context, gatewayAddress string) bool {
+	liveness, found := k.GetGatewayLiveness(ctx, gatewayAddress)
+	return !found || !liveness.Inactive
+}
+
+
+func (k Keeper) GetGatewayUptime(ctx sdk.Context, liveness types.GatewayLiveness) (uint64, sdk.Dec) {
+	window := k.GetParams(ctx).HeartbeatWindow
+	windows := uint64(ctx.BlockHeight()/window - liveness.StartHeight/window + 1)
+	if liveness.HeartbeatCount >= windows {
+		return windows, sdk.OneDec()
+	}
+	return windows, sdk.NewDec(int64(liveness.HeartbeatCount)).QuoInt64(int64(windows))
+}
+
+
+func (k Keeper) GetGatewayLiveness(ctx sdk.Context, gatewayAddress string) (types.GatewayLiveness, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGatewayLivenessKey(gatewayAddress))
+	if bz == nil {
+		return types.GatewayLiveness{}, false
+	}
+	var liveness types.GatewayLiveness
+	k.cdc.MustUnmarshal(bz, &liveness)
+	return liveness, true
+}
+
+func (k Keeper) SetGatewayLiveness(ctx sdk.Context, liveness types.GatewayLiveness) {
+	ctx.KVStore(k.storeKey).Set(types.GetGatewayLivenessKey(liveness.GatewayAddress), k.cdc.MustMarshal(&liveness))
+}
+
+
+func (k Keeper) GetAllGatewayLiveness(ctx sdk.Context) []types.GatewayLiveness {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixGatewayLiveness)
+	defer iterator.Close()
+
+	livenesses := make([]types.GatewayLiveness, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var liveness types.GatewayLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &liveness)
+		livenesses = append(livenesses, liveness)
+	}
+	return livenesses
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayLiveness(t *testing.T) {
+	k, _, bankKeeper, ctx := setupHooksKeeper(t)
+	msgServer := NewMsgServerImpl(k)
+	params := k.GetParams(ctx)
+	params.HeartbeatWindow = 10
+	params.HeartbeatMissLimit = 2
+	params.HeartbeatRedeemWindows = 3
+	k.SetParams(ctx, params)
+
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 2, []string{"1001", "1002"})
+	heartbeat := func(ctx sdk.Context, addr sdk.AccAddress) error {
+		_, err := msgServer.GatewayHeartbeat(sdk.WrapSDKContext(ctx), types.NewMsgGatewayHeartbeat(addr.String()))
+		return err
+	}
+	checkAt := func(height int64) sdk.Context {
+		ctx := ctx.WithBlockHeight(height)
+		require.NoError(t, k.GatewayLivenessCheck(ctx))
+		return ctx
+	}
+
+	liveness, found := k.GetGatewayLiveness(ctx, valAddress.String())
+	require.True(t, found)
+	require.Equal(t, types.GatewayLiveness{GatewayAddress: valAddress.String(), StartHeight: 1, LastHeartbeat: 1, HeartbeatCount: 1}, liveness)
+
+	require.ErrorIs(t, heartbeat(ctx.WithBlockHeight(5), sdk.AccAddress(valAddress)), types.ErrHeartbeatTooSoon)
+	require.ErrorIs(t, heartbeat(ctx.WithBlockHeight(12), sdk.AccAddress([]byte("stranger____________"))), types.ErrGatewayNotExist)
+	require.NoError(t, heartbeat(ctx.WithBlockHeight(12), sdk.AccAddress(valAddress)))
+	require.ErrorIs(t, heartbeat(ctx.WithBlockHeight(19), sdk.AccAddress(valAddress)), types.ErrHeartbeatTooSoon)
+
+	checkAt(30)
+	require.True(t, k.IsGatewayActive(ctx, valAddress.String()))
+
+	inactiveCtx := checkAt(40)
+	require.False(t, k.IsGatewayActive(ctx, valAddress.String()))
+	liveness, _ = k.GetGatewayLiveness(ctx, valAddress.String())
+	require.Equal(t, int64(40), liveness.InactiveHeight)
+	events := inactiveCtx.EventManager().Events()
+	require.Equal(t, types.EventTypeGatewayInactive, events[len(events)-1].Type)
+
+	checkAt(60)
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Len(t, gateway.GatewayNum, 2)
+
+	checkAt(70)
+	gateway, err = k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Empty(t, gateway.GatewayNum)
+	require.Equal(t, int64(2), gateway.GatewayQuota)
+	redeemNums, err := k.GetGatewayRedeemNum(ctx)
+	require.NoError(t, err)
+	require.Len(t, redeemNums, 2)
+	for _, numberIndex := range []string{"1001", "1002"} {
+		gatewayNum, _, err := k.GetGatewayNum(ctx, numberIndex)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), gatewayNum.Status)
+		require.Equal(t, int64(80), gatewayNum.Validity)
+	}
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, heartbeat(ctx.WithBlockHeight(71), sdk.AccAddress(valAddress)))
+	require.True(t, k.IsGatewayActive(ctx, valAddress.String()))
+
+	res, err := k.GatewayUptime(sdk.WrapSDKContext(ctx.WithBlockHeight(71)), &types.QueryGatewayUptimeRequest{GatewayAddress: valAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Liveness.HeartbeatCount)
+	require.False(t, res.Liveness.Inactive)
+	require.Equal(t, uint64(8), res.Windows)
+	require.Equal(t, sdk.NewDecWithPrec(375, 3), res.Uptime)
+}
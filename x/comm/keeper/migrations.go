@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	v3 "freemasonry.cc/blockchain/x/comm/migrations/v3"
+	v4 "freemasonry.cc/blockchain/x/comm/migrations/v4"
+	v6 "freemasonry.cc/blockchain/x/comm/migrations/v6"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+
+type Migrator struct {
+	keeper Keeper
+}
+
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	err := v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+	if err != nil {
+		return err
+	}
+	err = v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+	if err != nil {
+		return err
+	}
+	err = m.resyncGatewayQuotas(ctx)
+	if err != nil {
+		return err
+	}
+	err = v6.MigrateParams(ctx, m.keeper.paramstore)
+	if err != nil {
+		return err
+	}
+	gateways, err := m.keeper.GetGatewayList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, gateway := range gateways {
+		m.keeper.InitGatewayLiveness(ctx, gateway.GatewayAddress)
+	}
+	return nil
+}
+
+
+func (m Migrator) resyncGatewayQuotas(ctx sdk.Context) error {
+	gateways, err := m.keeper.GetGatewayList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, gateway := range gateways {
+		valAddress, err := sdk.ValAddressFromBech32(gateway.GatewayAddress)
+		if err != nil {
+			return err
+		}
+		err = m.keeper.SyncGatewayQuota(ctx, valAddress, m.keeper.GetGatewayQuota(ctx, valAddress))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateFromV2Params(t *testing.T) {
+	k, stakingKeeper, bankKeeper, ctx, paramsKey := setupHooksKeeperWithParamsKey(t)
+	valAddress := registerHooksGateway(t, k, bankKeeper, ctx, 4, []string{"1001", "1002", "1003", "1004"})
+	params := k.GetParams(ctx)
+
+	stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := stakingKeeper.GetValidator(ctx, valAddress)
+	require.True(t, found)
+	consAddress, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	stakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), validator.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(5, 1))
+
+	paramsStore := prefix.NewStore(ctx.KVStore(paramsKey), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyBonusDecay,
+		types.KeyBonusUserWeight,
+		types.KeyPremiumPrefixes,
+		types.KeyAuctionPeriod,
+		types.KeyAuctionMinBid,
+		types.KeyHeartbeatWindow,
+		types.KeyHeartbeatMissLimit,
+		types.KeyHeartbeatRedeemWindows,
+	} {
+		paramsStore.Delete(key)
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	migrator := NewMigrator(k)
+	require.NotPanics(t, func() { require.NoError(t, migrator.Migrate2to3(ctx)) })
+
+	gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), gateway.GatewayQuota)
+	require.Len(t, gateway.GatewayNum, 2)
+	redeemNum, _, err := k.GetGatewayNum(ctx, "1004")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), redeemNum.Status)
+	require.Equal(t, ctx.BlockHeight()+params.Validity, redeemNum.Validity)
+
+	require.NotPanics(t, func() { k.GetParams(ctx) })
+	require.Equal(t, params.MinDelegate, k.GetParams(ctx).MinDelegate)
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
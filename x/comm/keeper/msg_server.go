@@ -57,16 +57,14 @@ func (k msgServer) GatewayDelegation(goCtx context.Context, msg *types.MsgGatewa
 			return nil, err
 		}
 		
-		delegation, found := k.stakingKeeper.GetDelegation(ctx, delegatorAddress, valAddr)
+		_, found := k.stakingKeeper.GetDelegation(ctx, delegatorAddress, valAddr)
 		if !found {
 			return nil, stakingTypes.ErrNoDelegation
 		}
-		params := k.GetParams(ctx)
-		num := delegation.Shares.QuoInt(params.MinDelegate)
-		gateway.GatewayQuota = num.TruncateInt64()
+		gateway.GatewayQuota = k.GetGatewayQuota(ctx, valAddr)
 		if len(msg.IndexNumber) > 0 {
 			
-			if num.Sub(sdk.NewInt(int64(len(gateway.GatewayNum))).ToDec()).LT(sdk.NewDec(int64(len(msg.IndexNumber)))) {
+			if gateway.GatewayQuota-int64(len(gateway.GatewayNum)) < int64(len(msg.IndexNumber)) {
 				return nil, types.ErrGatewayNum
 			}
 			indexNumArray, err := k.GatewayNumFilter(ctx, msg.ValidatorAddress, msg.IndexNumber)
@@ -127,6 +125,64 @@ func (k msgServer) GatewayUndelegate(goCtx context.Context, msg *types.MsgGatewa
 	if !found {
 		return nil, stakingTypes.ErrNoDelegatorForAddress
 	}
+	if validator.GetOperator().String() == sdk.ValAddress(delegatorAddress).String() {
+		
+		gatewayInfo, err := k.GetGatewayInfo(ctx, validator.GetOperator().String())
+		if err != nil && err != types.ErrGatewayNotExist {
+			return nil, err
+		}
+		if err == nil {
+			params := k.GetParams(ctx)
+			
+			balanceShares := delegation.Shares.Sub(shares)
+			
+			gatewayInfo.GatewayQuota = validator.TokensFromShares(balanceShares).QuoInt(params.MinDelegate).TruncateInt64()
+			
+			holdNum := int64(len(gatewayInfo.GatewayNum))
+			
+			if holdNum-int64(len(msg.IndexNumber)) > gatewayInfo.GatewayQuota {
+				return nil, types.ErrGatewayNum
+			}
+			if len(msg.IndexNumber) > 0 {
+				var indexNumArray []types.GatewayNumIndex
+				for _, val := range msg.IndexNumber {
+					
+					indexNum, _, err := k.GetGatewayNum(ctx, val)
+					if err != nil {
+						return nil, err
+					}
+					if indexNum == nil {
+						return nil, types.ErrGatewayNumNotFound
+					}
+					indexNum.Status = 1 
+					indexNum.Validity = ctx.BlockHeight() + params.Validity
+					indexNumArray = append(indexNumArray, *indexNum)
+					
+					for i, gatewayNum := range gatewayInfo.GatewayNum {
+						if gatewayNum.NumberIndex == val {
+							gatewayInfo.GatewayNum = append(gatewayInfo.GatewayNum[:i], gatewayInfo.GatewayNum[i+1:]...)
+						}
+					}
+				}
+				
+				err = k.SetGatewayNum(ctx, indexNumArray)
+				if err != nil {
+					return nil, err
+				}
+				
+				err = k.SetGatewayRedeemNum(ctx, indexNumArray)
+				if err != nil {
+					return nil, err
+				}
+			}
+			
+			err = k.UpdateGatewayInfo(ctx, *gatewayInfo)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	completionTime, returnAmount, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, validator, shares)
 	if err != nil {
 		return nil, err
@@ -149,73 +205,6 @@ func (k msgServer) GatewayUndelegate(goCtx context.Context, msg *types.MsgGatewa
 		),
 	})
 
-	
-	if validator.GetOperator().String() == sdk.ValAddress(delegatorAddress).String() {
-		
-		gatewayInfo, err := k.GetGatewayInfo(ctx, validator.GetOperator().String())
-		if err != nil {
-			if err == types.ErrGatewayNumNotFound {
-				return &types.MsgEmptyResponse{}, nil
-			}
-			return nil, err
-		}
-		params := k.GetParams(ctx)
-		
-		balanceShares := delegation.Shares.Sub(shares)
-		
-		num := balanceShares.QuoInt(params.MinDelegate)
-
-		gatewayInfo.GatewayQuota = num.TruncateInt64()
-		
-		holdNum := int64(len(gatewayInfo.GatewayNum))
-		
-		if holdNum-int64(len(msg.IndexNumber)) > gatewayInfo.GatewayQuota {
-			return nil, types.ErrGatewayNum
-		}
-		if len(msg.IndexNumber) > 0 {
-			var indexNumArray []types.GatewayNumIndex
-			for _, val := range msg.IndexNumber {
-				
-				indexNum, _, err := k.GetGatewayNum(ctx, val)
-				if err != nil {
-					return nil, err
-				}
-				if indexNum == nil {
-					return nil, types.ErrGatewayNumNotFound
-				}
-				indexNum.Status = 1 
-				indexNum.Validity = indexNum.Validity + params.Validity
-				indexNumArray = append(indexNumArray, *indexNum)
-				
-				for i, gatewayNum := range gatewayInfo.GatewayNum {
-					if gatewayNum.NumberIndex == val {
-						gatewayInfo.GatewayNum = append(gatewayInfo.GatewayNum[:i], gatewayInfo.GatewayNum[i+1:]...)
-					}
-				}
-			}
-			
-			err := k.UpdateGatewayInfo(ctx, *gatewayInfo)
-			if err != nil {
-				return nil, err
-			}
-			
-			err = k.SetGatewayNum(ctx, indexNumArray)
-			if err != nil {
-				return nil, err
-			}
-			
-			err = k.SetGatewayRedeemNum(ctx, indexNumArray)
-			if err != nil {
-				return nil, err
-			}
-		}
-		
-		err = k.UpdateGatewayInfo(ctx, *gatewayInfo)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	return &types.MsgEmptyResponse{}, nil
 }
 
@@ -262,10 +251,69 @@ func (k msgServer) GatewayRegister(goCtx context.Context, msg *types.MsgGatewayR
 		if delegate.Shares.LT(params.MinDelegate.ToDec()) {
 			return &types.MsgEmptyResponse{}, types.ErrGatewayDelegation
 		}
-		delegation = sdk.NewCoin(sdk.DefaultBondDenom, delegate.Shares.TruncateInt())
 	}
 	
-	err = k.SetGateway(ctx, *msg, delegation, valAddress.String())
+	err = k.SetGateway(ctx, *msg, valAddress.String())
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k msgServer) WithdrawGatewayBonus(goCtx context.Context, msg *types.MsgWithdrawGatewayBonus) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	_, err = k.ClaimGatewayBonus(ctx, sdk.ValAddress(addr))
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	err = k.PlacePrefixBid(ctx, addr, msg.NumberIndex, msg.Amount)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k msgServer) GatewayHeartbeat(goCtx context.Context, msg *types.MsgGatewayHeartbeat) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	err = k.RecordGatewayHeartbeat(ctx, sdk.ValAddress(addr))
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+	return &types.MsgEmptyResponse{}, nil
+}
+
+
+func (k msgServer) GatewayEditEndpoints(goCtx context.Context, msg *types.MsgGatewayEditEndpoints) (*types.MsgEmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return &types.MsgEmptyResponse{}, err
+	}
+
+	err = k.SetGatewayEndpoints(ctx, sdk.ValAddress(addr), msg.Endpoints, msg.TlsFingerprint, msg.TransportPubKey)
 	if err != nil {
 		return &types.MsgEmptyResponse{}, err
 	}
@@ -14,3 +14,21 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+func (k Keeper) GetMinDelegate(ctx sdk.Context) sdk.Int {
+	minDelegate := types.DefaultMinDelegate
+	k.paramstore.GetIfExists(ctx, types.KeyMinDelegate, &minDelegate)
+	return minDelegate
+}
+
+func (k Keeper) GetValidity(ctx sdk.Context) int64 {
+	validity := types.DefaultValidity
+	k.paramstore.GetIfExists(ctx, types.KeyValidity, &validity)
+	return validity
+}
+
+func (k Keeper) GetBonusCycle(ctx sdk.Context) int64 {
+	bonusCycle := types.DefaultBonusCycle
+	k.paramstore.GetIfExists(ctx, types.KeyBonusCycle, &bonusCycle)
+	return bonusCycle
+}
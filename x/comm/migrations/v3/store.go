@@ -0,0 +1,76 @@
+package v3
+
+import (
+	"sort"
+
+	"freemasonry.cc/blockchain/util"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	LegacyGatewayNumKey = "gateway_num"
+
+	LegacyGatewayRedeemNumKey = "gateway_redeem_num"
+)
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	gatewayNums, err := readLegacyNumMap(store, LegacyGatewayNumKey)
+	if err != nil {
+		return err
+	}
+	for _, gatewayNum := range gatewayNums {
+		bz, err := cdc.Marshal(&gatewayNum)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetGatewayNumKey(gatewayNum.NumberIndex), bz)
+		if gatewayNum.GatewayAddress != "" {
+			store.Set(types.GetGatewayNumByGatewayKey(gatewayNum.GatewayAddress, gatewayNum.NumberIndex), []byte{})
+		}
+	}
+	store.Delete([]byte(LegacyGatewayNumKey))
+
+	redeemNums, err := readLegacyNumMap(store, LegacyGatewayRedeemNumKey)
+	if err != nil {
+		return err
+	}
+	for _, gatewayNum := range redeemNums {
+		bz, err := cdc.Marshal(&gatewayNum)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetGatewayRedeemNumKey(gatewayNum.NumberIndex), bz)
+		store.Set(types.GetGatewayRedeemQueueKey(gatewayNum.Validity, gatewayNum.NumberIndex), []byte{})
+	}
+	store.Delete([]byte(LegacyGatewayRedeemNumKey))
+
+	return nil
+}
+
+func readLegacyNumMap(store sdk.KVStore, key string) ([]types.GatewayNumIndex, error) {
+	bz := store.Get([]byte(key))
+	if bz == nil {
+		return nil, nil
+	}
+
+	gatewayNumMap := make(map[string]types.GatewayNumIndex)
+	err := util.Json.Unmarshal(bz, &gatewayNumMap)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayNums := make([]types.GatewayNumIndex, 0, len(gatewayNumMap))
+	for numberIndex, gatewayNum := range gatewayNumMap {
+		gatewayNum.NumberIndex = numberIndex
+		gatewayNums = append(gatewayNums, gatewayNum)
+	}
+	sort.Slice(gatewayNums, func(i, j int) bool {
+		return gatewayNums[i].NumberIndex < gatewayNums[j].NumberIndex
+	})
+	return gatewayNums, nil
+}
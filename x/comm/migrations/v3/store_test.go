@@ -0,0 +1,54 @@
+package v3_test
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/util"
+	v3 "freemasonry.cc/blockchain/x/comm/migrations/v3"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	gatewayNums := map[string]types.GatewayNumIndex{
+		"1001": {GatewayAddress: "dexvaloper1a", NumberIndex: "1001", NumberEnd: []string{"100100000"}},
+		"1002": {GatewayAddress: "dexvaloper1a", NumberIndex: "1002", Status: 1, Validity: 50},
+		"1003": {NumberIndex: "1003", Status: 2},
+	}
+	bz, err := util.Json.Marshal(gatewayNums)
+	require.NoError(t, err)
+	store.Set([]byte(v3.LegacyGatewayNumKey), bz)
+
+	redeemNums := map[string]types.GatewayNumIndex{
+		"1002": gatewayNums["1002"],
+	}
+	bz, err = util.Json.Marshal(redeemNums)
+	require.NoError(t, err)
+	store.Set([]byte(v3.LegacyGatewayRedeemNumKey), bz)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	require.False(t, store.Has([]byte(v3.LegacyGatewayNumKey)))
+	require.False(t, store.Has([]byte(v3.LegacyGatewayRedeemNumKey)))
+
+	for numberIndex, expected := range gatewayNums {
+		var gatewayNum types.GatewayNumIndex
+		require.NoError(t, cdc.Unmarshal(store.Get(types.GetGatewayNumKey(numberIndex)), &gatewayNum))
+		require.Equal(t, expected.GatewayAddress, gatewayNum.GatewayAddress)
+		require.Equal(t, expected.NumberEnd, gatewayNum.NumberEnd)
+		require.Equal(t, expected.Status, gatewayNum.Status)
+		require.Equal(t, expected.GatewayAddress != "", store.Has(types.GetGatewayNumByGatewayKey(expected.GatewayAddress, numberIndex)))
+	}
+
+	require.True(t, store.Has(types.GetGatewayRedeemNumKey("1002")))
+	require.True(t, store.Has(types.GetGatewayRedeemQueueKey(50, "1002")))
+}
@@ -0,0 +1,92 @@
+package v4
+
+import (
+	"strings"
+
+	"freemasonry.cc/blockchain/util"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	LegacyGatewayKey = "gateway_"
+
+	LegacyDelegateLastTimeKey = "delegate_last_time_"
+
+	LegacyKeyPrefixAddressBook = "comm_address_book"
+)
+
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	err := migratePrefix(store, LegacyGatewayKey, func(_ string, value []byte) error {
+		var gateway types.Gateway
+		err := util.Json.Unmarshal(value, &gateway)
+		if err != nil {
+			return err
+		}
+		if gateway.GatewayAddress == "" {
+			return nil
+		}
+		bz, err := cdc.Marshal(&gateway)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetGatewayKey(gateway.GatewayAddress), bz)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = migratePrefix(store, LegacyDelegateLastTimeKey, func(suffix string, value []byte) error {
+		addresses := strings.SplitN(suffix, "_", 2)
+		if len(addresses) != 2 {
+			return nil
+		}
+		lastTime := types.DelegateLastTime{
+			DelegatorAddress: addresses[0],
+			ValidatorAddress: addresses[1],
+		}
+		err := util.Json.Unmarshal(value, &lastTime.Height)
+		if err != nil {
+			return err
+		}
+		bz, err := cdc.Marshal(&lastTime)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetDelegateLastTimeKey(lastTime.DelegatorAddress, lastTime.ValidatorAddress), bz)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return migratePrefix(store, LegacyKeyPrefixAddressBook, func(_ string, _ []byte) error {
+		return nil
+	})
+}
+
+
+func migratePrefix(store sdk.KVStore, prefix string, migrate func(suffix string, value []byte) error) error {
+	iterator := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+		values = append(values, append([]byte{}, iterator.Value()...))
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		err := migrate(string(key[len(prefix):]), values[i])
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+	}
+	return nil
+}
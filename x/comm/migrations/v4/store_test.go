@@ -0,0 +1,57 @@
+package v4_test
+
+import (
+	"testing"
+
+	"freemasonry.cc/blockchain/util"
+	v4 "freemasonry.cc/blockchain/x/comm/migrations/v4"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	gateway := types.Gateway{
+		GatewayAddress: "dexvaloper1a",
+		GatewayName:    "gateway",
+		GatewayUrl:     "https://gateway.example",
+		GatewayQuota:   3,
+		GatewayNum:     []types.GatewayNumIndex{{GatewayAddress: "dexvaloper1a", NumberIndex: "1001"}},
+	}
+	bz, err := util.Json.Marshal(gateway)
+	require.NoError(t, err)
+	store.Set([]byte(v4.LegacyGatewayKey+gateway.GatewayAddress), bz)
+
+	bz, err = util.Json.Marshal(int64(42))
+	require.NoError(t, err)
+	store.Set([]byte(v4.LegacyDelegateLastTimeKey+"dex1a_dexvaloper1a"), bz)
+
+	store.Set([]byte(v4.LegacyKeyPrefixAddressBook+"dex1a"), []byte(`["1001"]`))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	require.False(t, store.Has([]byte(v4.LegacyGatewayKey+gateway.GatewayAddress)))
+	require.False(t, store.Has([]byte(v4.LegacyDelegateLastTimeKey+"dex1a_dexvaloper1a")))
+	require.False(t, store.Has([]byte(v4.LegacyKeyPrefixAddressBook+"dex1a")))
+
+	var migrated types.Gateway
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetGatewayKey(gateway.GatewayAddress)), &migrated))
+	require.Equal(t, gateway.GatewayName, migrated.GatewayName)
+	require.Equal(t, gateway.GatewayUrl, migrated.GatewayUrl)
+	require.Equal(t, gateway.GatewayQuota, migrated.GatewayQuota)
+	require.Equal(t, gateway.GatewayNum, migrated.GatewayNum)
+
+	var lastTime types.DelegateLastTime
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetDelegateLastTimeKey("dex1a", "dexvaloper1a")), &lastTime))
+	require.Equal(t, int64(42), lastTime.Height)
+	require.Equal(t, "dex1a", lastTime.DelegatorAddress)
+	require.Equal(t, "dexvaloper1a", lastTime.ValidatorAddress)
+}
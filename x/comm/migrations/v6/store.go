@@ -0,0 +1,37 @@
+package v6
+
+import (
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+
+func MigrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	if !paramstore.Has(ctx, types.KeyBonusDecay) {
+		paramstore.Set(ctx, types.KeyBonusDecay, params.BonusDecay)
+	}
+	if !paramstore.Has(ctx, types.KeyBonusUserWeight) {
+		paramstore.Set(ctx, types.KeyBonusUserWeight, params.BonusUserWeight)
+	}
+	if !paramstore.Has(ctx, types.KeyPremiumPrefixes) {
+		paramstore.Set(ctx, types.KeyPremiumPrefixes, params.PremiumPrefixes)
+	}
+	if !paramstore.Has(ctx, types.KeyAuctionPeriod) {
+		paramstore.Set(ctx, types.KeyAuctionPeriod, params.AuctionPeriod)
+	}
+	if !paramstore.Has(ctx, types.KeyAuctionMinBid) {
+		paramstore.Set(ctx, types.KeyAuctionMinBid, params.AuctionMinBid)
+	}
+	if !paramstore.Has(ctx, types.KeyHeartbeatWindow) {
+		paramstore.Set(ctx, types.KeyHeartbeatWindow, params.HeartbeatWindow)
+	}
+	if !paramstore.Has(ctx, types.KeyHeartbeatMissLimit) {
+		paramstore.Set(ctx, types.KeyHeartbeatMissLimit, params.HeartbeatMissLimit)
+	}
+	if !paramstore.Has(ctx, types.KeyHeartbeatRedeemWindows) {
+		paramstore.Set(ctx, types.KeyHeartbeatRedeemWindows, params.HeartbeatRedeemWindows)
+	}
+	return nil
+}
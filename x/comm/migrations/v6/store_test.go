@@ -0,0 +1,54 @@
+package v6_test
+
+import (
+	"testing"
+
+	v6 "freemasonry.cc/blockchain/x/comm/migrations/v6"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	amino := codec.NewLegacyAmino()
+	paramstore := paramtypes.NewSubspace(cdc, amino, storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	require.NoError(t, v6.MigrateParams(ctx, paramstore))
+
+	defaults := types.DefaultParams()
+	testCases := []struct {
+		name     string
+		key      []byte
+		expected interface{}
+	}{
+		{"bonus decay", types.KeyBonusDecay, defaults.BonusDecay},
+		{"bonus user weight", types.KeyBonusUserWeight, defaults.BonusUserWeight},
+		{"premium prefixes", types.KeyPremiumPrefixes, defaults.PremiumPrefixes},
+		{"auction period", types.KeyAuctionPeriod, defaults.AuctionPeriod},
+		{"auction min bid", types.KeyAuctionMinBid, defaults.AuctionMinBid},
+		{"heartbeat window", types.KeyHeartbeatWindow, defaults.HeartbeatWindow},
+		{"heartbeat miss limit", types.KeyHeartbeatMissLimit, defaults.HeartbeatMissLimit},
+		{"heartbeat redeem windows", types.KeyHeartbeatRedeemWindows, defaults.HeartbeatRedeemWindows},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := amino.MarshalJSON(tc.expected)
+			require.NoError(t, err)
+			require.Equal(t, string(bz), string(paramstore.GetRaw(ctx, tc.key)))
+		})
+	}
+
+	paramstore.Set(ctx, types.KeyBonusUserWeight, sdk.OneDec())
+	require.NoError(t, v6.MigrateParams(ctx, paramstore))
+	var bonusUserWeight sdk.Dec
+	paramstore.Get(ctx, types.KeyBonusUserWeight, &bonusUserWeight)
+	require.Equal(t, sdk.OneDec(), bonusUserWeight)
+}
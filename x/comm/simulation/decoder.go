@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayNum),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayRedeemNum):
+			var gatewayNumA, gatewayNumB types.GatewayNumIndex
+
+			cdc.MustUnmarshal(kvA.Value, &gatewayNumA)
+			cdc.MustUnmarshal(kvB.Value, &gatewayNumB)
+
+			return fmt.Sprintf("%v\n%v", gatewayNumA, gatewayNumB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayNumByGateway):
+			gatewayA, numberIndexA := types.ParseGatewayNumByGatewayKey(kvA.Key)
+			gatewayB, numberIndexB := types.ParseGatewayNumByGatewayKey(kvB.Key)
+
+			return fmt.Sprintf("%s %s\n%s %s", gatewayA, numberIndexA, gatewayB, numberIndexB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayRedeemQueue):
+			validityA, numberIndexA := types.ParseGatewayRedeemQueueKey(kvA.Key)
+			validityB, numberIndexB := types.ParseGatewayRedeemQueueKey(kvB.Key)
+
+			return fmt.Sprintf("%d %s\n%d %s", validityA, numberIndexA, validityB, numberIndexB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGateway):
+			var gatewayA, gatewayB types.Gateway
+
+			cdc.MustUnmarshal(kvA.Value, &gatewayA)
+			cdc.MustUnmarshal(kvB.Value, &gatewayB)
+
+			return fmt.Sprintf("%v\n%v", gatewayA, gatewayB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDelegateLastTime):
+			var lastTimeA, lastTimeB types.DelegateLastTime
+
+			cdc.MustUnmarshal(kvA.Value, &lastTimeA)
+			cdc.MustUnmarshal(kvB.Value, &lastTimeB)
+
+			return fmt.Sprintf("%v\n%v", lastTimeA, lastTimeB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPortedNum):
+			return fmt.Sprintf("%s %s\n%s %s", kvA.Key[1:], kvA.Value, kvB.Key[1:], kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixSlashedGateway):
+			return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayBonus):
+			var gatewayBonusA, gatewayBonusB types.GatewayBonus
+
+			cdc.MustUnmarshal(kvA.Value, &gatewayBonusA)
+			cdc.MustUnmarshal(kvB.Value, &gatewayBonusB)
+
+			return fmt.Sprintf("%v\n%v", gatewayBonusA, gatewayBonusB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPrefixAuction):
+			var auctionA, auctionB types.PrefixAuction
+
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+
+			return fmt.Sprintf("%v\n%v", auctionA, auctionB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAuctionQueue):
+			endHeightA, numberIndexA := types.ParseAuctionQueueKey(kvA.Key)
+			endHeightB, numberIndexB := types.ParseAuctionQueueKey(kvB.Key)
+
+			return fmt.Sprintf("%d %s\n%d %s", endHeightA, numberIndexA, endHeightB, numberIndexB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPrefixBid):
+			var bidA, bidB types.PrefixBid
+
+			cdc.MustUnmarshal(kvA.Value, &bidA)
+			cdc.MustUnmarshal(kvB.Value, &bidB)
+
+			return fmt.Sprintf("%v\n%v", bidA, bidB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayUserCount):
+			return fmt.Sprintf("%s %d\n%s %d", kvA.Key[1:], sdk.BigEndianToUint64(kvA.Value), kvB.Key[1:], sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixGatewayLiveness):
+			var livenessA, livenessB types.GatewayLiveness
+
+			cdc.MustUnmarshal(kvA.Value, &livenessA)
+			cdc.MustUnmarshal(kvB.Value, &livenessB)
+
+			return fmt.Sprintf("%v\n%v", livenessA, livenessB)
+		default:
+			panic(fmt.Sprintf("invalid comm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
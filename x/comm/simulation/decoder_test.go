@@ -0,0 +1,80 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/simulation"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	gatewayAddress := "gateway"
+	numberIndex := "100000"
+	gatewayNum := types.GatewayNumIndex{GatewayAddress: gatewayAddress, NumberIndex: numberIndex, NumberEnd: []string{"10000000001"}}
+	gateway := types.Gateway{GatewayAddress: gatewayAddress, GatewayName: "gateway", GatewayNum: []types.GatewayNumIndex{gatewayNum}}
+	lastTime := types.DelegateLastTime{DelegatorAddress: "delegator", ValidatorAddress: gatewayAddress, Height: 10}
+	bid := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))
+	auction := types.PrefixAuction{NumberIndex: "888888", Bidder: "bidder", Amount: bid, StartHeight: 1, EndHeight: 11, BidCount: 1}
+	prefixBid := types.PrefixBid{NumberIndex: "888888", Bidder: "bidder", Amount: bid, Height: 1}
+	liveness := types.GatewayLiveness{GatewayAddress: gatewayAddress, StartHeight: 1, LastHeartbeat: 5, HeartbeatCount: 2}
+	gatewayBonus := types.GatewayBonus{GatewayAddress: gatewayAddress, Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetGatewayNumKey(numberIndex), Value: cdc.MustMarshal(&gatewayNum)},
+			{Key: types.GetGatewayNumByGatewayKey(gatewayAddress, numberIndex), Value: []byte{}},
+			{Key: types.GetGatewayRedeemQueueKey(10, numberIndex), Value: []byte{}},
+			{Key: types.GetGatewayKey(gatewayAddress), Value: cdc.MustMarshal(&gateway)},
+			{Key: types.GetDelegateLastTimeKey("delegator", gatewayAddress), Value: cdc.MustMarshal(&lastTime)},
+			{Key: types.GetPortedNumKey("20000000001"), Value: []byte(numberIndex)},
+			{Key: types.GetSlashedGatewayKey(gatewayAddress), Value: []byte{}},
+			{Key: types.GetGatewayBonusKey(gatewayAddress), Value: cdc.MustMarshal(&gatewayBonus)},
+			{Key: types.GetGatewayUserCountKey(gatewayAddress), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: types.GetPrefixAuctionKey("888888"), Value: cdc.MustMarshal(&auction)},
+			{Key: types.GetAuctionQueueKey(11, "888888"), Value: []byte{}},
+			{Key: types.GetPrefixBidKey("888888", 0), Value: cdc.MustMarshal(&prefixBid)},
+			{Key: types.GetGatewayLivenessKey(gatewayAddress), Value: cdc.MustMarshal(&liveness)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"GatewayNum", fmt.Sprintf("%v\n%v", gatewayNum, gatewayNum)},
+		{"GatewayNumByGateway", fmt.Sprintf("%s %s\n%s %s", gatewayAddress, numberIndex, gatewayAddress, numberIndex)},
+		{"GatewayRedeemQueue", fmt.Sprintf("%d %s\n%d %s", 10, numberIndex, 10, numberIndex)},
+		{"Gateway", fmt.Sprintf("%v\n%v", gateway, gateway)},
+		{"DelegateLastTime", fmt.Sprintf("%v\n%v", lastTime, lastTime)},
+		{"PortedNum", fmt.Sprintf("%s %s\n%s %s", "20000000001", numberIndex, "20000000001", numberIndex)},
+		{"SlashedGateway", fmt.Sprintf("%s\n%s", gatewayAddress, gatewayAddress)},
+		{"GatewayBonus", fmt.Sprintf("%v\n%v", gatewayBonus, gatewayBonus)},
+		{"GatewayUserCount", fmt.Sprintf("%s %d\n%s %d", gatewayAddress, 3, gatewayAddress, 3)},
+		{"PrefixAuction", fmt.Sprintf("%v\n%v", auction, auction)},
+		{"AuctionQueue", fmt.Sprintf("%d %s\n%d %s", 11, "888888", 11, "888888")},
+		{"PrefixBid", fmt.Sprintf("%v\n%v", prefixBid, prefixBid)},
+		{"GatewayLiveness", fmt.Sprintf("%v\n%v", liveness, liveness)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+
+const (
+	redeemFeeHeight        = "redeem_fee_height"
+	redeemFee              = "redeem_fee"
+	minDelegate            = "min_delegate"
+	validity               = "validity"
+	bonusCycle             = "bonus_cycle"
+	bonusHalve             = "bonus_halve"
+	bonus                  = "bonus"
+	bonusDecay             = "bonus_decay"
+	bonusUserWeight        = "bonus_user_weight"
+	premiumPrefixes        = "premium_prefixes"
+	auctionPeriod          = "auction_period"
+	auctionMinBid          = "auction_min_bid"
+	heartbeatWindow        = "heartbeat_window"
+	heartbeatMissLimit     = "heartbeat_miss_limit"
+	heartbeatRedeemWindows = "heartbeat_redeem_windows"
+)
+
+
+func genMinDelegate(r *rand.Rand, initialStake int64) sdk.Int {
+	amount := initialStake / int64(simtypes.RandIntBetween(r, 10, 100))
+	if amount <= 0 {
+		amount = 1
+	}
+	return sdk.NewInt(amount)
+}
+
+
+func genValidity(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 200))
+}
+
+
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		feeHeight     int64
+		fee           sdk.Dec
+		minDelegation sdk.Int
+		numValidity   int64
+		cycle         int64
+		halve         int64
+		bonusAmount   sdk.Int
+		decay         sdk.Dec
+		userWeight    sdk.Dec
+		prefixes      []string
+		period        int64
+		minBid        sdk.Int
+		window        int64
+		missLimit     int64
+		redeemWindows int64
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, redeemFeeHeight, &feeHeight, simState.Rand,
+		func(r *rand.Rand) { feeHeight = GenRedeemFeeHeight(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, redeemFee, &fee, simState.Rand,
+		func(r *rand.Rand) { fee = GenRedeemFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minDelegate, &minDelegation, simState.Rand,
+		func(r *rand.Rand) { minDelegation = genMinDelegate(r, simState.InitialStake) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validity, &numValidity, simState.Rand,
+		func(r *rand.Rand) { numValidity = genValidity(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bonusCycle, &cycle, simState.Rand,
+		func(r *rand.Rand) { cycle = GenBonusCycle(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bonusHalve, &halve, simState.Rand,
+		func(r *rand.Rand) { halve = GenBonusHalve(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bonus, &bonusAmount, simState.Rand,
+		func(r *rand.Rand) { bonusAmount = GenBonus(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bonusDecay, &decay, simState.Rand,
+		func(r *rand.Rand) { decay = GenBonusDecay(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bonusUserWeight, &userWeight, simState.Rand,
+		func(r *rand.Rand) { userWeight = GenBonusUserWeight(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, premiumPrefixes, &prefixes, simState.Rand,
+		func(r *rand.Rand) { prefixes = GenPremiumPrefixes(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, auctionPeriod, &period, simState.Rand,
+		func(r *rand.Rand) { period = GenAuctionPeriod(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, auctionMinBid, &minBid, simState.Rand,
+		func(r *rand.Rand) { minBid = GenAuctionMinBid(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, heartbeatWindow, &window, simState.Rand,
+		func(r *rand.Rand) { window = GenHeartbeatWindow(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, heartbeatMissLimit, &missLimit, simState.Rand,
+		func(r *rand.Rand) { missLimit = GenHeartbeatMissLimit(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, heartbeatRedeemWindows, &redeemWindows, simState.Rand,
+		func(r *rand.Rand) { redeemWindows = GenHeartbeatRedeemWindows(r) },
+	)
+
+	params := types.NewParams(
+		types.DefaultIndexNumHeight,
+		feeHeight,
+		fee,
+		minDelegation,
+		numValidity,
+		cycle,
+		halve,
+		bonusAmount,
+		decay,
+		userWeight,
+		prefixes,
+		period,
+		minBid,
+		window,
+		missLimit,
+		redeemWindows,
+	)
+	commGenesis := types.NewGenesisState(params)
+
+	bz, err := json.MarshalIndent(&commGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated comm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&commGenesis)
+}
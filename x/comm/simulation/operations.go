@@ -0,0 +1,627 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"freemasonry.cc/blockchain/x/comm/keeper"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+
+const (
+	OpWeightMsgGatewayRegister      = "op_weight_msg_gateway_register"
+	OpWeightMsgGatewayDelegate      = "op_weight_msg_gateway_delegate"
+	OpWeightMsgGatewayUndelegate    = "op_weight_msg_gateway_undelegate"
+	OpWeightMsgWithdrawGatewayBonus = "op_weight_msg_withdraw_gateway_bonus"
+	OpWeightMsgBid                  = "op_weight_msg_bid"
+	OpWeightMsgGatewayHeartbeat     = "op_weight_msg_gateway_heartbeat"
+	OpWeightMsgGatewayEditEndpoints = "op_weight_msg_gateway_edit_endpoints"
+
+	DefaultWeightMsgGatewayRegister      = 40
+	DefaultWeightMsgGatewayDelegate      = 60
+	DefaultWeightMsgGatewayUndelegate    = 30
+	DefaultWeightMsgWithdrawGatewayBonus = 20
+	DefaultWeightMsgBid                  = 30
+	DefaultWeightMsgGatewayHeartbeat     = 50
+	DefaultWeightMsgGatewayEditEndpoints = 20
+)
+
+
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper, sk stakingkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgGatewayRegister      int
+		weightMsgGatewayDelegate      int
+		weightMsgGatewayUndelegate    int
+		weightMsgWithdrawGatewayBonus int
+		weightMsgBid                  int
+		weightMsgGatewayHeartbeat     int
+		weightMsgGatewayEditEndpoints int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGatewayRegister, &weightMsgGatewayRegister, nil,
+		func(_ *rand.Rand) {
+			weightMsgGatewayRegister = DefaultWeightMsgGatewayRegister
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGatewayDelegate, &weightMsgGatewayDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgGatewayDelegate = DefaultWeightMsgGatewayDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGatewayUndelegate, &weightMsgGatewayUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgGatewayUndelegate = DefaultWeightMsgGatewayUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawGatewayBonus, &weightMsgWithdrawGatewayBonus, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawGatewayBonus = DefaultWeightMsgWithdrawGatewayBonus
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBid, &weightMsgBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgBid = DefaultWeightMsgBid
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGatewayHeartbeat, &weightMsgGatewayHeartbeat, nil,
+		func(_ *rand.Rand) {
+			weightMsgGatewayHeartbeat = DefaultWeightMsgGatewayHeartbeat
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGatewayEditEndpoints, &weightMsgGatewayEditEndpoints, nil,
+		func(_ *rand.Rand) {
+			weightMsgGatewayEditEndpoints = DefaultWeightMsgGatewayEditEndpoints
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGatewayRegister,
+			SimulateMsgGatewayRegister(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGatewayDelegate,
+			SimulateMsgGatewayDelegate(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGatewayUndelegate,
+			SimulateMsgGatewayUndelegate(ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawGatewayBonus,
+			SimulateMsgWithdrawGatewayBonus(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBid,
+			SimulateMsgBid(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGatewayHeartbeat,
+			SimulateMsgGatewayHeartbeat(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGatewayEditEndpoints,
+			SimulateMsgGatewayEditEndpoints(ak, bk, k),
+		),
+	}
+}
+
+
+func SimulateMsgGatewayRegister(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		valAddress := sdk.ValAddress(simAccount.Address)
+		params := k.GetParams(ctx)
+		denom := sk.BondDenom(ctx)
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		balance := spendable.AmountOf(denom)
+		if balance.LT(params.MinDelegate) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "balance is lower than min delegate"), nil, nil
+		}
+
+		amount := params.MinDelegate.Add(simtypes.RandomAmount(r, balance.Sub(params.MinDelegate)))
+		shares := amount.ToDec()
+		holdNum := int64(0)
+
+		validator, found := sk.GetValidator(ctx, valAddress)
+		if found {
+			if validator.InvalidExRate() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "validator has invalid exchange rate"), nil, nil
+			}
+			newShares, err := sharesFromTokens(validator, amount)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "unable to calculate delegation shares"), nil, nil
+			}
+			shares = newShares
+			delegation, found := sk.GetDelegation(ctx, simAccount.Address, valAddress)
+			if found {
+				shares = shares.Add(delegation.Shares)
+			}
+			if shares.LT(params.MinDelegate.ToDec()) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "self delegation is lower than min delegate"), nil, nil
+			}
+			gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+			if err == nil {
+				holdNum = int64(len(gateway.GatewayNum))
+			}
+		} else if _, found := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(simAccount.ConsKey.PubKey())); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "consensus key already in use"), nil, nil
+		}
+
+		quota := shares.TruncateInt().Quo(params.MinDelegate).Int64() - holdNum
+		indexNumber, err := randomIndexNumbers(r, ctx, k, randomNumberCount(r, quota))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayRegister, "unable to pick index numbers"), nil, err
+		}
+
+		maxCommission := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 5, 100)), 2)
+		minCommission := sdk.NewDecWithPrec(5, 2)
+		commission := stakingtypes.NewCommissionRates(
+			minCommission.Add(simtypes.RandomDecAmount(r, maxCommission.Sub(minCommission))),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		msg := types.NewMsgGatewayRegister(
+			simAccount.Address.String(),
+			simtypes.RandStringOfLength(r, 10),
+			fmt.Sprintf("https://%s.com", simtypes.RandStringOfLength(r, 10)),
+			amount.String(),
+			base64.StdEncoding.EncodeToString(simAccount.ConsKey.PubKey().Bytes()),
+			indexNumber,
+			commission,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin(denom, amount)),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func SimulateMsgGatewayDelegate(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(sk.GetAllValidators(ctx)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "number of validators equal zero"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "unable to pick a validator"), nil, nil
+		}
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "validator has invalid exchange rate"), nil, nil
+		}
+
+		denom := sk.BondDenom(ctx)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		balance := spendable.AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "balance is not positive"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "unable to generate positive amount"), nil, err
+		}
+
+		var indexNumber []string
+		if validator.GetOperator().Equals(sdk.ValAddress(simAccount.Address)) {
+			gateway, err := k.GetGatewayInfo(ctx, validator.GetOperator().String())
+			if err == nil {
+				shares, err := sharesFromTokens(validator, amount)
+				if err != nil {
+					return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "unable to calculate delegation shares"), nil, nil
+				}
+				delegation, found := sk.GetDelegation(ctx, simAccount.Address, validator.GetOperator())
+				if found {
+					shares = shares.Add(delegation.Shares)
+				}
+				quota := shares.QuoInt(k.GetParams(ctx).MinDelegate).Sub(sdk.NewDec(int64(len(gateway.GatewayNum)))).TruncateInt64()
+				indexNumber, err = randomIndexNumbers(r, ctx, k, randomNumberCount(r, quota))
+				if err != nil {
+					return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayDelegation, "unable to pick index numbers"), nil, err
+				}
+			}
+		}
+
+		msg := types.NewMsgGatewayDelegation(simAccount.Address.String(), validator.GetOperator().String(), sdk.NewCoin(denom, amount), indexNumber)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func SimulateMsgGatewayUndelegate(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, sk stakingkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "validator is not ok"), nil, nil
+		}
+		valAddress := validator.GetOperator()
+
+		delegations := sk.GetValidatorDelegations(ctx, valAddress)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "keeper does have any delegation entries"), nil, nil
+		}
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddress := delegation.GetDelegatorAddr()
+
+		simAccount, found := simtypes.FindAccount(accs, delAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "account private key is nil"), nil, nil
+		}
+
+		if sk.HasMaxUnbondingDelegationEntries(ctx, delAddress, valAddress) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "total bond is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "invalid unbond amount"), nil, err
+		}
+
+		shares, err := sk.ValidateUnbondAmount(ctx, delAddress, valAddress, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "invalid unbond amount"), nil, nil
+		}
+
+		var indexNumber []string
+		if valAddress.Equals(sdk.ValAddress(delAddress)) {
+			if _, err := k.GetGatewayDelegateLastTime(ctx, delAddress.String(), valAddress.String()); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayUndelegation, "gateway delegate height not found"), nil, nil
+			}
+
+			gateway, err := k.GetGatewayInfo(ctx, valAddress.String())
+			if err == nil {
+				holdNum := len(gateway.GatewayNum)
+				quota := int(delegation.Shares.Sub(shares).QuoInt(k.GetParams(ctx).MinDelegate).TruncateInt64())
+				release := 0
+				if holdNum > quota {
+					release = holdNum - quota
+				}
+				release = simtypes.RandIntBetween(r, release, holdNum+1)
+				for _, i := range r.Perm(holdNum)[:release] {
+					indexNumber = append(indexNumber, gateway.GatewayNum[i].NumberIndex)
+				}
+			}
+		}
+
+		msg := types.NewMsgGatewayUndelegation(delAddress.String(), valAddress.String(), sdk.NewCoin(sk.BondDenom(ctx), amount), indexNumber)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func SimulateMsgWithdrawGatewayBonus(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		gatewayBonuses := k.GetAllGatewayBonus(ctx)
+		if len(gatewayBonuses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawGatewayBonus, "no gateway bonus"), nil, nil
+		}
+		gatewayBonus := gatewayBonuses[r.Intn(len(gatewayBonuses))]
+
+		valAddress, err := sdk.ValAddressFromBech32(gatewayBonus.GatewayAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawGatewayBonus, "invalid gateway address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddress))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawGatewayBonus, "account private key is nil"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawGatewayBonus(simAccount.Address.String())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func SimulateMsgBid(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if len(params.PremiumPrefixes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "no premium prefixes"), nil, nil
+		}
+		numberIndex := params.PremiumPrefixes[r.Intn(len(params.PremiumPrefixes))]
+		_, isRegister, err := k.GetGatewayNum(ctx, numberIndex)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "unable to get number index"), nil, err
+		}
+		if !isRegister {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "premium prefix already issued"), nil, nil
+		}
+
+		gateways, err := k.GetGatewayList(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "unable to get gateways"), nil, err
+		}
+		if len(gateways) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "no gateways"), nil, nil
+		}
+		gateway := gateways[r.Intn(len(gateways))]
+		valAddress, err := sdk.ValAddressFromBech32(gateway.GatewayAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "invalid gateway address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddress))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "account private key is nil"), nil, nil
+		}
+
+		minBid := params.AuctionMinBid
+		if auction, found := k.GetPrefixAuction(ctx, numberIndex); found {
+			minBid = sdk.MaxInt(minBid, auction.Amount.Amount.AddRaw(1))
+		}
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(sdk.DefaultBondDenom)
+		if balance.LT(minBid) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "balance is lower than the minimum bid"), nil, nil
+		}
+		amount := minBid.Add(simtypes.RandomAmount(r, sdk.MinInt(balance.Sub(minBid), minBid)))
+
+		msg := types.NewMsgBid(simAccount.Address.String(), numberIndex, sdk.NewCoin(sdk.DefaultBondDenom, amount))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func sharesFromTokens(validator stakingtypes.Validator, amount sdk.Int) (sdk.Dec, error) {
+	if validator.DelegatorShares.IsZero() {
+		return amount.ToDec(), nil
+	}
+	return validator.SharesFromTokens(amount)
+}
+
+
+func randomNumberCount(r *rand.Rand, quota int64) int {
+	if quota <= 0 {
+		return 0
+	}
+	if quota > 3 {
+		quota = 3
+	}
+	return simtypes.RandIntBetween(r, 0, int(quota)+1)
+}
+
+
+func randomIndexNumbers(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, count int) ([]string, error) {
+	params := k.GetParams(ctx)
+	indexNumber := make([]string, 0, count)
+	picked := make(map[string]bool, count)
+	for len(indexNumber) < count {
+		number := fmt.Sprintf("%d", simtypes.RandIntBetween(r, 100000, 1000000))
+		if picked[number] || params.IsPremiumPrefix(number) {
+			continue
+		}
+		_, isRegister, err := k.GetGatewayNum(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+		if !isRegister {
+			continue
+		}
+		picked[number] = true
+		indexNumber = append(indexNumber, number)
+	}
+	return indexNumber, nil
+}
+
+
+func SimulateMsgGatewayHeartbeat(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		gateways, err := k.GetGatewayList(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayHeartbeat, "unable to get gateways"), nil, err
+		}
+		if len(gateways) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayHeartbeat, "no gateways"), nil, nil
+		}
+		gateway := gateways[r.Intn(len(gateways))]
+
+		window := k.GetParams(ctx).HeartbeatWindow
+		liveness, found := k.GetGatewayLiveness(ctx, gateway.GatewayAddress)
+		if found && liveness.LastHeartbeat/window == ctx.BlockHeight()/window {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayHeartbeat, "heartbeat already sent in this window"), nil, nil
+		}
+
+		valAddress, err := sdk.ValAddressFromBech32(gateway.GatewayAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayHeartbeat, "invalid gateway address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddress))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayHeartbeat, "account private key is nil"), nil, nil
+		}
+
+		msg := types.NewMsgGatewayHeartbeat(simAccount.Address.String())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+
+func SimulateMsgGatewayEditEndpoints(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		gateways, err := k.GetGatewayList(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayEditEndpoints, "unable to get gateways"), nil, err
+		}
+		if len(gateways) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayEditEndpoints, "no gateways"), nil, nil
+		}
+		gateway := gateways[r.Intn(len(gateways))]
+
+		valAddress, err := sdk.ValAddressFromBech32(gateway.GatewayAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayEditEndpoints, "invalid gateway address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddress))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGatewayEditEndpoints, "account private key is nil"), nil, nil
+		}
+
+		host := strings.ToLower(simtypes.RandStringOfLength(r, 10)) + ".example.com"
+		endpoints := []types.GatewayEndpoint{
+			{Transport: types.EndpointTransportWebsocket, Address: "wss://" + host + "/ws"},
+			{Transport: types.EndpointTransportHTTPS, Address: "https://" + host},
+			{Transport: types.EndpointTransportP2P, Address: "/dns4/" + host + "/tcp/26656/p2p/" + simtypes.RandStringOfLength(r, 20)},
+		}
+		endpoints = endpoints[:r.Intn(len(endpoints)+1)]
+		fingerprint := hex.EncodeToString(tmhash.Sum([]byte(host)))
+		transportPubKey := base64.StdEncoding.EncodeToString(simAccount.PubKey.Bytes())
+
+		msg := types.NewMsgGatewayEditEndpoints(simAccount.Address.String(), endpoints, fingerprint, transportPubKey)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,160 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"freemasonry.cc/blockchain/x/comm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRedeemFeeHeight),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenRedeemFeeHeight(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRedeemFee),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRedeemFee(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidity),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenValidity(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBonusCycle),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBonusCycle(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBonusHalve),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBonusHalve(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBonus),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBonus(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBonusDecay),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBonusDecay(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBonusUserWeight),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBonusUserWeight(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPremiumPrefixes),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("[\"%s\"]", strings.Join(GenPremiumPrefixes(r), "\",\""))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAuctionPeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAuctionPeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAuctionMinBid),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenAuctionMinBid(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHeartbeatWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHeartbeatWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHeartbeatMissLimit),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHeartbeatMissLimit(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHeartbeatRedeemWindows),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHeartbeatRedeemWindows(r))
+			},
+		),
+	}
+}
+
+
+func GenRedeemFeeHeight(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 500000))
+}
+
+
+func GenRedeemFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2)
+}
+
+
+func GenValidity(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 6000000))
+}
+
+
+func GenBonusCycle(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 20000))
+}
+
+
+func GenBonusHalve(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 20000000))
+}
+
+
+func GenBonus(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 20000))).Mul(types.DefaultBonus.QuoRaw(10000))
+}
+
+
+func GenBonusDecay(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2)
+}
+
+
+func GenBonusUserWeight(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2)
+}
+
+
+func GenPremiumPrefixes(r *rand.Rand) []string {
+	prefixes := []string{"888888", "666666", "999999", "123456", "520520"}
+	r.Shuffle(len(prefixes), func(i, j int) { prefixes[i], prefixes[j] = prefixes[j], prefixes[i] })
+	return prefixes[:simtypes.RandIntBetween(r, 1, len(prefixes)+1)]
+}
+
+
+func GenAuctionPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+
+func GenAuctionMinBid(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000)))
+}
+
+
+func GenHeartbeatWindow(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+
+func GenHeartbeatMissLimit(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 5))
+}
+
+
+func GenHeartbeatRedeemWindows(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 10))
+}
@@ -0,0 +1,37 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"freemasonry.cc/blockchain/x/comm/simulation"
+	"freemasonry.cc/blockchain/x/comm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamChanges(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		for _, paramChange := range simulation.ParamChanges(r) {
+			require.Equal(t, types.ModuleName, paramChange.Subspace())
+			value := paramChange.SimValue()(r)
+			require.NoError(t, paramstore.Update(ctx, []byte(paramChange.Key()), []byte(value)), "%s: %s", paramChange.Key(), value)
+		}
+
+		paramstore.GetParamSet(ctx, &params)
+		require.NoError(t, params.Validate())
+	}
+}
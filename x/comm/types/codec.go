@@ -7,13 +7,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgGatewayRegister{}, MSG_GATEWAY_REGISTER, nil)
 	cdc.RegisterConcrete(&MsgGatewayDelegate{}, MSG_GATEWAY_DELEGATION, nil)
 	cdc.RegisterConcrete(&MsgGatewayUndelegate{}, MSG_GATEWAY_UNDELEGATION, nil)
+	cdc.RegisterConcrete(&MsgWithdrawGatewayBonus{}, MSG_WITHDRAW_GATEWAY_BONUS, nil)
+	cdc.RegisterConcrete(&MsgBid{}, MSG_BID, nil)
+	cdc.RegisterConcrete(&MsgGatewayHeartbeat{}, MSG_GATEWAY_HEARTBEAT, nil)
+	cdc.RegisterConcrete(&MsgGatewayEditEndpoints{}, MSG_GATEWAY_EDIT_ENDPOINTS, nil)
 }
 
 
@@ -23,6 +30,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgGatewayRegister{},
 		&MsgGatewayDelegate{},
 		&MsgGatewayUndelegate{},
+		&MsgWithdrawGatewayBonus{},
+		&MsgBid{},
+		&MsgGatewayHeartbeat{},
+		&MsgGatewayEditEndpoints{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	EndpointTransportWebsocket = "ws"
+	EndpointTransportHTTPS     = "https"
+	EndpointTransportP2P       = "p2p"
+
+	MaxGatewayEndpoints      = 8
+	MaxEndpointAddressLength = 256
+	TLSFingerprintLength     = 32
+)
+
+
+var endpointSchemes = map[string][]string{
+	EndpointTransportWebsocket: {"ws", "wss"},
+	EndpointTransportHTTPS:     {"https"},
+}
+
+
+func (e GatewayEndpoint) Validate() error {
+	if len(e.Address) == 0 || len(e.Address) > MaxEndpointAddressLength {
+		return sdkerrors.Wrapf(ErrInvalidEndpoint, "address length must be between 1 and %d", MaxEndpointAddressLength)
+	}
+	if strings.TrimSpace(e.Address) != e.Address {
+		return sdkerrors.Wrapf(ErrInvalidEndpoint, "address %q contains surrounding whitespace", e.Address)
+	}
+
+	if e.Transport == EndpointTransportP2P {
+		if !strings.HasPrefix(e.Address, "/") || !strings.Contains(e.Address, "/p2p/") {
+			return sdkerrors.Wrapf(ErrInvalidEndpoint, "p2p address %s must be a multiaddr containing /p2p/", e.Address)
+		}
+		return nil
+	}
+
+	schemes, ok := endpointSchemes[e.Transport]
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidEndpoint, "unknown transport %s", e.Transport)
+	}
+	u, err := url.Parse(e.Address)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidEndpoint, err.Error())
+	}
+	if u.Host == "" {
+		return sdkerrors.Wrapf(ErrInvalidEndpoint, "address %s has no host", e.Address)
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalidEndpoint, "%s transport does not accept scheme %s", e.Transport, u.Scheme)
+}
+
+
+func ValidateGatewayEndpoints(endpoints []GatewayEndpoint, tlsFingerprint, transportPubKey string) error {
+	if len(endpoints) > MaxGatewayEndpoints {
+		return sdkerrors.Wrapf(ErrInvalidEndpoint, "at most %d endpoints are allowed", MaxGatewayEndpoints)
+	}
+	addresses := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if err := endpoint.Validate(); err != nil {
+			return err
+		}
+		if addresses[endpoint.Address] {
+			return sdkerrors.Wrapf(ErrInvalidEndpoint, "duplicate address %s", endpoint.Address)
+		}
+		addresses[endpoint.Address] = true
+	}
+
+	if tlsFingerprint != "" {
+		bz, err := hex.DecodeString(tlsFingerprint)
+		if err != nil || len(bz) != TLSFingerprintLength || strings.ToLower(tlsFingerprint) != tlsFingerprint {
+			return sdkerrors.Wrapf(ErrInvalidEndpoint, "tls fingerprint must be a lowercase hex SHA-256 digest: %s", tlsFingerprint)
+		}
+	}
+
+	if transportPubKey != "" {
+		bz, err := base64.StdEncoding.DecodeString(transportPubKey)
+		if err != nil || (len(bz) != 32 && len(bz) != 33) {
+			return sdkerrors.Wrapf(ErrInvalidEndpoint, "transport public key must be a base64 encoded 32 or 33 byte key: %s", transportPubKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGatewayEndpoints(t *testing.T) {
+	fingerprint := strings.Repeat("ab", TLSFingerprintLength)
+	pubKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	endpoint := func(transport, address string) GatewayEndpoint {
+		return GatewayEndpoint{Transport: transport, Address: address}
+	}
+
+	testCases := []struct {
+		name            string
+		endpoints       []GatewayEndpoint
+		tlsFingerprint  string
+		transportPubKey string
+		expPass         bool
+	}{
+		{"empty", nil, "", "", true},
+		{"all transports", []GatewayEndpoint{
+			endpoint(EndpointTransportWebsocket, "wss://gw.example.com/ws"),
+			endpoint(EndpointTransportWebsocket, "ws://10.0.0.1:8080"),
+			endpoint(EndpointTransportHTTPS, "https://gw.example.com"),
+			endpoint(EndpointTransportP2P, "/dns4/gw.example.com/tcp/26656/p2p/12D3KooW"),
+		}, fingerprint, pubKey, true},
+		{"unknown transport", []GatewayEndpoint{endpoint("grpc", "https://gw.example.com")}, "", "", false},
+		{"wrong scheme", []GatewayEndpoint{endpoint(EndpointTransportHTTPS, "http://gw.example.com")}, "", "", false},
+		{"websocket with https scheme", []GatewayEndpoint{endpoint(EndpointTransportWebsocket, "https://gw.example.com")}, "", "", false},
+		{"missing host", []GatewayEndpoint{endpoint(EndpointTransportHTTPS, "https:///path")}, "", "", false},
+		{"p2p without peer id", []GatewayEndpoint{endpoint(EndpointTransportP2P, "/ip4/1.2.3.4/tcp/26656")}, "", "", false},
+		{"address too long", []GatewayEndpoint{endpoint(EndpointTransportHTTPS, "https://gw.example.com/"+strings.Repeat("a", MaxEndpointAddressLength))}, "", "", false},
+		{"padded address", []GatewayEndpoint{endpoint(EndpointTransportHTTPS, " https://gw.example.com")}, "", "", false},
+		{"duplicate address", []GatewayEndpoint{
+			endpoint(EndpointTransportHTTPS, "https://gw.example.com"),
+			endpoint(EndpointTransportHTTPS, "https://gw.example.com"),
+		}, "", "", false},
+		{"too many endpoints", func() []GatewayEndpoint {
+			endpoints := make([]GatewayEndpoint, 0, MaxGatewayEndpoints+1)
+			for i := 0; i <= MaxGatewayEndpoints; i++ {
+				endpoints = append(endpoints, endpoint(EndpointTransportHTTPS, "https://gw"+strings.Repeat("a", i)+".example.com"))
+			}
+			return endpoints
+		}(), "", "", false},
+		{"short fingerprint", nil, "abcd", "", false},
+		{"uppercase fingerprint", nil, strings.ToUpper(fingerprint), "", false},
+		{"invalid pub key", nil, "", "not-base64", false},
+		{"short pub key", nil, "", base64.StdEncoding.EncodeToString(make([]byte, 16)), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGatewayEndpoints(tc.endpoints, tc.tlsFingerprint, tc.transportPubKey)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidEndpoint)
+			}
+		})
+	}
+}
@@ -13,4 +13,11 @@ var (
 	ErrGatewayNotExist    = sdkerrors.Register(ModuleName, 206, "gateway not exist")
 	ErrGatewayNumNotFound = sdkerrors.Register(ModuleName, 207, "gateway number not found")
 	ErrGatewayNumLength   = sdkerrors.Register(ModuleName, 208, "Illegal length of number segment")
+	ErrDelegateLastTime   = sdkerrors.Register(ModuleName, 209, "delegate last time not found")
+	ErrNoGatewayBonus     = sdkerrors.Register(ModuleName, 210, "no gateway bonus to withdraw")
+	ErrNotPremiumPrefix   = sdkerrors.Register(ModuleName, 211, "number index is not auctioned")
+	ErrPremiumPrefix      = sdkerrors.Register(ModuleName, 212, "premium number index must be won at auction")
+	ErrBidTooLow          = sdkerrors.Register(ModuleName, 213, "bid is lower than the current highest bid")
+	ErrHeartbeatTooSoon   = sdkerrors.Register(ModuleName, 214, "gateway heartbeat already sent in this window")
+	ErrInvalidEndpoint    = sdkerrors.Register(ModuleName, 215, "invalid gateway endpoint")
 )
@@ -17,11 +17,32 @@ const (
 	EventTypeRegisterCoin          = "register_coin"
 	EventTypeRegisterERC20         = "register_erc20"
 	EventTypeToggleTokenConversion = "toggle_token_conversion" 
+	EventTypeGatewayQuotaSync      = "gateway_quota_sync"
+	EventTypeGatewayNumRedeem      = "gateway_num_redeem"
+	EventTypeGatewayBonus          = "gateway_bonus"
+	EventTypeWithdrawGatewayBonus  = "withdraw_gateway_bonus"
+	EventTypePrefixBid             = "prefix_bid"
+	EventTypePrefixAuctionSettle   = "prefix_auction_settle"
+	EventTypeGatewayHeartbeat      = "gateway_heartbeat"
+	EventTypeGatewayInactive       = "gateway_inactive"
+	EventTypeGatewayReactivate     = "gateway_reactivate"
+	EventTypeGatewayEditEndpoints  = "gateway_edit_endpoints"
 
 	AttributeKeyCosmosCoin   = "cosmos_coin"
 	AttributeKeyERC20Token   = "erc20_token" 
 	AttributeKeyReceiver     = "receiver"
 	AttributeKeyReturnAmount = "return_amount"
+	AttributeKeyGateway      = "gateway"
+	AttributeKeyGatewayQuota = "gateway_quota"
+	AttributeKeyNumberIndex  = "number_index"
+	AttributeKeyValidity     = "validity"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyBidder       = "bidder"
+	AttributeKeyEndHeight    = "end_height"
+	AttributeKeyAwarded      = "awarded"
+	AttributeKeyHeight       = "height"
+	AttributeKeyMissed       = "missed_windows"
+	AttributeKeyEndpoints    = "endpoints"
 
 	ERC20EventTransfer = "Transfer"
 )
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -42,10 +43,26 @@ type Params struct {
 
 	BonusHalve int64 `protobuf:"varint,7,opt,name=bonus_halve,json=bonusHalve,proto3" json:"bonus_halve,omitempty"`
 
-	Bonus                github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=bonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonus"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
+	Bonus github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=bonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonus"`
+
+	BonusDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=bonus_decay,json=bonusDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_decay"`
+
+	BonusUserWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=bonus_user_weight,json=bonusUserWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_user_weight"`
+
+	PremiumPrefixes []string `protobuf:"bytes,11,rep,name=premium_prefixes,json=premiumPrefixes,proto3" json:"premium_prefixes,omitempty"`
+
+	AuctionPeriod int64 `protobuf:"varint,12,opt,name=auction_period,json=auctionPeriod,proto3" json:"auction_period,omitempty"`
+
+	AuctionMinBid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=auction_min_bid,json=auctionMinBid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"auction_min_bid"`
+
+	HeartbeatWindow int64 `protobuf:"varint,14,opt,name=heartbeat_window,json=heartbeatWindow,proto3" json:"heartbeat_window,omitempty"`
+
+	HeartbeatMissLimit int64 `protobuf:"varint,15,opt,name=heartbeat_miss_limit,json=heartbeatMissLimit,proto3" json:"heartbeat_miss_limit,omitempty"`
+
+	HeartbeatRedeemWindows int64    `protobuf:"varint,16,opt,name=heartbeat_redeem_windows,json=heartbeatRedeemWindows,proto3" json:"heartbeat_redeem_windows,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -116,228 +133,3222 @@ func (m *Params) GetBonusHalve() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "freemasonry.comm.v1.Params")
+func (m *Params) GetPremiumPrefixes() []string {
+	if m != nil {
+		return m.PremiumPrefixes
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gateway.proto", fileDescriptor_f1a937782ebbded5) }
+func (m *Params) GetAuctionPeriod() int64 {
+	if m != nil {
+		return m.AuctionPeriod
+	}
+	return 0
+}
 
-var fileDescriptor_f1a937782ebbded5 = []byte{
+func (m *Params) GetHeartbeatWindow() int64 {
+	if m != nil {
+		return m.HeartbeatWindow
+	}
+	return 0
+}
 
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x4f, 0x4e, 0xc2, 0x40,
-	0x14, 0xc6, 0xad, 0x08, 0xc2, 0xe0, 0x1f, 0xac, 0x2e, 0x1a, 0x36, 0x25, 0x2e, 0x48, 0x63, 0x62,
-	0x1b, 0xe3, 0xce, 0x25, 0x12, 0x83, 0x0b, 0x8d, 0xb2, 0x74, 0xd3, 0x4c, 0xa7, 0x8f, 0x76, 0x42,
-	0x67, 0x86, 0x74, 0xa6, 0x48, 0x6f, 0xe0, 0xd2, 0x23, 0xc0, 0x25, 0x3c, 0x83, 0x67, 0x70, 0xc1,
-	0xda, 0x63, 0x98, 0x4e, 0x11, 0x74, 0xa9, 0xab, 0xf6, 0x7d, 0xdf, 0x6f, 0xbe, 0xbc, 0x97, 0x0f,
-	0xed, 0x47, 0x58, 0xc1, 0x33, 0xce, 0xdd, 0x49, 0x2a, 0x94, 0x30, 0x8f, 0x47, 0x29, 0x00, 0xc3,
-	0x52, 0xf0, 0x34, 0x77, 0x89, 0x60, 0xcc, 0x9d, 0x5e, 0xb4, 0x4f, 0x22, 0x11, 0x09, 0xed, 0x7b,
-	0xc5, 0x5f, 0x89, 0x9e, 0xbe, 0x55, 0x50, 0xed, 0x01, 0xa7, 0x98, 0x49, 0xd3, 0x41, 0x2d, 0xca,
-	0x43, 0x98, 0xf9, 0x3c, 0x63, 0x7e, 0x0c, 0x34, 0x8a, 0x95, 0x65, 0x74, 0x0c, 0xa7, 0x32, 0x3c,
-	0xd0, 0xfa, 0x7d, 0xc6, 0x06, 0x5a, 0x35, 0xcf, 0xd0, 0x51, 0x0a, 0x21, 0x00, 0xf3, 0x47, 0x00,
-	0xdf, 0xe8, 0xb6, 0x46, 0x0f, 0x4b, 0xe3, 0x06, 0x60, 0xc5, 0xde, 0x21, 0xb4, 0x61, 0xad, 0x4a,
-	0xc7, 0x70, 0x1a, 0x3d, 0xf7, 0x7d, 0x69, 0x6f, 0x7d, 0x2c, 0xed, 0x6e, 0x44, 0x55, 0x9c, 0x05,
-	0xc5, 0x8a, 0x1e, 0x11, 0x92, 0x09, 0xb9, 0xfa, 0x9c, 0xcb, 0x70, 0xec, 0xa9, 0x7c, 0x02, 0xd2,
-	0xed, 0x03, 0x19, 0x36, 0xd6, 0xa1, 0xe6, 0x23, 0xda, 0x63, 0x94, 0xfb, 0x21, 0x24, 0x50, 0xdc,
-	0x6c, 0xed, 0xfc, 0x39, 0xf0, 0x96, 0xab, 0x61, 0x93, 0x51, 0xde, 0x5f, 0x45, 0x98, 0x6d, 0x54,
-	0x9f, 0xe2, 0x84, 0x86, 0x54, 0xe5, 0x56, 0x55, 0x1f, 0xb1, 0x9e, 0x4d, 0x1b, 0x35, 0x03, 0xc1,
-	0x33, 0xe9, 0x93, 0x9c, 0x24, 0x60, 0xd5, 0xb4, 0x8d, 0xb4, 0x74, 0x5d, 0x28, 0x1b, 0x20, 0xc6,
-	0xc9, 0x14, 0xac, 0xdd, 0x1f, 0xc0, 0xa0, 0x50, 0xcc, 0x3e, 0xaa, 0xea, 0xc9, 0xaa, 0xff, 0x6b,
-	0xd3, 0xf2, 0xf1, 0x55, 0xeb, 0x73, 0x6e, 0x1b, 0x2f, 0x0b, 0xdb, 0x78, 0x5d, 0xd8, 0xc6, 0x7c,
-	0x61, 0x1b, 0x3d, 0xe7, 0xa9, 0xfb, 0xab, 0x65, 0xe2, 0x05, 0x89, 0x20, 0x63, 0x12, 0x63, 0xca,
-	0xbd, 0x99, 0x57, 0xb4, 0x5e, 0xc6, 0x04, 0x35, 0xdd, 0xf4, 0xe5, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9b, 0x77, 0xcc, 0x04, 0x25, 0x02, 0x00, 0x00,
+func (m *Params) GetHeartbeatMissLimit() int64 {
+	if m != nil {
+		return m.HeartbeatMissLimit
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetHeartbeatRedeemWindows() int64 {
+	if m != nil {
+		return m.HeartbeatRedeemWindows
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+
+type Gateway struct {
+
+	GatewayAddress string `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+
+	GatewayName string `protobuf:"bytes,2,opt,name=gateway_name,json=gatewayName,proto3" json:"gateway_name,omitempty"`
+
+	GatewayUrl string `protobuf:"bytes,3,opt,name=gateway_url,json=gatewayUrl,proto3" json:"gateway_url,omitempty"`
+
+	GatewayQuota int64 `protobuf:"varint,4,opt,name=gateway_quota,json=gatewayQuota,proto3" json:"gateway_quota,omitempty"`
+
+	GatewayNum []GatewayNumIndex `protobuf:"bytes,5,rep,name=gateway_num,json=gatewayNum,proto3" json:"gateway_num"`
+
+	Endpoints []GatewayEndpoint `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints"`
+
+	TlsFingerprint string `protobuf:"bytes,7,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"`
+
+	TransportPubKey      string   `protobuf:"bytes,8,opt,name=transport_pub_key,json=transportPubKey,proto3" json:"transport_pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Gateway) Reset()         { *m = Gateway{} }
+func (m *Gateway) String() string { return proto.CompactTextString(m) }
+func (*Gateway) ProtoMessage()    {}
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{1}
+}
+func (m *Gateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *Gateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gateway.Merge(m, src)
+}
+func (m *Gateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gateway proto.InternalMessageInfo
+
+func (m *Gateway) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
 	}
-	if this.IndexNumHeight != that1.IndexNumHeight {
-		return false
+	return ""
+}
+
+func (m *Gateway) GetGatewayName() string {
+	if m != nil {
+		return m.GatewayName
 	}
-	if this.RedeemFeeHeight != that1.RedeemFeeHeight {
-		return false
+	return ""
+}
+
+func (m *Gateway) GetGatewayUrl() string {
+	if m != nil {
+		return m.GatewayUrl
 	}
-	if !this.RedeemFee.Equal(that1.RedeemFee) {
-		return false
+	return ""
+}
+
+func (m *Gateway) GetGatewayQuota() int64 {
+	if m != nil {
+		return m.GatewayQuota
 	}
-	if !this.MinDelegate.Equal(that1.MinDelegate) {
-		return false
+	return 0
+}
+
+func (m *Gateway) GetGatewayNum() []GatewayNumIndex {
+	if m != nil {
+		return m.GatewayNum
 	}
-	if this.Validity != that1.Validity {
-		return false
+	return nil
+}
+
+func (m *Gateway) GetEndpoints() []GatewayEndpoint {
+	if m != nil {
+		return m.Endpoints
 	}
-	if this.BonusCycle != that1.BonusCycle {
-		return false
+	return nil
+}
+
+func (m *Gateway) GetTlsFingerprint() string {
+	if m != nil {
+		return m.TlsFingerprint
 	}
-	if this.BonusHalve != that1.BonusHalve {
-		return false
+	return ""
+}
+
+func (m *Gateway) GetTransportPubKey() string {
+	if m != nil {
+		return m.TransportPubKey
 	}
-	if !this.Bonus.Equal(that1.Bonus) {
-		return false
+	return ""
+}
+
+
+type GatewayEndpoint struct {
+
+	Transport string `protobuf:"bytes,1,opt,name=transport,proto3" json:"transport,omitempty"`
+
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayEndpoint) Reset()         { *m = GatewayEndpoint{} }
+func (m *GatewayEndpoint) String() string { return proto.CompactTextString(m) }
+func (*GatewayEndpoint) ProtoMessage()    {}
+func (*GatewayEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{2}
+}
+func (m *GatewayEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *GatewayEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayEndpoint.Merge(m, src)
+}
+func (m *GatewayEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayEndpoint proto.InternalMessageInfo
+
+func (m *GatewayEndpoint) GetTransport() string {
+	if m != nil {
+		return m.Transport
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *GatewayEndpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type GatewayNumIndex struct {
+
+	GatewayAddress string `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+
+	NumberIndex string `protobuf:"bytes,2,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+
+	NumberEnd []string `protobuf:"bytes,3,rep,name=number_end,json=numberEnd,proto3" json:"number_end,omitempty"`
+
+	Status int64 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+
+	Validity             int64    `protobuf:"varint,5,opt,name=validity,proto3" json:"validity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size := m.Bonus.Size()
-		i -= size
-		if _, err := m.Bonus.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *GatewayNumIndex) Reset()         { *m = GatewayNumIndex{} }
+func (m *GatewayNumIndex) String() string { return proto.CompactTextString(m) }
+func (*GatewayNumIndex) ProtoMessage()    {}
+func (*GatewayNumIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{3}
+}
+func (m *GatewayNumIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayNumIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayNumIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGateway(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
+}
+func (m *GatewayNumIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayNumIndex.Merge(m, src)
+}
+func (m *GatewayNumIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayNumIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayNumIndex.DiscardUnknown(m)
+}
 
-	if m.BonusHalve != 0 {
-		i = encodeVarintGateway(dAtA, i, uint64(m.BonusHalve))
-		i--
-		dAtA[i] = 0x38
+var xxx_messageInfo_GatewayNumIndex proto.InternalMessageInfo
+
+func (m *GatewayNumIndex) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
 	}
-	if m.BonusCycle != 0 {
-		i = encodeVarintGateway(dAtA, i, uint64(m.BonusCycle))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *GatewayNumIndex) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
 	}
-	if m.Validity != 0 {
-		i = encodeVarintGateway(dAtA, i, uint64(m.Validity))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *GatewayNumIndex) GetNumberEnd() []string {
+	if m != nil {
+		return m.NumberEnd
 	}
-	{
-		size := m.MinDelegate.Size()
-		i -= size
-		if _, err := m.MinDelegate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+func (m *GatewayNumIndex) GetStatus() int64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GatewayNumIndex) GetValidity() int64 {
+	if m != nil {
+		return m.Validity
+	}
+	return 0
+}
+
+
+type GatewayBonus struct {
+
+	GatewayAddress string `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+
+	Amount               types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GatewayBonus) Reset()         { *m = GatewayBonus{} }
+func (m *GatewayBonus) String() string { return proto.CompactTextString(m) }
+func (*GatewayBonus) ProtoMessage()    {}
+func (*GatewayBonus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{4}
+}
+func (m *GatewayBonus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayBonus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayBonus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGateway(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
+}
+func (m *GatewayBonus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayBonus.Merge(m, src)
+}
+func (m *GatewayBonus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayBonus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayBonus.DiscardUnknown(m)
+}
 
-	{
-		size := m.RedeemFee.Size()
-		i -= size
+var xxx_messageInfo_GatewayBonus proto.InternalMessageInfo
+
+func (m *GatewayBonus) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *GatewayBonus) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+
+type GatewayUserCount struct {
+
+	GatewayAddress string `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayUserCount) Reset()         { *m = GatewayUserCount{} }
+func (m *GatewayUserCount) String() string { return proto.CompactTextString(m) }
+func (*GatewayUserCount) ProtoMessage()    {}
+func (*GatewayUserCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{5}
+}
+func (m *GatewayUserCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayUserCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayUserCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayUserCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUserCount.Merge(m, src)
+}
+func (m *GatewayUserCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayUserCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUserCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUserCount proto.InternalMessageInfo
+
+func (m *GatewayUserCount) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *GatewayUserCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+
+type PrefixAuction struct {
+
+	NumberIndex string `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+
+	BidCount             uint64   `protobuf:"varint,6,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrefixAuction) Reset()         { *m = PrefixAuction{} }
+func (m *PrefixAuction) String() string { return proto.CompactTextString(m) }
+func (*PrefixAuction) ProtoMessage()    {}
+func (*PrefixAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{6}
+}
+func (m *PrefixAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefixAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefixAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrefixAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixAuction.Merge(m, src)
+}
+func (m *PrefixAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefixAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefixAuction proto.InternalMessageInfo
+
+func (m *PrefixAuction) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+func (m *PrefixAuction) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *PrefixAuction) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PrefixAuction) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PrefixAuction) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *PrefixAuction) GetBidCount() uint64 {
+	if m != nil {
+		return m.BidCount
+	}
+	return 0
+}
+
+
+type PrefixBid struct {
+
+	NumberIndex string `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrefixBid) Reset()         { *m = PrefixBid{} }
+func (m *PrefixBid) String() string { return proto.CompactTextString(m) }
+func (*PrefixBid) ProtoMessage()    {}
+func (*PrefixBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{7}
+}
+func (m *PrefixBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefixBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefixBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrefixBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixBid.Merge(m, src)
+}
+func (m *PrefixBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefixBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefixBid proto.InternalMessageInfo
+
+func (m *PrefixBid) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+func (m *PrefixBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *PrefixBid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PrefixBid) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+
+type GatewayLiveness struct {
+
+	GatewayAddress string `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+
+	LastHeartbeat int64 `protobuf:"varint,3,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+
+	HeartbeatCount uint64 `protobuf:"varint,4,opt,name=heartbeat_count,json=heartbeatCount,proto3" json:"heartbeat_count,omitempty"`
+
+	Inactive bool `protobuf:"varint,5,opt,name=inactive,proto3" json:"inactive,omitempty"`
+
+	InactiveHeight       int64    `protobuf:"varint,6,opt,name=inactive_height,json=inactiveHeight,proto3" json:"inactive_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayLiveness) Reset()         { *m = GatewayLiveness{} }
+func (m *GatewayLiveness) String() string { return proto.CompactTextString(m) }
+func (*GatewayLiveness) ProtoMessage()    {}
+func (*GatewayLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{8}
+}
+func (m *GatewayLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayLiveness.Merge(m, src)
+}
+func (m *GatewayLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayLiveness proto.InternalMessageInfo
+
+func (m *GatewayLiveness) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *GatewayLiveness) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GatewayLiveness) GetLastHeartbeat() int64 {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return 0
+}
+
+func (m *GatewayLiveness) GetHeartbeatCount() uint64 {
+	if m != nil {
+		return m.HeartbeatCount
+	}
+	return 0
+}
+
+func (m *GatewayLiveness) GetInactive() bool {
+	if m != nil {
+		return m.Inactive
+	}
+	return false
+}
+
+func (m *GatewayLiveness) GetInactiveHeight() int64 {
+	if m != nil {
+		return m.InactiveHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "freemasonry.comm.v1.Params")
+	proto.RegisterType((*Gateway)(nil), "freemasonry.comm.v1.Gateway")
+	proto.RegisterType((*GatewayEndpoint)(nil), "freemasonry.comm.v1.GatewayEndpoint")
+	proto.RegisterType((*GatewayNumIndex)(nil), "freemasonry.comm.v1.GatewayNumIndex")
+	proto.RegisterType((*GatewayBonus)(nil), "freemasonry.comm.v1.GatewayBonus")
+	proto.RegisterType((*GatewayUserCount)(nil), "freemasonry.comm.v1.GatewayUserCount")
+	proto.RegisterType((*PrefixAuction)(nil), "freemasonry.comm.v1.PrefixAuction")
+	proto.RegisterType((*PrefixBid)(nil), "freemasonry.comm.v1.PrefixBid")
+	proto.RegisterType((*GatewayLiveness)(nil), "freemasonry.comm.v1.GatewayLiveness")
+}
+
+func init() { proto.RegisterFile("gateway.proto", fileDescriptor_f1a937782ebbded5) }
+
+var fileDescriptor_f1a937782ebbded5 = []byte{
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x53, 0x1c, 0xc5,
+	0x1b, 0xfe, 0x0d, 0x0b, 0x0b, 0xf3, 0x2e, 0xec, 0x92, 0xfe, 0x51, 0xd4, 0x88, 0xc6, 0xc5, 0x68,
+	0xe2, 0x6a, 0x95, 0xb3, 0x12, 0x0f, 0x5a, 0xb9, 0x05, 0x48, 0x24, 0x95, 0x80, 0x64, 0xaa, 0x22,
+	0x55, 0xb9, 0x4c, 0xf5, 0xcc, 0x34, 0xbb, 0x5d, 0x4c, 0xf7, 0xac, 0xdd, 0x3d, 0x0b, 0x7b, 0xb1,
+	0x3c, 0x7a, 0xf4, 0xe2, 0x3d, 0x54, 0xe9, 0xd7, 0xf0, 0xec, 0x67, 0xf0, 0x90, 0xb3, 0x47, 0x3f,
+	0x82, 0xd5, 0x7f, 0x66, 0x36, 0x2c, 0x94, 0x06, 0x2e, 0x9e, 0xa0, 0x9f, 0xf7, 0xe9, 0xf7, 0xdf,
+	0x33, 0xef, 0xdb, 0x0b, 0x2b, 0x03, 0xac, 0xc8, 0x29, 0x9e, 0x84, 0x23, 0x51, 0xa8, 0x02, 0xfd,
+	0xff, 0x58, 0x10, 0xc2, 0xb0, 0x2c, 0xb8, 0x98, 0x84, 0x69, 0xc1, 0x58, 0x38, 0xde, 0xda, 0x58,
+	0x1b, 0x14, 0x83, 0xc2, 0xd8, 0xfb, 0xfa, 0x3f, 0x4b, 0xdd, 0x78, 0x3f, 0x2d, 0x24, 0x2b, 0x64,
+	0x3f, 0xc1, 0x92, 0xf4, 0xc7, 0x5b, 0x09, 0x51, 0x78, 0xab, 0x9f, 0x16, 0x94, 0x5b, 0xfb, 0x9d,
+	0x5f, 0x16, 0xa1, 0x79, 0x88, 0x05, 0x66, 0x12, 0xf5, 0x60, 0x95, 0xf2, 0x8c, 0x9c, 0xc5, 0xbc,
+	0x64, 0xf1, 0x90, 0xd0, 0xc1, 0x50, 0x05, 0xde, 0xa6, 0xd7, 0x6b, 0x44, 0x6d, 0x83, 0x1f, 0x94,
+	0x6c, 0xcf, 0xa0, 0xe8, 0x53, 0xb8, 0x25, 0x48, 0x46, 0x08, 0x8b, 0x8f, 0x09, 0xa9, 0xa8, 0x73,
+	0x86, 0xda, 0xb1, 0x86, 0xc7, 0x84, 0x38, 0xee, 0x3e, 0xc0, 0x94, 0x1b, 0x34, 0x36, 0xbd, 0x9e,
+	0xbf, 0x1d, 0xfe, 0xfe, 0xba, 0xfb, 0xbf, 0x3f, 0x5e, 0x77, 0xef, 0x0d, 0xa8, 0x1a, 0x96, 0x89,
+	0x2e, 0xa1, 0xef, 0xf2, 0xb4, 0x7f, 0x3e, 0x93, 0xd9, 0x49, 0x5f, 0x4d, 0x46, 0x44, 0x86, 0xbb,
+	0x24, 0x8d, 0xfc, 0xda, 0x29, 0x7a, 0x0e, 0xcb, 0x8c, 0xf2, 0x38, 0x23, 0x39, 0xd1, 0x3d, 0x09,
+	0xe6, 0xaf, 0xed, 0xf0, 0x09, 0x57, 0x51, 0x8b, 0x51, 0xbe, 0xeb, 0x5c, 0xa0, 0x0d, 0x58, 0x1a,
+	0xe3, 0x9c, 0x66, 0x54, 0x4d, 0x82, 0x05, 0x53, 0x44, 0x7d, 0x46, 0x5d, 0x68, 0x25, 0x05, 0x2f,
+	0x65, 0x9c, 0x4e, 0xd2, 0x9c, 0x04, 0x4d, 0x63, 0x06, 0x03, 0xed, 0x68, 0x64, 0x4a, 0x18, 0xe2,
+	0x7c, 0x4c, 0x82, 0xc5, 0x37, 0x08, 0x7b, 0x1a, 0x41, 0xbb, 0xb0, 0x60, 0x4e, 0xc1, 0xd2, 0x8d,
+	0x32, 0xb5, 0x97, 0xd1, 0x37, 0x55, 0x98, 0x8c, 0xa4, 0x78, 0x12, 0xf8, 0x37, 0x6a, 0xa3, 0x4d,
+	0x6b, 0x57, 0x7b, 0x40, 0x2f, 0xe1, 0x96, 0x75, 0x58, 0x4a, 0x22, 0xe2, 0x53, 0x2b, 0x21, 0xdc,
+	0xc8, 0x6d, 0xc7, 0x38, 0x7a, 0x21, 0x89, 0x38, 0xb2, 0x92, 0x7f, 0x02, 0xab, 0x23, 0x41, 0x18,
+	0x2d, 0x59, 0x3c, 0x12, 0xe4, 0x98, 0x9e, 0x11, 0x19, 0xb4, 0x36, 0x1b, 0x3d, 0x3f, 0xea, 0x38,
+	0xfc, 0xd0, 0xc1, 0xe8, 0x2e, 0xb4, 0x71, 0x99, 0x2a, 0x5a, 0xf0, 0x78, 0x44, 0x04, 0x2d, 0xb2,
+	0x60, 0xd9, 0x74, 0x70, 0xc5, 0xa1, 0x87, 0x06, 0x44, 0xdf, 0x42, 0xa7, 0xa2, 0x69, 0xf5, 0x13,
+	0x9a, 0x05, 0x2b, 0x37, 0x6a, 0x67, 0xe5, 0x77, 0x9f, 0xf2, 0x6d, 0x9a, 0xe9, 0x4c, 0x87, 0x04,
+	0x0b, 0x95, 0x10, 0xac, 0xe2, 0x53, 0xca, 0xb3, 0xe2, 0x34, 0x68, 0xdb, 0xef, 0xb8, 0xc6, 0x8f,
+	0x0c, 0x8c, 0x3e, 0x87, 0xb5, 0x29, 0x95, 0x51, 0x29, 0xe3, 0x9c, 0x32, 0xaa, 0x82, 0x8e, 0xa1,
+	0xa3, 0xda, 0xb6, 0x4f, 0xa5, 0x7c, 0xa6, 0x2d, 0xe8, 0x2b, 0x08, 0xa6, 0x37, 0xdc, 0x0c, 0xd8,
+	0x18, 0x32, 0x58, 0x35, 0xb7, 0xd6, 0x6b, 0x7b, 0x64, 0xcc, 0x36, 0x94, 0x7c, 0xb0, 0xfa, 0xe7,
+	0xab, 0xae, 0xf7, 0xe3, 0x79, 0xd7, 0xfb, 0xe9, 0xbc, 0xeb, 0xbd, 0x3a, 0xef, 0x7a, 0x77, 0x7e,
+	0x6e, 0xc0, 0xe2, 0xd7, 0x76, 0x07, 0xa0, 0x8f, 0xa1, 0xe3, 0xd6, 0x41, 0x8c, 0xb3, 0x4c, 0x10,
+	0x29, 0xcd, 0x98, 0xfa, 0x51, 0xdb, 0xc1, 0x0f, 0x2d, 0x8a, 0x3e, 0x80, 0xe5, 0x8a, 0xc8, 0x31,
+	0x23, 0x66, 0x42, 0xfd, 0xa8, 0xe5, 0xb0, 0x03, 0xcc, 0xcc, 0xe7, 0x5b, 0x51, 0x4a, 0x91, 0xdb,
+	0xf1, 0x8c, 0xc0, 0x41, 0x2f, 0x44, 0x8e, 0x3e, 0xac, 0x77, 0x4f, 0xfc, 0x5d, 0x59, 0x28, 0x6c,
+	0x06, 0xae, 0x11, 0x55, 0x8e, 0x9f, 0x6b, 0x0c, 0x3d, 0x9d, 0x7a, 0xe1, 0x25, 0x0b, 0x16, 0x36,
+	0x1b, 0xbd, 0xd6, 0xfd, 0x8f, 0xc2, 0x2b, 0xb6, 0x54, 0xe8, 0x8a, 0x38, 0x28, 0xd9, 0x13, 0xbd,
+	0x53, 0xb6, 0xe7, 0xb5, 0x80, 0x75, 0xc4, 0x83, 0x92, 0xa1, 0x3d, 0xf0, 0x09, 0xcf, 0x46, 0x05,
+	0xe5, 0x4a, 0x06, 0xcd, 0x7f, 0x77, 0xf5, 0xc8, 0x91, 0x9d, 0xab, 0xe9, 0x65, 0xdd, 0x28, 0x95,
+	0xcb, 0xf8, 0x98, 0xf2, 0x01, 0x11, 0x23, 0x41, 0xb9, 0x32, 0xf3, 0xe9, 0x47, 0x6d, 0x95, 0xcb,
+	0xc7, 0x53, 0x54, 0xef, 0x33, 0x25, 0x30, 0x97, 0xa3, 0x42, 0xa8, 0x78, 0x54, 0x26, 0xf1, 0x09,
+	0x99, 0xd8, 0x79, 0x8d, 0x3a, 0xb5, 0xe1, 0xb0, 0x4c, 0x9e, 0x92, 0xc9, 0x83, 0xe5, 0x0b, 0xba,
+	0x1c, 0x41, 0x67, 0x26, 0x0d, 0xf4, 0x1e, 0xf8, 0xf5, 0x1d, 0x27, 0xcc, 0x14, 0x40, 0x01, 0x2c,
+	0x56, 0xa2, 0x59, 0x39, 0xaa, 0xe3, 0x8c, 0xe3, 0xdf, 0x3c, 0xe8, 0xcc, 0xf4, 0xea, 0x5a, 0xc2,
+	0xf3, 0x92, 0x25, 0x44, 0xc4, 0x66, 0x71, 0x57, 0xc2, 0x5b, 0xcc, 0xfa, 0xba, 0x0d, 0xe0, 0x28,
+	0x84, 0x67, 0x41, 0xc3, 0x4c, 0xa7, 0x6f, 0x91, 0x47, 0x3c, 0x43, 0xeb, 0xd0, 0x94, 0x0a, 0xab,
+	0x52, 0x3a, 0xbd, 0xdd, 0xe9, 0x9f, 0x76, 0xe5, 0x4c, 0x01, 0xdf, 0xc3, 0xb2, 0xcb, 0x7f, 0xdb,
+	0x6c, 0xb0, 0xb7, 0x4e, 0xfe, 0x4b, 0x68, 0x62, 0x56, 0x94, 0xdc, 0xbe, 0x28, 0xad, 0xfb, 0xef,
+	0x84, 0x76, 0x92, 0x43, 0xfd, 0x84, 0x85, 0xee, 0x09, 0x0b, 0x77, 0x0a, 0xca, 0x9d, 0xe2, 0x8e,
+	0x3e, 0x13, 0x3f, 0x86, 0x55, 0x17, 0x5f, 0x6f, 0xa6, 0x1d, 0xcd, 0x78, 0xfb, 0x1c, 0xd6, 0x60,
+	0x21, 0xad, 0x53, 0x98, 0x8f, 0x16, 0xd2, 0x2b, 0x02, 0xfc, 0xe5, 0xc1, 0x8a, 0xdd, 0x63, 0x0f,
+	0xed, 0x4e, 0xb9, 0xd4, 0x76, 0xef, 0x72, 0xdb, 0xd7, 0xa1, 0x99, 0xd0, 0x2c, 0x23, 0xc2, 0x69,
+	0xe2, 0x4e, 0x6f, 0x14, 0xdd, 0xb8, 0x56, 0xd1, 0x3a, 0xa6, 0x54, 0x58, 0xa8, 0xea, 0x15, 0xb6,
+	0x72, 0xb5, 0x0c, 0xe6, 0x5e, 0xe0, 0xdb, 0x00, 0x84, 0x67, 0x15, 0xc1, 0xaa, 0xa6, 0xa7, 0xc4,
+	0x99, 0xdf, 0x05, 0x3f, 0xa1, 0x59, 0x6c, 0xeb, 0x6d, 0x9a, 0x7a, 0x97, 0x12, 0x9a, 0xed, 0x5c,
+	0x51, 0xf2, 0xaf, 0x1e, 0xf8, 0xb6, 0x64, 0xbd, 0x3c, 0xff, 0x8b, 0x72, 0xd7, 0xa1, 0x79, 0xa1,
+	0x50, 0x77, 0x9a, 0xc9, 0xf3, 0x87, 0xb9, 0x7a, 0x78, 0x9e, 0xd1, 0x31, 0xe1, 0x5a, 0xd2, 0xeb,
+	0x0c, 0xcf, 0x85, 0x8e, 0xce, 0x5d, 0xee, 0xe8, 0x5d, 0x68, 0xe7, 0x58, 0xaa, 0xb8, 0x5e, 0xdf,
+	0xa6, 0x8c, 0x46, 0xb4, 0xa2, 0xd1, 0xbd, 0x0a, 0xd4, 0x21, 0xa7, 0x0f, 0x80, 0xed, 0xef, 0xbc,
+	0xe9, 0x6f, 0xbb, 0x86, 0xed, 0x77, 0xb9, 0x01, 0x4b, 0x94, 0xe3, 0x54, 0xd1, 0x31, 0x31, 0xfa,
+	0x2c, 0x45, 0xf5, 0x59, 0x3b, 0xa9, 0xfe, 0xaf, 0x32, 0x6a, 0x56, 0x3f, 0xca, 0x2c, 0xbc, 0x77,
+	0x45, 0x0b, 0xb6, 0x7b, 0x2f, 0xef, 0x5d, 0xd8, 0x99, 0x69, 0x3f, 0xc9, 0x8b, 0xf4, 0x24, 0x1d,
+	0x62, 0xca, 0xfb, 0x67, 0x7d, 0xbd, 0x43, 0xed, 0xb3, 0x98, 0x34, 0xcd, 0x0f, 0xc1, 0x2f, 0xfe,
+	0x1e, 0x00, 0x8e, 0x6e, 0x98, 0xfd, 0x64, 0x0a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexNumHeight != that1.IndexNumHeight {
+		return false
+	}
+	if this.RedeemFeeHeight != that1.RedeemFeeHeight {
+		return false
+	}
+	if !this.RedeemFee.Equal(that1.RedeemFee) {
+		return false
+	}
+	if !this.MinDelegate.Equal(that1.MinDelegate) {
+		return false
+	}
+	if this.Validity != that1.Validity {
+		return false
+	}
+	if this.BonusCycle != that1.BonusCycle {
+		return false
+	}
+	if this.BonusHalve != that1.BonusHalve {
+		return false
+	}
+	if !this.Bonus.Equal(that1.Bonus) {
+		return false
+	}
+	if !this.BonusDecay.Equal(that1.BonusDecay) {
+		return false
+	}
+	if !this.BonusUserWeight.Equal(that1.BonusUserWeight) {
+		return false
+	}
+	if len(this.PremiumPrefixes) != len(that1.PremiumPrefixes) {
+		return false
+	}
+	for i := range this.PremiumPrefixes {
+		if this.PremiumPrefixes[i] != that1.PremiumPrefixes[i] {
+			return false
+		}
+	}
+	if this.AuctionPeriod != that1.AuctionPeriod {
+		return false
+	}
+	if !this.AuctionMinBid.Equal(that1.AuctionMinBid) {
+		return false
+	}
+	if this.HeartbeatWindow != that1.HeartbeatWindow {
+		return false
+	}
+	if this.HeartbeatMissLimit != that1.HeartbeatMissLimit {
+		return false
+	}
+	if this.HeartbeatRedeemWindows != that1.HeartbeatRedeemWindows {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeartbeatRedeemWindows != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.HeartbeatRedeemWindows))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HeartbeatMissLimit != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.HeartbeatMissLimit))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.HeartbeatWindow != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.HeartbeatWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.AuctionMinBid.Size()
+		i -= size
+		if _, err := m.AuctionMinBid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AuctionPeriod != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.AuctionPeriod))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PremiumPrefixes) > 0 {
+		for iNdEx := len(m.PremiumPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PremiumPrefixes[iNdEx])
+			copy(dAtA[i:], m.PremiumPrefixes[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.PremiumPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.BonusUserWeight.Size()
+		i -= size
+		if _, err := m.BonusUserWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BonusDecay.Size()
+		i -= size
+		if _, err := m.BonusDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Bonus.Size()
+		i -= size
+		if _, err := m.Bonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BonusHalve != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.BonusHalve))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BonusCycle != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.BonusCycle))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Validity != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.Validity))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinDelegate.Size()
+		i -= size
+		if _, err := m.MinDelegate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RedeemFee.Size()
+		i -= size
 		if _, err := m.RedeemFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintGateway(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-
-	if m.RedeemFeeHeight != 0 {
-		i = encodeVarintGateway(dAtA, i, uint64(m.RedeemFeeHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.IndexNumHeight != 0 {
-		i = encodeVarintGateway(dAtA, i, uint64(m.IndexNumHeight))
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RedeemFeeHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.RedeemFeeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IndexNumHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.IndexNumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TransportPubKey) > 0 {
+		i -= len(m.TransportPubKey)
+		copy(dAtA[i:], m.TransportPubKey)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.TransportPubKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TlsFingerprint) > 0 {
+		i -= len(m.TlsFingerprint)
+		copy(dAtA[i:], m.TlsFingerprint)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.TlsFingerprint)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GatewayNum) > 0 {
+		for iNdEx := len(m.GatewayNum) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayNum[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GatewayQuota != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.GatewayQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.GatewayUrl) > 0 {
+		i -= len(m.GatewayUrl)
+		copy(dAtA[i:], m.GatewayUrl)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GatewayName) > 0 {
+		i -= len(m.GatewayName)
+		copy(dAtA[i:], m.GatewayName)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transport) > 0 {
+		i -= len(m.Transport)
+		copy(dAtA[i:], m.Transport)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.Transport)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayNumIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayNumIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayNumIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Validity != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.Validity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NumberEnd) > 0 {
+		for iNdEx := len(m.NumberEnd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NumberEnd[iNdEx])
+			copy(dAtA[i:], m.NumberEnd[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.NumberEnd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NumberIndex) > 0 {
+		i -= len(m.NumberIndex)
+		copy(dAtA[i:], m.NumberIndex)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.NumberIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayBonus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayBonus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayBonus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayUserCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUserCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUserCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefixAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefixAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BidCount != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.BidCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NumberIndex) > 0 {
+		i -= len(m.NumberIndex)
+		copy(dAtA[i:], m.NumberIndex)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.NumberIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefixBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefixBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NumberIndex) > 0 {
+		i -= len(m.NumberIndex)
+		copy(dAtA[i:], m.NumberIndex)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.NumberIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InactiveHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.InactiveHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HeartbeatCount != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.HeartbeatCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastHeartbeat != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.LastHeartbeat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGateway(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGateway(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGateway(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexNumHeight != 0 {
+		n += 1 + sovGateway(uint64(m.IndexNumHeight))
+	}
+	if m.RedeemFeeHeight != 0 {
+		n += 1 + sovGateway(uint64(m.RedeemFeeHeight))
+	}
+	l = m.RedeemFee.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	l = m.MinDelegate.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if m.Validity != 0 {
+		n += 1 + sovGateway(uint64(m.Validity))
+	}
+	if m.BonusCycle != 0 {
+		n += 1 + sovGateway(uint64(m.BonusCycle))
+	}
+	if m.BonusHalve != 0 {
+		n += 1 + sovGateway(uint64(m.BonusHalve))
+	}
+	l = m.Bonus.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	l = m.BonusDecay.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	l = m.BonusUserWeight.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if len(m.PremiumPrefixes) > 0 {
+		for _, s := range m.PremiumPrefixes {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if m.AuctionPeriod != 0 {
+		n += 1 + sovGateway(uint64(m.AuctionPeriod))
+	}
+	l = m.AuctionMinBid.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if m.HeartbeatWindow != 0 {
+		n += 1 + sovGateway(uint64(m.HeartbeatWindow))
+	}
+	if m.HeartbeatMissLimit != 0 {
+		n += 1 + sovGateway(uint64(m.HeartbeatMissLimit))
+	}
+	if m.HeartbeatRedeemWindows != 0 {
+		n += 2 + sovGateway(uint64(m.HeartbeatRedeemWindows))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Gateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.GatewayName)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.GatewayUrl)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.GatewayQuota != 0 {
+		n += 1 + sovGateway(uint64(m.GatewayQuota))
+	}
+	if len(m.GatewayNum) > 0 {
+		for _, e := range m.GatewayNum {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	l = len(m.TlsFingerprint)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.TransportPubKey)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GatewayEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Transport)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GatewayNumIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.NumberIndex)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.NumberEnd) > 0 {
+		for _, s := range m.NumberEnd {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGateway(uint64(m.Status))
+	}
+	if m.Validity != 0 {
+		n += 1 + sovGateway(uint64(m.Validity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GatewayBonus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GatewayUserCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGateway(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrefixAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NumberIndex)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovGateway(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGateway(uint64(m.EndHeight))
+	}
+	if m.BidCount != 0 {
+		n += 1 + sovGateway(uint64(m.BidCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrefixBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NumberIndex)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGateway(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGateway(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GatewayLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGateway(uint64(m.StartHeight))
+	}
+	if m.LastHeartbeat != 0 {
+		n += 1 + sovGateway(uint64(m.LastHeartbeat))
+	}
+	if m.HeartbeatCount != 0 {
+		n += 1 + sovGateway(uint64(m.HeartbeatCount))
+	}
+	if m.Inactive {
+		n += 2
+	}
+	if m.InactiveHeight != 0 {
+		n += 1 + sovGateway(uint64(m.InactiveHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovGateway(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGateway(x uint64) (n int) {
+	return sovGateway(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexNumHeight", wireType)
+			}
+			m.IndexNumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexNumHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFeeHeight", wireType)
+			}
+			m.RedeemFeeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedeemFeeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
+			}
+			m.Validity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusCycle", wireType)
+			}
+			m.BonusCycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BonusCycle |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusHalve", wireType)
+			}
+			m.BonusHalve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BonusHalve |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusUserWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusUserWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PremiumPrefixes = append(m.PremiumPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionPeriod", wireType)
+			}
+			m.AuctionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMinBid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionMinBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatWindow", wireType)
+			}
+			m.HeartbeatWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatMissLimit", wireType)
+			}
+			m.HeartbeatMissLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatMissLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRedeemWindows", wireType)
+			}
+			m.HeartbeatRedeemWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatRedeemWindows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayQuota", wireType)
+			}
+			m.GatewayQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayNum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayNum = append(m.GatewayNum, GatewayNumIndex{})
+			if err := m.GatewayNum[len(m.GatewayNum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, GatewayEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransportPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransportPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transport = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayNumIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayNumIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayNumIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumberIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumberEnd = append(m.NumberEnd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
+			}
+			m.Validity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayBonus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayBonus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayBonus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayUserCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUserCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUserCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefixAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumberIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCount", wireType)
+			}
+			m.BidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGateway(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGateway(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IndexNumHeight != 0 {
-		n += 1 + sovGateway(uint64(m.IndexNumHeight))
-	}
-	if m.RedeemFeeHeight != 0 {
-		n += 1 + sovGateway(uint64(m.RedeemFeeHeight))
-	}
-	l = m.RedeemFee.Size()
-	if l > 0 {
-		n += 1 + l + sovGateway(uint64(l))
-	}
-	l = m.MinDelegate.Size()
-	if l > 0 {
-		n += 1 + l + sovGateway(uint64(l))
-	}
-	if m.Validity != 0 {
-		n += 1 + sovGateway(uint64(m.Validity))
-	}
-	if m.BonusCycle != 0 {
-		n += 1 + sovGateway(uint64(m.BonusCycle))
-	}
-	if m.BonusHalve != 0 {
-		n += 1 + sovGateway(uint64(m.BonusHalve))
-	}
-	l = m.Bonus.Size()
-	if l > 0 {
-		n += 1 + l + sovGateway(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGateway(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGateway(x uint64) (n int) {
-	return sovGateway(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PrefixBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -360,17 +3371,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrefixBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrefixBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexNumHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberIndex", wireType)
 			}
-			m.IndexNumHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -380,16 +3391,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexNumHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumberIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFeeHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			m.RedeemFeeHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -399,16 +3423,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedeemFeeHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -418,29 +3455,98 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGateway
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedeemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -468,15 +3574,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinDelegate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.Validity = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -486,16 +3590,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Validity |= int64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeat", wireType)
 			}
-			m.BonusCycle = 0
+			m.LastHeartbeat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -505,16 +3609,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BonusCycle |= int64(b&0x7F) << shift
+				m.LastHeartbeat |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusHalve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatCount", wireType)
 			}
-			m.BonusHalve = 0
+			m.HeartbeatCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -524,16 +3628,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BonusHalve |= int64(b&0x7F) << shift
+				m.HeartbeatCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bonus", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGateway
@@ -543,26 +3647,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGateway
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGateway
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Inactive = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveHeight", wireType)
 			}
-			if err := m.Bonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InactiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 
 func NewGenesisState(params Params) GenesisState {
 	return GenesisState{
@@ -18,6 +24,124 @@ func DefaultGenesisState() *GenesisState {
 
 
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	gatewayAddresses := make(map[string]bool)
+	for _, gateway := range gs.Gateways {
+		if _, err := sdk.ValAddressFromBech32(gateway.GatewayAddress); err != nil {
+			return fmt.Errorf("invalid gateway address %s: %w", gateway.GatewayAddress, err)
+		}
+		if gatewayAddresses[gateway.GatewayAddress] {
+			return fmt.Errorf("duplicate gateway %s", gateway.GatewayAddress)
+		}
+		gatewayAddresses[gateway.GatewayAddress] = true
+		if err := ValidateGatewayEndpoints(gateway.Endpoints, gateway.TlsFingerprint, gateway.TransportPubKey); err != nil {
+			return fmt.Errorf("invalid gateway endpoints %s: %w", gateway.GatewayAddress, err)
+		}
+	}
+
+	numberIndexes := make(map[string]bool)
+	mobileIndexes := make(map[string]string)
+	for _, gatewayNum := range gs.GatewayNums {
+		if numberIndexes[gatewayNum.NumberIndex] {
+			return fmt.Errorf("duplicate number index %s", gatewayNum.NumberIndex)
+		}
+		numberIndexes[gatewayNum.NumberIndex] = true
+
+		for _, mobile := range gatewayNum.NumberEnd {
+			if numberIndex, ok := mobileIndexes[mobile]; ok {
+				return fmt.Errorf("duplicate mobile %s in number index %s and %s", mobile, numberIndex, gatewayNum.NumberIndex)
+			}
+			mobileIndexes[mobile] = gatewayNum.NumberIndex
+		}
+	}
+
+	redeemNumberIndexes := make(map[string]bool)
+	for _, gatewayNum := range gs.GatewayRedeemNums {
+		if redeemNumberIndexes[gatewayNum.NumberIndex] {
+			return fmt.Errorf("duplicate redeem number index %s", gatewayNum.NumberIndex)
+		}
+		redeemNumberIndexes[gatewayNum.NumberIndex] = true
+	}
+
+	bonusAddresses := make(map[string]bool)
+	for _, gatewayBonus := range gs.GatewayBonuses {
+		if _, err := sdk.ValAddressFromBech32(gatewayBonus.GatewayAddress); err != nil {
+			return fmt.Errorf("invalid gateway bonus address %s: %w", gatewayBonus.GatewayAddress, err)
+		}
+		if bonusAddresses[gatewayBonus.GatewayAddress] {
+			return fmt.Errorf("duplicate gateway bonus %s", gatewayBonus.GatewayAddress)
+		}
+		bonusAddresses[gatewayBonus.GatewayAddress] = true
+		if err := gatewayBonus.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid gateway bonus amount %s: %w", gatewayBonus.GatewayAddress, err)
+		}
+	}
+
+	auctionBidCounts := make(map[string]uint64)
+	for _, auction := range gs.PrefixAuctions {
+		if _, ok := auctionBidCounts[auction.NumberIndex]; ok {
+			return fmt.Errorf("duplicate prefix auction %s", auction.NumberIndex)
+		}
+		if _, err := sdk.AccAddressFromBech32(auction.Bidder); err != nil {
+			return fmt.Errorf("invalid prefix auction bidder %s: %w", auction.Bidder, err)
+		}
+		if !auction.Amount.IsValid() || !auction.Amount.IsPositive() {
+			return fmt.Errorf("invalid prefix auction amount %s: %s", auction.NumberIndex, auction.Amount)
+		}
+		auctionBidCounts[auction.NumberIndex] = auction.BidCount
+	}
+
+	bidCounts := make(map[string]uint64)
+	for _, bid := range gs.PrefixBids {
+		if _, ok := auctionBidCounts[bid.NumberIndex]; !ok {
+			return fmt.Errorf("prefix bid for number index %s without an auction", bid.NumberIndex)
+		}
+		bidCounts[bid.NumberIndex]++
+	}
+	for numberIndex, bidCount := range auctionBidCounts {
+		if bidCounts[numberIndex] != bidCount {
+			return fmt.Errorf("prefix auction %s has %d bids, expected %d", numberIndex, bidCounts[numberIndex], bidCount)
+		}
+	}
+
+	livenessAddresses := make(map[string]bool)
+	for _, liveness := range gs.GatewayLivenesses {
+		if _, err := sdk.ValAddressFromBech32(liveness.GatewayAddress); err != nil {
+			return fmt.Errorf("invalid gateway liveness address %s: %w", liveness.GatewayAddress, err)
+		}
+		if livenessAddresses[liveness.GatewayAddress] {
+			return fmt.Errorf("duplicate gateway liveness %s", liveness.GatewayAddress)
+		}
+		livenessAddresses[liveness.GatewayAddress] = true
+		if liveness.LastHeartbeat < liveness.StartHeight {
+			return fmt.Errorf("gateway liveness %s last heartbeat %d is before start height %d", liveness.GatewayAddress, liveness.LastHeartbeat, liveness.StartHeight)
+		}
+	}
+
+	userCountAddresses := make(map[string]bool)
+	for _, userCount := range gs.GatewayUserCounts {
+		if _, err := sdk.ValAddressFromBech32(userCount.GatewayAddress); err != nil {
+			return fmt.Errorf("invalid gateway user count address %s: %w", userCount.GatewayAddress, err)
+		}
+		if userCountAddresses[userCount.GatewayAddress] {
+			return fmt.Errorf("duplicate gateway user count %s", userCount.GatewayAddress)
+		}
+		userCountAddresses[userCount.GatewayAddress] = true
+	}
+
+	slashedAddresses := make(map[string]bool)
+	for _, gatewayAddress := range gs.SlashedGateways {
+		if _, err := sdk.ValAddressFromBech32(gatewayAddress); err != nil {
+			return fmt.Errorf("invalid slashed gateway address %s: %w", gatewayAddress, err)
+		}
+		if slashedAddresses[gatewayAddress] {
+			return fmt.Errorf("duplicate slashed gateway %s", gatewayAddress)
+		}
+		slashedAddresses[gatewayAddress] = true
+	}
 
 	return nil
 }
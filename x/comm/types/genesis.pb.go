@@ -22,12 +22,32 @@ var _ = math.Inf
 
 
 
-const _ = proto.GoGoProtoPackageIsVersion3 
+const _ = proto.GoGoProtoPackageIsVersion3
 
 
 type GenesisState struct {
-	
-	Params               Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+
+	Gateways []Gateway `protobuf:"bytes,2,rep,name=gateways,proto3" json:"gateways"`
+
+	GatewayNums []GatewayNumIndex `protobuf:"bytes,3,rep,name=gateway_nums,json=gatewayNums,proto3" json:"gateway_nums"`
+
+	GatewayRedeemNums []GatewayNumIndex `protobuf:"bytes,4,rep,name=gateway_redeem_nums,json=gatewayRedeemNums,proto3" json:"gateway_redeem_nums"`
+
+	DelegateLastTimes []DelegateLastTime `protobuf:"bytes,5,rep,name=delegate_last_times,json=delegateLastTimes,proto3" json:"delegate_last_times"`
+
+	GatewayBonuses []GatewayBonus `protobuf:"bytes,6,rep,name=gateway_bonuses,json=gatewayBonuses,proto3" json:"gateway_bonuses"`
+
+	PrefixAuctions []PrefixAuction `protobuf:"bytes,7,rep,name=prefix_auctions,json=prefixAuctions,proto3" json:"prefix_auctions"`
+
+	PrefixBids []PrefixBid `protobuf:"bytes,8,rep,name=prefix_bids,json=prefixBids,proto3" json:"prefix_bids"`
+
+	GatewayLivenesses []GatewayLiveness `protobuf:"bytes,9,rep,name=gateway_livenesses,json=gatewayLivenesses,proto3" json:"gateway_livenesses"`
+
+	GatewayUserCounts []GatewayUserCount `protobuf:"bytes,10,rep,name=gateway_user_counts,json=gatewayUserCounts,proto3" json:"gateway_user_counts"`
+
+	SlashedGateways      []string `protobuf:"bytes,11,rep,name=slashed_gateways,json=slashedGateways,proto3" json:"slashed_gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,27 +93,182 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetGateways() []Gateway {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGatewayNums() []GatewayNumIndex {
+	if m != nil {
+		return m.GatewayNums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGatewayRedeemNums() []GatewayNumIndex {
+	if m != nil {
+		return m.GatewayRedeemNums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegateLastTimes() []DelegateLastTime {
+	if m != nil {
+		return m.DelegateLastTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGatewayBonuses() []GatewayBonus {
+	if m != nil {
+		return m.GatewayBonuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrefixAuctions() []PrefixAuction {
+	if m != nil {
+		return m.PrefixAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrefixBids() []PrefixBid {
+	if m != nil {
+		return m.PrefixBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGatewayLivenesses() []GatewayLiveness {
+	if m != nil {
+		return m.GatewayLivenesses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGatewayUserCounts() []GatewayUserCount {
+	if m != nil {
+		return m.GatewayUserCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedGateways() []string {
+	if m != nil {
+		return m.SlashedGateways
+	}
+	return nil
+}
+
+type DelegateLastTime struct {
+	DelegatorAddress     string   `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress     string   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegateLastTime) Reset()         { *m = DelegateLastTime{} }
+func (m *DelegateLastTime) String() string { return proto.CompactTextString(m) }
+func (*DelegateLastTime) ProtoMessage()    {}
+func (*DelegateLastTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14205810582f3203, []int{1}
+}
+func (m *DelegateLastTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateLastTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateLastTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateLastTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateLastTime.Merge(m, src)
+}
+func (m *DelegateLastTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateLastTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateLastTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateLastTime proto.InternalMessageInfo
+
+func (m *DelegateLastTime) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegateLastTime) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegateLastTime) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "freemasonry.comm.v1.GenesisState")
+	proto.RegisterType((*DelegateLastTime)(nil), "freemasonry.comm.v1.DelegateLastTime")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4e, 0x2b, 0x4a, 0x4d, 0xcd,
-	0x4d, 0x2c, 0xce, 0xcf, 0x2b, 0xaa, 0xd4, 0x4b, 0xce, 0xcf, 0xcd, 0xd5, 0x2b, 0x33, 0x94, 0x92,
-	0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f,
-	0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x83, 0x6a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5,
-	0x41, 0x2c, 0xa8, 0xa8, 0x28, 0x48, 0xb3, 0x7e, 0x99, 0xa1, 0x7e, 0x7a, 0x62, 0x49, 0x6a, 0x79,
-	0x62, 0x25, 0x44, 0x58, 0xc9, 0x93, 0x8b, 0xc7, 0x1d, 0x62, 0x61, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x25, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0xb4, 0x1e, 0x16, 0x07, 0xe8, 0x05, 0x80, 0x95, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10,
-	0x04, 0xd5, 0xe0, 0x64, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x46, 0xa9, 0xa1, 0xe8, 0x4b, 0xd6, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x4e, 0xce, 0x48, 0xcc,
-	0xcc, 0xd3, 0xaf, 0xd0, 0x07, 0xbb, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x0e,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0x21, 0x0c, 0x34, 0xf8, 0x00, 0x00, 0x00,
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0xc9, 0x3a, 0xca, 0xea, 0x0e, 0x5a, 0x5c, 0x40, 0xd1, 0x98, 0x4a, 0xa9, 0x00, 0x15,
+	0x21, 0x35, 0xda, 0xe0, 0xc2, 0x05, 0x69, 0x05, 0x34, 0x21, 0x15, 0x34, 0x02, 0x1c, 0x18, 0x87,
+	0xc8, 0x4d, 0xfe, 0x4b, 0x2d, 0x92, 0x38, 0xf2, 0xdf, 0x29, 0xed, 0x9d, 0x0f, 0xb7, 0x23, 0x9f,
+	0x00, 0x4d, 0xfd, 0x24, 0x28, 0x8e, 0x13, 0x6d, 0x55, 0x55, 0xb4, 0x9b, 0xf3, 0xfc, 0xde, 0x2f,
+	0x76, 0x9e, 0x63, 0x72, 0x3b, 0x84, 0x04, 0x90, 0xe3, 0x30, 0x95, 0x42, 0x09, 0xda, 0x39, 0x93,
+	0x00, 0x31, 0x43, 0x91, 0xc8, 0xc5, 0xd0, 0x17, 0x71, 0x3c, 0x9c, 0x1d, 0xec, 0xed, 0x87, 0x42,
+	0x84, 0x11, 0x38, 0x2c, 0xe5, 0x0e, 0x4b, 0x12, 0xa1, 0x98, 0xe2, 0x22, 0x31, 0x91, 0xbd, 0x7b,
+	0xa1, 0x08, 0x85, 0x1e, 0x3a, 0xf9, 0xc8, 0xa8, 0xf7, 0xf3, 0xb0, 0x33, 0x3b, 0x70, 0x42, 0xa6,
+	0xe0, 0x17, 0x5b, 0x14, 0x72, 0xff, 0xa2, 0x4e, 0x76, 0x8f, 0x8b, 0x37, 0x7e, 0x51, 0x4c, 0x01,
+	0x7d, 0x4d, 0xea, 0x29, 0x93, 0x2c, 0x46, 0xdb, 0xea, 0x59, 0x83, 0xe6, 0xe1, 0xc3, 0xe1, 0x9a,
+	0x15, 0x0c, 0x4f, 0xb4, 0x65, 0xb4, 0x7d, 0xfe, 0xf7, 0xd1, 0x0d, 0xd7, 0x04, 0xe8, 0x1b, 0xb2,
+	0x63, 0xe0, 0x68, 0x6f, 0xf5, 0x6a, 0x83, 0xe6, 0xe1, 0xfe, 0xda, 0xf0, 0x71, 0x61, 0x32, 0xe9,
+	0x2a, 0x43, 0x3f, 0x92, 0x5d, 0x33, 0xf6, 0x92, 0x2c, 0x46, 0xbb, 0xa6, 0x19, 0x4f, 0x36, 0x31,
+	0x3e, 0x65, 0xf1, 0x87, 0x24, 0x80, 0xb9, 0x61, 0x35, 0xc3, 0x4a, 0x46, 0x7a, 0x4a, 0x3a, 0x25,
+	0x4e, 0x42, 0x00, 0x10, 0x17, 0xd4, 0xed, 0x6b, 0x53, 0xef, 0x1a, 0x8c, 0xab, 0x29, 0x9a, 0xfd,
+	0x83, 0x74, 0x02, 0x88, 0x20, 0x9f, 0xf0, 0x22, 0x86, 0xca, 0x53, 0x3c, 0x06, 0xb4, 0x6f, 0x6a,
+	0xf6, 0xd3, 0xb5, 0xec, 0x77, 0xc6, 0x3f, 0x66, 0xa8, 0xbe, 0xf2, 0x18, 0x4a, 0x78, 0xb0, 0xa2,
+	0x23, 0x3d, 0x21, 0xad, 0x72, 0xe1, 0x13, 0x91, 0x64, 0x08, 0x68, 0xd7, 0x35, 0xf8, 0xf1, 0xc6,
+	0xcf, 0x99, 0x5b, 0x0d, 0xf4, 0x4e, 0x78, 0x49, 0x03, 0xa4, 0x9f, 0x49, 0x2b, 0x95, 0x70, 0xc6,
+	0xe7, 0x1e, 0xcb, 0x7c, 0x7d, 0x56, 0xec, 0x5b, 0x9a, 0xd8, 0x5f, 0xdf, 0xae, 0xf6, 0x1e, 0x15,
+	0xd6, 0x12, 0x99, 0x5e, 0x16, 0x91, 0xbe, 0x27, 0x4d, 0x83, 0x9c, 0xf0, 0x00, 0xed, 0x1d, 0x8d,
+	0xeb, 0x6e, 0xc0, 0x8d, 0x78, 0x60, 0x50, 0x24, 0x2d, 0x05, 0xa4, 0xdf, 0x09, 0x2d, 0xf7, 0x1a,
+	0xf1, 0x59, 0x7e, 0x10, 0xf3, 0xed, 0x36, 0xfe, 0xdf, 0xd1, 0xd8, 0xb8, 0x57, 0x3a, 0x1a, 0x57,
+	0x90, 0xbc, 0xa3, 0x12, 0x9d, 0x21, 0x48, 0xcf, 0x17, 0x59, 0xa2, 0xd0, 0x26, 0x1b, 0x3a, 0x32,
+	0xec, 0x6f, 0x08, 0xf2, 0x6d, 0xee, 0x5e, 0x81, 0x57, 0x3a, 0xd2, 0xe7, 0xa4, 0x8d, 0x11, 0xc3,
+	0x29, 0x04, 0x5e, 0x75, 0xe6, 0x9b, 0xbd, 0xda, 0xa0, 0xe1, 0xb6, 0x8c, 0x6e, 0x58, 0xd8, 0xff,
+	0x6d, 0x91, 0xf6, 0x6a, 0xf9, 0xf4, 0x05, 0x29, 0x8b, 0x17, 0xd2, 0x63, 0x41, 0x20, 0x01, 0x8b,
+	0x3f, 0xae, 0xe1, 0xb6, 0xab, 0x89, 0xa3, 0x42, 0xcf, 0xcd, 0x33, 0x16, 0xf1, 0xe0, 0x8a, 0x79,
+	0xab, 0x30, 0x57, 0x13, 0xa5, 0xf9, 0x01, 0xa9, 0x4f, 0x81, 0x87, 0x53, 0x65, 0xd7, 0x7a, 0xd6,
+	0xa0, 0xe6, 0x9a, 0xa7, 0xd1, 0xab, 0xf3, 0x65, 0xd7, 0xfa, 0xb3, 0xec, 0x5a, 0x17, 0xcb, 0xae,
+	0x75, 0xfa, 0xec, 0xca, 0xf6, 0x7d, 0x67, 0x12, 0x09, 0xff, 0xa7, 0x3f, 0x65, 0x3c, 0x71, 0xe6,
+	0x8e, 0xbe, 0x2a, 0xd4, 0x22, 0x05, 0x9c, 0xd4, 0xf5, 0x35, 0xf1, 0xf2, 0xdf, 0x00, 0xb9, 0xbf,
+	0x4e, 0x1a, 0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +295,141 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SlashedGateways) > 0 {
+		for iNdEx := len(m.SlashedGateways) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashedGateways[iNdEx])
+			copy(dAtA[i:], m.SlashedGateways[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashedGateways[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.GatewayUserCounts) > 0 {
+		for iNdEx := len(m.GatewayUserCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayUserCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.GatewayLivenesses) > 0 {
+		for iNdEx := len(m.GatewayLivenesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayLivenesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PrefixBids) > 0 {
+		for iNdEx := len(m.PrefixBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrefixBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PrefixAuctions) > 0 {
+		for iNdEx := len(m.PrefixAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrefixAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GatewayBonuses) > 0 {
+		for iNdEx := len(m.GatewayBonuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayBonuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegateLastTimes) > 0 {
+		for iNdEx := len(m.DelegateLastTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateLastTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GatewayRedeemNums) > 0 {
+		for iNdEx := len(m.GatewayRedeemNums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayRedeemNums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GatewayNums) > 0 {
+		for iNdEx := len(m.GatewayNums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayNums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Gateways) > 0 {
+		for iNdEx := len(m.Gateways) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gateways[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -133,6 +443,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegateLastTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateLastTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateLastTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -152,6 +508,89 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GatewayNums) > 0 {
+		for _, e := range m.GatewayNums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GatewayRedeemNums) > 0 {
+		for _, e := range m.GatewayRedeemNums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateLastTimes) > 0 {
+		for _, e := range m.DelegateLastTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GatewayBonuses) > 0 {
+		for _, e := range m.GatewayBonuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrefixAuctions) > 0 {
+		for _, e := range m.PrefixAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrefixBids) > 0 {
+		for _, e := range m.PrefixBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GatewayLivenesses) > 0 {
+		for _, e := range m.GatewayLivenesses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GatewayUserCounts) > 0 {
+		for _, e := range m.GatewayUserCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedGateways) > 0 {
+		for _, s := range m.SlashedGateways {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DelegateLastTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -226,6 +665,478 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateways = append(m.Gateways, Gateway{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayNums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayNums = append(m.GatewayNums, GatewayNumIndex{})
+			if err := m.GatewayNums[len(m.GatewayNums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayRedeemNums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayRedeemNums = append(m.GatewayRedeemNums, GatewayNumIndex{})
+			if err := m.GatewayRedeemNums[len(m.GatewayRedeemNums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLastTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateLastTimes = append(m.DelegateLastTimes, DelegateLastTime{})
+			if err := m.DelegateLastTimes[len(m.DelegateLastTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayBonuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayBonuses = append(m.GatewayBonuses, GatewayBonus{})
+			if err := m.GatewayBonuses[len(m.GatewayBonuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrefixAuctions = append(m.PrefixAuctions, PrefixAuction{})
+			if err := m.PrefixAuctions[len(m.PrefixAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrefixBids = append(m.PrefixBids, PrefixBid{})
+			if err := m.PrefixBids[len(m.PrefixBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayLivenesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayLivenesses = append(m.GatewayLivenesses, GatewayLiveness{})
+			if err := m.GatewayLivenesses[len(m.GatewayLivenesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayUserCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayUserCounts = append(m.GatewayUserCounts, GatewayUserCount{})
+			if err := m.GatewayUserCounts[len(m.GatewayUserCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedGateways", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedGateways = append(m.SlashedGateways, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateLastTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateLastTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateLastTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
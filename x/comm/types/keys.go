@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -14,6 +16,8 @@ const (
 
 
 	RouterKey = ModuleName
+
+	ModuleBurnName = "comm_burn"
 )
 
 
@@ -24,8 +28,133 @@ func init() {
 }
 
 
-const (
-	KeyPrefixAddressBook = "comm_address_book"
+const NumberSuffixLength = 5
+
 
-	DelegateLastTimeKey = "delegate_last_time_"
+var (
+	KeyPrefixGatewayNum          = []byte{0x01}
+	KeyPrefixGatewayNumByGateway = []byte{0x02}
+	KeyPrefixGatewayRedeemNum    = []byte{0x03}
+	KeyPrefixGatewayRedeemQueue  = []byte{0x04}
+	KeyPrefixGateway             = []byte{0x05}
+	KeyPrefixDelegateLastTime    = []byte{0x06}
+	KeyPrefixPortedNum           = []byte{0x07}
+	KeyPrefixSlashedGateway      = []byte{0x08}
+	KeyPrefixGatewayBonus        = []byte{0x09}
+	KeyPrefixGatewayUserCount    = []byte{0x0A}
+	KeyPrefixPrefixAuction       = []byte{0x0B}
+	KeyPrefixAuctionQueue        = []byte{0x0C}
+	KeyPrefixPrefixBid           = []byte{0x0D}
+	KeyPrefixGatewayLiveness     = []byte{0x0E}
 )
+
+
+func GetGatewayKey(gatewayAddress string) []byte {
+	return append(KeyPrefixGateway, []byte(gatewayAddress)...)
+}
+
+
+func GetGatewayNumKey(numberIndex string) []byte {
+	return append(KeyPrefixGatewayNum, []byte(numberIndex)...)
+}
+
+
+func GetGatewayNumByGatewayPrefix(gatewayAddress string) []byte {
+	return append(KeyPrefixGatewayNumByGateway, address.MustLengthPrefix([]byte(gatewayAddress))...)
+}
+
+func GetGatewayNumByGatewayKey(gatewayAddress, numberIndex string) []byte {
+	return append(GetGatewayNumByGatewayPrefix(gatewayAddress), []byte(numberIndex)...)
+}
+
+
+func ParseGatewayNumByGatewayKey(key []byte) (string, string) {
+	key = key[len(KeyPrefixGatewayNumByGateway):]
+	gatewayLen := int(key[0])
+	return string(key[1 : 1+gatewayLen]), string(key[1+gatewayLen:])
+}
+
+
+func GetGatewayRedeemNumKey(numberIndex string) []byte {
+	return append(KeyPrefixGatewayRedeemNum, []byte(numberIndex)...)
+}
+
+
+func GetGatewayRedeemQueuePrefix(validity int64) []byte {
+	return append(KeyPrefixGatewayRedeemQueue, sdk.Uint64ToBigEndian(uint64(validity))...)
+}
+
+func GetGatewayRedeemQueueKey(validity int64, numberIndex string) []byte {
+	return append(GetGatewayRedeemQueuePrefix(validity), []byte(numberIndex)...)
+}
+
+
+func ParseGatewayRedeemQueueKey(key []byte) (int64, string) {
+	key = key[len(KeyPrefixGatewayRedeemQueue):]
+	return int64(sdk.BigEndianToUint64(key[:8])), string(key[8:])
+}
+
+
+func GetDelegateLastTimeKey(delegatorAddress, validatorAddress string) []byte {
+	key := append(KeyPrefixDelegateLastTime, address.MustLengthPrefix([]byte(delegatorAddress))...)
+	return append(key, []byte(validatorAddress)...)
+}
+
+
+func GetPortedNumKey(mobile string) []byte {
+	return append(KeyPrefixPortedNum, []byte(mobile)...)
+}
+
+
+func IsPortedNum(numberIndex, mobile string) bool {
+	return len(mobile) <= NumberSuffixLength || mobile[:len(mobile)-NumberSuffixLength] != numberIndex
+}
+
+
+func GetSlashedGatewayKey(gatewayAddress string) []byte {
+	return append(KeyPrefixSlashedGateway, []byte(gatewayAddress)...)
+}
+
+
+func GetGatewayBonusKey(gatewayAddress string) []byte {
+	return append(KeyPrefixGatewayBonus, []byte(gatewayAddress)...)
+}
+
+
+func GetGatewayUserCountKey(gatewayAddress string) []byte {
+	return append(KeyPrefixGatewayUserCount, []byte(gatewayAddress)...)
+}
+
+
+func GetPrefixAuctionKey(numberIndex string) []byte {
+	return append(KeyPrefixPrefixAuction, []byte(numberIndex)...)
+}
+
+
+func GetAuctionQueuePrefix(endHeight int64) []byte {
+	return append(KeyPrefixAuctionQueue, sdk.Uint64ToBigEndian(uint64(endHeight))...)
+}
+
+func GetAuctionQueueKey(endHeight int64, numberIndex string) []byte {
+	return append(GetAuctionQueuePrefix(endHeight), []byte(numberIndex)...)
+}
+
+
+func ParseAuctionQueueKey(key []byte) (int64, string) {
+	key = key[len(KeyPrefixAuctionQueue):]
+	return int64(sdk.BigEndianToUint64(key[:8])), string(key[8:])
+}
+
+
+func GetPrefixBidPrefix(numberIndex string) []byte {
+	return append(KeyPrefixPrefixBid, address.MustLengthPrefix([]byte(numberIndex))...)
+}
+
+func GetPrefixBidKey(numberIndex string, seq uint64) []byte {
+	return append(GetPrefixBidPrefix(numberIndex), sdk.Uint64ToBigEndian(seq)...)
+}
+
+
+func GetGatewayLivenessKey(gatewayAddress string) []byte {
+	return append(KeyPrefixGatewayLiveness, []byte(gatewayAddress)...)
+}
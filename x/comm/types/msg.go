@@ -10,12 +10,20 @@ var (
 	_ sdk.Msg = &MsgGatewayRegister{}
 	_ sdk.Msg = &MsgGatewayDelegate{}
 	_ sdk.Msg = &MsgGatewayUndelegate{}
+	_ sdk.Msg = &MsgWithdrawGatewayBonus{}
+	_ sdk.Msg = &MsgBid{}
+	_ sdk.Msg = &MsgGatewayHeartbeat{}
+	_ sdk.Msg = &MsgGatewayEditEndpoints{}
 )
 
 const (
-	TypeMsgGatewayRegister     = "gateway_register"
-	TypeMsgGatewayDelegation   = "gateway_delegation"
-	TypeMsgGatewayUndelegation = "gateway_undelegation"
+	TypeMsgGatewayRegister      = "gateway_register"
+	TypeMsgGatewayDelegation    = "gateway_delegation"
+	TypeMsgGatewayUndelegation  = "gateway_undelegation"
+	TypeMsgWithdrawGatewayBonus = "withdraw_gateway_bonus"
+	TypeMsgBid                  = "bid"
+	TypeMsgGatewayHeartbeat     = "gateway_heartbeat"
+	TypeMsgGatewayEditEndpoints = "gateway_edit_endpoints"
 )
 
 
@@ -145,3 +153,134 @@ func (msg MsgGatewayUndelegate) ValidateBasic() error {
 func (m MsgGatewayUndelegate) XXX_MessageName() string {
 	return TypeMsgGatewayUndelegation
 }
+
+
+func NewMsgWithdrawGatewayBonus(address string) *MsgWithdrawGatewayBonus {
+	return &MsgWithdrawGatewayBonus{
+		Address: address,
+	}
+}
+
+func (msg MsgWithdrawGatewayBonus) Route() string { return RouterKey }
+func (msg MsgWithdrawGatewayBonus) Type() string  { return TypeMsgWithdrawGatewayBonus }
+func (msg MsgWithdrawGatewayBonus) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgWithdrawGatewayBonus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgWithdrawGatewayBonus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid send address")
+	}
+	return nil
+}
+func (m MsgWithdrawGatewayBonus) XXX_MessageName() string {
+	return TypeMsgWithdrawGatewayBonus
+}
+
+
+func NewMsgBid(address, numberIndex string, amount sdk.Coin) *MsgBid {
+	return &MsgBid{
+		Address:     address,
+		NumberIndex: numberIndex,
+		Amount:      amount,
+	}
+}
+
+func (msg MsgBid) Route() string { return RouterKey }
+func (msg MsgBid) Type() string  { return TypeMsgBid }
+func (msg MsgBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid send address")
+	}
+	if len(msg.NumberIndex) != 6 {
+		return ErrGatewayNumLength
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrDelegationCoin, msg.Amount.String())
+	}
+	return nil
+}
+func (m MsgBid) XXX_MessageName() string {
+	return TypeMsgBid
+}
+
+
+func NewMsgGatewayHeartbeat(address string) *MsgGatewayHeartbeat {
+	return &MsgGatewayHeartbeat{
+		Address: address,
+	}
+}
+
+func (msg MsgGatewayHeartbeat) Route() string { return RouterKey }
+func (msg MsgGatewayHeartbeat) Type() string  { return TypeMsgGatewayHeartbeat }
+func (msg MsgGatewayHeartbeat) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgGatewayHeartbeat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgGatewayHeartbeat) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid send address")
+	}
+	return nil
+}
+func (m MsgGatewayHeartbeat) XXX_MessageName() string {
+	return TypeMsgGatewayHeartbeat
+}
+
+
+func NewMsgGatewayEditEndpoints(address string, endpoints []GatewayEndpoint, tlsFingerprint, transportPubKey string) *MsgGatewayEditEndpoints {
+	return &MsgGatewayEditEndpoints{
+		Address:         address,
+		Endpoints:       endpoints,
+		TlsFingerprint:  tlsFingerprint,
+		TransportPubKey: transportPubKey,
+	}
+}
+
+func (msg MsgGatewayEditEndpoints) Route() string { return RouterKey }
+func (msg MsgGatewayEditEndpoints) Type() string  { return TypeMsgGatewayEditEndpoints }
+func (msg MsgGatewayEditEndpoints) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{addr}
+}
+func (msg *MsgGatewayEditEndpoints) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgGatewayEditEndpoints) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid send address")
+	}
+	return ValidateGatewayEndpoints(msg.Endpoints, msg.TlsFingerprint, msg.TransportPubKey)
+}
+func (m MsgGatewayEditEndpoints) XXX_MessageName() string {
+	return TypeMsgGatewayEditEndpoints
+}
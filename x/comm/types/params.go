@@ -24,17 +24,41 @@ var (
 	DefaultBonusHalve = int64(15768000)
 
 	DefaultBonus = sdk.NewInt(10000).Mul(sdk.NewInt(core.RealToLedgerRateInt64))
+
+	DefaultBonusDecay = sdk.NewDecWithPrec(5, 1)
+
+	DefaultBonusUserWeight = sdk.NewDecWithPrec(5, 1)
+
+	DefaultPremiumPrefixes = []string{}
+
+	DefaultAuctionPeriod = int64(100800)
+
+	DefaultAuctionMinBid = sdk.NewInt(1000).Mul(sdk.NewInt(core.RealToLedgerRateInt64))
+
+	DefaultHeartbeatWindow = int64(600)
+
+	DefaultHeartbeatMissLimit = int64(24)
+
+	DefaultHeartbeatRedeemWindows = int64(168)
 )
 
 var (
-	KeyIndexNumHeight  = []byte("IndexNumHeight")
-	KeyRedeemFeeHeight = []byte("RedeemFeeHeight")
-	KeyRedeemFee       = []byte("RedeemFee")
-	KeyMinDelegate     = []byte("MinDelegate")
-	KeyValidity        = []byte("Validity")
-	KeyBonusCycle      = []byte("BonusCycle")
-	KeyBonusHalve      = []byte("BonusHalve")
-	KeyBonus           = []byte("Bonus")
+	KeyIndexNumHeight         = []byte("IndexNumHeight")
+	KeyRedeemFeeHeight        = []byte("RedeemFeeHeight")
+	KeyRedeemFee              = []byte("RedeemFee")
+	KeyMinDelegate            = []byte("MinDelegate")
+	KeyValidity               = []byte("Validity")
+	KeyBonusCycle             = []byte("BonusCycle")
+	KeyBonusHalve             = []byte("BonusHalve")
+	KeyBonus                  = []byte("Bonus")
+	KeyBonusDecay             = []byte("BonusDecay")
+	KeyBonusUserWeight        = []byte("BonusUserWeight")
+	KeyPremiumPrefixes        = []byte("PremiumPrefixes")
+	KeyAuctionPeriod          = []byte("AuctionPeriod")
+	KeyAuctionMinBid          = []byte("AuctionMinBid")
+	KeyHeartbeatWindow        = []byte("HeartbeatWindow")
+	KeyHeartbeatMissLimit     = []byte("HeartbeatMissLimit")
+	KeyHeartbeatRedeemWindows = []byte("HeartbeatRedeemWindows")
 )
 
 
@@ -47,30 +71,54 @@ func NewParams(
 	BonusCycle int64,
 	BonusHalve int64,
 	Bonus sdk.Int,
+	BonusDecay sdk.Dec,
+	BonusUserWeight sdk.Dec,
+	PremiumPrefixes []string,
+	AuctionPeriod int64,
+	AuctionMinBid sdk.Int,
+	HeartbeatWindow int64,
+	HeartbeatMissLimit int64,
+	HeartbeatRedeemWindows int64,
 ) Params {
 	return Params{
-		IndexNumHeight:  IndexNumHeight,
-		RedeemFeeHeight: RedeemFeeHeight,
-		RedeemFee:       RedeemFee,
-		MinDelegate:     MinDelegate,
-		Validity:        Validity,
-		BonusCycle:      BonusCycle,
-		BonusHalve:      BonusHalve,
-		Bonus:           Bonus,
+		IndexNumHeight:         IndexNumHeight,
+		RedeemFeeHeight:        RedeemFeeHeight,
+		RedeemFee:              RedeemFee,
+		MinDelegate:            MinDelegate,
+		Validity:               Validity,
+		BonusCycle:             BonusCycle,
+		BonusHalve:             BonusHalve,
+		Bonus:                  Bonus,
+		BonusDecay:             BonusDecay,
+		BonusUserWeight:        BonusUserWeight,
+		PremiumPrefixes:        PremiumPrefixes,
+		AuctionPeriod:          AuctionPeriod,
+		AuctionMinBid:          AuctionMinBid,
+		HeartbeatWindow:        HeartbeatWindow,
+		HeartbeatMissLimit:     HeartbeatMissLimit,
+		HeartbeatRedeemWindows: HeartbeatRedeemWindows,
 	}
 }
 
 func DefaultParams() Params {
 
 	return Params{
-		IndexNumHeight:  DefaultIndexNumHeight,
-		RedeemFeeHeight: DefaultRedeemFeeHeight,
-		RedeemFee:       DefaultRedeemFee,
-		MinDelegate:     DefaultMinDelegate,
-		Validity:        DefaultValidity,
-		BonusCycle:      DefaultBonusCycle,
-		BonusHalve:      DefaultBonusHalve,
-		Bonus:           DefaultBonus,
+		IndexNumHeight:         DefaultIndexNumHeight,
+		RedeemFeeHeight:        DefaultRedeemFeeHeight,
+		RedeemFee:              DefaultRedeemFee,
+		MinDelegate:            DefaultMinDelegate,
+		Validity:               DefaultValidity,
+		BonusCycle:             DefaultBonusCycle,
+		BonusHalve:             DefaultBonusHalve,
+		Bonus:                  DefaultBonus,
+		BonusDecay:             DefaultBonusDecay,
+		BonusUserWeight:        DefaultBonusUserWeight,
+		PremiumPrefixes:        DefaultPremiumPrefixes,
+		AuctionPeriod:          DefaultAuctionPeriod,
+		AuctionMinBid:          DefaultAuctionMinBid,
+		HeartbeatWindow:        DefaultHeartbeatWindow,
+		HeartbeatMissLimit:     DefaultHeartbeatMissLimit,
+		HeartbeatRedeemWindows: DefaultHeartbeatRedeemWindows,
 	}
 }
 
@@ -102,6 +150,30 @@ func (p Params) Validate() error {
 	if err := validateBonus(p.Bonus); err != nil {
 		return err
 	}
+	if err := validateBonusDecay(p.BonusDecay); err != nil {
+		return err
+	}
+	if err := validateBonusUserWeight(p.BonusUserWeight); err != nil {
+		return err
+	}
+	if err := validatePremiumPrefixes(p.PremiumPrefixes); err != nil {
+		return err
+	}
+	if err := validateAuctionPeriod(p.AuctionPeriod); err != nil {
+		return err
+	}
+	if err := validateAuctionMinBid(p.AuctionMinBid); err != nil {
+		return err
+	}
+	if err := validateHeartbeatWindow(p.HeartbeatWindow); err != nil {
+		return err
+	}
+	if err := validateHeartbeatMissLimit(p.HeartbeatMissLimit); err != nil {
+		return err
+	}
+	if err := validateHeartbeatRedeemWindows(p.HeartbeatRedeemWindows); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -115,6 +187,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBonusCycle, &p.BonusCycle, validateBonusCycle),
 		paramtypes.NewParamSetPair(KeyBonusHalve, &p.BonusHalve, validateBonusHalve),
 		paramtypes.NewParamSetPair(KeyBonus, &p.Bonus, validateBonus),
+		paramtypes.NewParamSetPair(KeyBonusDecay, &p.BonusDecay, validateBonusDecay),
+		paramtypes.NewParamSetPair(KeyBonusUserWeight, &p.BonusUserWeight, validateBonusUserWeight),
+		paramtypes.NewParamSetPair(KeyPremiumPrefixes, &p.PremiumPrefixes, validatePremiumPrefixes),
+		paramtypes.NewParamSetPair(KeyAuctionPeriod, &p.AuctionPeriod, validateAuctionPeriod),
+		paramtypes.NewParamSetPair(KeyAuctionMinBid, &p.AuctionMinBid, validateAuctionMinBid),
+		paramtypes.NewParamSetPair(KeyHeartbeatWindow, &p.HeartbeatWindow, validateHeartbeatWindow),
+		paramtypes.NewParamSetPair(KeyHeartbeatMissLimit, &p.HeartbeatMissLimit, validateHeartbeatMissLimit),
+		paramtypes.NewParamSetPair(KeyHeartbeatRedeemWindows, &p.HeartbeatRedeemWindows, validateHeartbeatRedeemWindows),
 	}
 }
 
@@ -146,10 +226,18 @@ func validateRedeemFee(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("RedeemFee cannot be nil")
+	}
+
 	if v.IsNegative() {
 		return fmt.Errorf("RedeemFee cannot be negative: %s", v)
 	}
 
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("RedeemFee cannot be greater than 1: %s", v)
+	}
+
 	return nil
 }
 
@@ -159,8 +247,8 @@ func validateMinDelegate(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.IsNegative() {
-		return fmt.Errorf("MinDelegate cannot be negative: %s", v)
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("MinDelegate must be positive: %s", v)
 	}
 	return nil
 }
@@ -204,12 +292,143 @@ func validateBonus(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("Bonus cannot be nil")
+	}
+
 	if v.IsNegative() {
 		return fmt.Errorf("Bonus cannot be negative: %s", v)
 	}
 	return nil
 }
 
+func validateBonusDecay(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("BonusDecay cannot be nil")
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("BonusDecay must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("BonusDecay cannot be greater than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateBonusUserWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("BonusUserWeight cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("BonusUserWeight cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("BonusUserWeight cannot be greater than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validatePremiumPrefixes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	prefixes := make(map[string]bool)
+	for _, prefix := range v {
+		if len(prefix) != 6 {
+			return fmt.Errorf("PremiumPrefixes contains an invalid number index: %s", prefix)
+		}
+		if prefixes[prefix] {
+			return fmt.Errorf("PremiumPrefixes contains a duplicate number index: %s", prefix)
+		}
+		prefixes[prefix] = true
+	}
+	return nil
+}
+
+func validateAuctionPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("AuctionPeriod must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateAuctionMinBid(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("AuctionMinBid must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateHeartbeatWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("HeartbeatWindow must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateHeartbeatMissLimit(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("HeartbeatMissLimit must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateHeartbeatRedeemWindows(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("HeartbeatRedeemWindows must be positive: %d", v)
+	}
+	return nil
+}
+
+
+func (p Params) IsPremiumPrefix(numberIndex string) bool {
+	for _, prefix := range p.PremiumPrefixes {
+		if prefix == numberIndex {
+			return true
+		}
+	}
+	return false
+}
+
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(KeyIndexNumHeight, DefaultParams().IndexNumHeight, validateIndexNumHeight),
@@ -220,5 +439,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(KeyBonusCycle, DefaultParams().BonusCycle, validateBonusCycle),
 		paramtypes.NewParamSetPair(KeyBonusHalve, DefaultParams().BonusHalve, validateBonusHalve),
 		paramtypes.NewParamSetPair(KeyBonus, DefaultParams().Bonus, validateBonus),
+		paramtypes.NewParamSetPair(KeyBonusDecay, DefaultParams().BonusDecay, validateBonusDecay),
+		paramtypes.NewParamSetPair(KeyBonusUserWeight, DefaultParams().BonusUserWeight, validateBonusUserWeight),
+		paramtypes.NewParamSetPair(KeyPremiumPrefixes, DefaultParams().PremiumPrefixes, validatePremiumPrefixes),
+		paramtypes.NewParamSetPair(KeyAuctionPeriod, DefaultParams().AuctionPeriod, validateAuctionPeriod),
+		paramtypes.NewParamSetPair(KeyAuctionMinBid, DefaultParams().AuctionMinBid, validateAuctionMinBid),
+		paramtypes.NewParamSetPair(KeyHeartbeatWindow, DefaultParams().HeartbeatWindow, validateHeartbeatWindow),
+		paramtypes.NewParamSetPair(KeyHeartbeatMissLimit, DefaultParams().HeartbeatMissLimit, validateHeartbeatMissLimit),
+		paramtypes.NewParamSetPair(KeyHeartbeatRedeemWindows, DefaultParams().HeartbeatRedeemWindows, validateHeartbeatRedeemWindows),
 	)
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(p *Params)
+		expPass  bool
+	}{
+		{"default", func(p *Params) {}, true},
+		{"zero index num height", func(p *Params) { p.IndexNumHeight = 0 }, false},
+		{"negative redeem fee height", func(p *Params) { p.RedeemFeeHeight = -1 }, false},
+		{"zero redeem fee", func(p *Params) { p.RedeemFee = sdk.ZeroDec() }, true},
+		{"redeem fee of 1", func(p *Params) { p.RedeemFee = sdk.OneDec() }, true},
+		{"negative redeem fee", func(p *Params) { p.RedeemFee = sdk.NewDecWithPrec(-1, 2) }, false},
+		{"redeem fee greater than 1", func(p *Params) { p.RedeemFee = sdk.NewDecWithPrec(101, 2) }, false},
+		{"nil redeem fee", func(p *Params) { p.RedeemFee = sdk.Dec{} }, false},
+		{"zero min delegate", func(p *Params) { p.MinDelegate = sdk.ZeroInt() }, false},
+		{"zero validity", func(p *Params) { p.Validity = 0 }, false},
+		{"zero bonus cycle", func(p *Params) { p.BonusCycle = 0 }, false},
+		{"negative bonus halve", func(p *Params) { p.BonusHalve = -1 }, false},
+		{"zero bonus", func(p *Params) { p.Bonus = sdk.ZeroInt() }, true},
+		{"negative bonus", func(p *Params) { p.Bonus = sdk.NewInt(-1) }, false},
+		{"zero bonus decay", func(p *Params) { p.BonusDecay = sdk.ZeroDec() }, false},
+		{"no bonus decay", func(p *Params) { p.BonusDecay = sdk.OneDec() }, true},
+		{"bonus decay above one", func(p *Params) { p.BonusDecay = sdk.NewDecWithPrec(11, 1) }, false},
+		{"zero bonus user weight", func(p *Params) { p.BonusUserWeight = sdk.ZeroDec() }, true},
+		{"bonus user weight above one", func(p *Params) { p.BonusUserWeight = sdk.NewDecWithPrec(11, 1) }, false},
+		{"premium prefixes", func(p *Params) { p.PremiumPrefixes = []string{"888888", "666666"} }, true},
+		{"short premium prefix", func(p *Params) { p.PremiumPrefixes = []string{"8888"} }, false},
+		{"duplicate premium prefix", func(p *Params) { p.PremiumPrefixes = []string{"888888", "888888"} }, false},
+		{"zero auction period", func(p *Params) { p.AuctionPeriod = 0 }, false},
+		{"zero auction min bid", func(p *Params) { p.AuctionMinBid = sdk.ZeroInt() }, false},
+		{"zero heartbeat window", func(p *Params) { p.HeartbeatWindow = 0 }, false},
+		{"zero heartbeat miss limit", func(p *Params) { p.HeartbeatMissLimit = 0 }, false},
+		{"negative heartbeat redeem windows", func(p *Params) { p.HeartbeatRedeemWindows = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,7 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -24,160 +28,1529 @@ var _ = math.Inf
 
 
 
-const _ = proto.GoGoProtoPackageIsVersion3 
+const _ = proto.GoGoProtoPackageIsVersion3
 
+type QueryGatewayRequest struct {
+	GatewayAddress       string   `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayRequest) Reset()         { *m = QueryGatewayRequest{} }
+func (m *QueryGatewayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayRequest) ProtoMessage()    {}
+func (*QueryGatewayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{0}
+}
+func (m *QueryGatewayRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayRequest.Merge(m, src)
+}
+func (m *QueryGatewayRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayRequest.Size(m)
+}
+func (m *QueryGatewayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayRequest proto.InternalMessageInfo
+
+func (m *QueryGatewayRequest) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+type QueryGatewayResponse struct {
+	Gateway              Gateway  `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayResponse) Reset()         { *m = QueryGatewayResponse{} }
+func (m *QueryGatewayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayResponse) ProtoMessage()    {}
+func (*QueryGatewayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{1}
+}
+func (m *QueryGatewayResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayResponse.Merge(m, src)
+}
+func (m *QueryGatewayResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayResponse.Size(m)
+}
+func (m *QueryGatewayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayResponse proto.InternalMessageInfo
+
+func (m *QueryGatewayResponse) GetGateway() Gateway {
+	if m != nil {
+		return m.Gateway
+	}
+	return Gateway{}
+}
+
+type QueryGatewaysRequest struct {
+	Pagination           *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryGatewaysRequest) Reset()         { *m = QueryGatewaysRequest{} }
+func (m *QueryGatewaysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewaysRequest) ProtoMessage()    {}
+func (*QueryGatewaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{2}
+}
+func (m *QueryGatewaysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewaysRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewaysRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewaysRequest.Merge(m, src)
+}
+func (m *QueryGatewaysRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewaysRequest.Size(m)
+}
+func (m *QueryGatewaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewaysRequest proto.InternalMessageInfo
+
+func (m *QueryGatewaysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGatewaysResponse struct {
+	Gateways             []Gateway           `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryGatewaysResponse) Reset()         { *m = QueryGatewaysResponse{} }
+func (m *QueryGatewaysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewaysResponse) ProtoMessage()    {}
+func (*QueryGatewaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{3}
+}
+func (m *QueryGatewaysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewaysResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewaysResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewaysResponse.Merge(m, src)
+}
+func (m *QueryGatewaysResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewaysResponse.Size(m)
+}
+func (m *QueryGatewaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewaysResponse proto.InternalMessageInfo
+
+func (m *QueryGatewaysResponse) GetGateways() []Gateway {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *QueryGatewaysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGatewayByNumberRequest struct {
+
+	Number               string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayByNumberRequest) Reset()         { *m = QueryGatewayByNumberRequest{} }
+func (m *QueryGatewayByNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayByNumberRequest) ProtoMessage()    {}
+func (*QueryGatewayByNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{4}
+}
+func (m *QueryGatewayByNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayByNumberRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewayByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayByNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayByNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayByNumberRequest.Merge(m, src)
+}
+func (m *QueryGatewayByNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayByNumberRequest.Size(m)
+}
+func (m *QueryGatewayByNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayByNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayByNumberRequest proto.InternalMessageInfo
+
+func (m *QueryGatewayByNumberRequest) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+type QueryGatewayByNumberResponse struct {
+	Gateway              Gateway  `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayByNumberResponse) Reset()         { *m = QueryGatewayByNumberResponse{} }
+func (m *QueryGatewayByNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayByNumberResponse) ProtoMessage()    {}
+func (*QueryGatewayByNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{5}
+}
+func (m *QueryGatewayByNumberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayByNumberResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewayByNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayByNumberResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayByNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayByNumberResponse.Merge(m, src)
+}
+func (m *QueryGatewayByNumberResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayByNumberResponse.Size(m)
+}
+func (m *QueryGatewayByNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayByNumberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayByNumberResponse proto.InternalMessageInfo
+
+func (m *QueryGatewayByNumberResponse) GetGateway() Gateway {
+	if m != nil {
+		return m.Gateway
+	}
+	return Gateway{}
+}
+
+type QueryNumberIndexRequest struct {
+	NumberIndex          string   `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
+func (m *QueryNumberIndexRequest) Reset()         { *m = QueryNumberIndexRequest{} }
+func (m *QueryNumberIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNumberIndexRequest) ProtoMessage()    {}
+func (*QueryNumberIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{6}
+}
+func (m *QueryNumberIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNumberIndexRequest.Unmarshal(m, b)
+}
+func (m *QueryNumberIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNumberIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryNumberIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNumberIndexRequest.Merge(m, src)
+}
+func (m *QueryNumberIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryNumberIndexRequest.Size(m)
+}
+func (m *QueryNumberIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNumberIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNumberIndexRequest proto.InternalMessageInfo
+
+func (m *QueryNumberIndexRequest) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+type QueryNumberIndexResponse struct {
+	NumberIndex          GatewayNumIndex `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryNumberIndexResponse) Reset()         { *m = QueryNumberIndexResponse{} }
+func (m *QueryNumberIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNumberIndexResponse) ProtoMessage()    {}
+func (*QueryNumberIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{7}
+}
+func (m *QueryNumberIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNumberIndexResponse.Unmarshal(m, b)
+}
+func (m *QueryNumberIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNumberIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryNumberIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNumberIndexResponse.Merge(m, src)
+}
+func (m *QueryNumberIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryNumberIndexResponse.Size(m)
+}
+func (m *QueryNumberIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNumberIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNumberIndexResponse proto.InternalMessageInfo
+
+func (m *QueryNumberIndexResponse) GetNumberIndex() GatewayNumIndex {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return GatewayNumIndex{}
+}
+
+type QueryNumbersRequest struct {
+
+	GatewayAddress       string             `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	Pagination           *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryNumbersRequest) Reset()         { *m = QueryNumbersRequest{} }
+func (m *QueryNumbersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNumbersRequest) ProtoMessage()    {}
+func (*QueryNumbersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{8}
+}
+func (m *QueryNumbersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNumbersRequest.Unmarshal(m, b)
+}
+func (m *QueryNumbersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNumbersRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryNumbersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNumbersRequest.Merge(m, src)
+}
+func (m *QueryNumbersRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryNumbersRequest.Size(m)
+}
+func (m *QueryNumbersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNumbersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNumbersRequest proto.InternalMessageInfo
+
+func (m *QueryNumbersRequest) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *QueryNumbersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNumbersResponse struct {
+	Numbers              []GatewayNumIndex   `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryNumbersResponse) Reset()         { *m = QueryNumbersResponse{} }
+func (m *QueryNumbersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNumbersResponse) ProtoMessage()    {}
+func (*QueryNumbersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{9}
+}
+func (m *QueryNumbersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryNumbersResponse.Unmarshal(m, b)
+}
+func (m *QueryNumbersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryNumbersResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryNumbersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNumbersResponse.Merge(m, src)
+}
+func (m *QueryNumbersResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryNumbersResponse.Size(m)
+}
+func (m *QueryNumbersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNumbersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNumbersResponse proto.InternalMessageInfo
 
-type TestQueryRequest struct {
+func (m *QueryNumbersResponse) GetNumbers() []GatewayNumIndex {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+func (m *QueryNumbersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRedeemingNumbersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRedeemingNumbersRequest) Reset()         { *m = QueryRedeemingNumbersRequest{} }
+func (m *QueryRedeemingNumbersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemingNumbersRequest) ProtoMessage()    {}
+func (*QueryRedeemingNumbersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{10}
+}
+func (m *QueryRedeemingNumbersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRedeemingNumbersRequest.Unmarshal(m, b)
+}
+func (m *QueryRedeemingNumbersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRedeemingNumbersRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRedeemingNumbersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemingNumbersRequest.Merge(m, src)
+}
+func (m *QueryRedeemingNumbersRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRedeemingNumbersRequest.Size(m)
+}
+func (m *QueryRedeemingNumbersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemingNumbersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemingNumbersRequest proto.InternalMessageInfo
+
+type QueryRedeemingNumbersResponse struct {
+	Numbers              []GatewayNumIndex `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryRedeemingNumbersResponse) Reset()         { *m = QueryRedeemingNumbersResponse{} }
+func (m *QueryRedeemingNumbersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemingNumbersResponse) ProtoMessage()    {}
+func (*QueryRedeemingNumbersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{11}
+}
+func (m *QueryRedeemingNumbersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRedeemingNumbersResponse.Unmarshal(m, b)
+}
+func (m *QueryRedeemingNumbersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRedeemingNumbersResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryRedeemingNumbersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemingNumbersResponse.Merge(m, src)
+}
+func (m *QueryRedeemingNumbersResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRedeemingNumbersResponse.Size(m)
+}
+func (m *QueryRedeemingNumbersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemingNumbersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemingNumbersResponse proto.InternalMessageInfo
+
+func (m *QueryRedeemingNumbersResponse) GetNumbers() []GatewayNumIndex {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+type QueryGatewayBonusRequest struct {
+	GatewayAddress       string   `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TestQueryRequest) Reset()         { *m = TestQueryRequest{} }
-func (m *TestQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*TestQueryRequest) ProtoMessage()    {}
-func (*TestQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{0}
-}
-func (m *TestQueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TestQueryRequest.Unmarshal(m, b)
+func (m *QueryGatewayBonusRequest) Reset()         { *m = QueryGatewayBonusRequest{} }
+func (m *QueryGatewayBonusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayBonusRequest) ProtoMessage()    {}
+func (*QueryGatewayBonusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{12}
+}
+func (m *QueryGatewayBonusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayBonusRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewayBonusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayBonusRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayBonusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayBonusRequest.Merge(m, src)
+}
+func (m *QueryGatewayBonusRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayBonusRequest.Size(m)
+}
+func (m *QueryGatewayBonusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayBonusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayBonusRequest proto.InternalMessageInfo
+
+func (m *QueryGatewayBonusRequest) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+type QueryGatewayBonusResponse struct {
+	Amount               types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *QueryGatewayBonusResponse) Reset()         { *m = QueryGatewayBonusResponse{} }
+func (m *QueryGatewayBonusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayBonusResponse) ProtoMessage()    {}
+func (*QueryGatewayBonusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{13}
+}
+func (m *QueryGatewayBonusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayBonusResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewayBonusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayBonusResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayBonusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayBonusResponse.Merge(m, src)
+}
+func (m *QueryGatewayBonusResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayBonusResponse.Size(m)
+}
+func (m *QueryGatewayBonusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayBonusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayBonusResponse proto.InternalMessageInfo
+
+func (m *QueryGatewayBonusResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type QueryAuctionsRequest struct {
+	Pagination           *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryAuctionsRequest) Reset()         { *m = QueryAuctionsRequest{} }
+func (m *QueryAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionsRequest) ProtoMessage()    {}
+func (*QueryAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{14}
+}
+func (m *QueryAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuctionsRequest.Unmarshal(m, b)
+}
+func (m *QueryAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuctionsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionsRequest.Merge(m, src)
+}
+func (m *QueryAuctionsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAuctionsRequest.Size(m)
+}
+func (m *QueryAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAuctionsResponse struct {
+	Auctions             []PrefixAuction     `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination           *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryAuctionsResponse) Reset()         { *m = QueryAuctionsResponse{} }
+func (m *QueryAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionsResponse) ProtoMessage()    {}
+func (*QueryAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{15}
+}
+func (m *QueryAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuctionsResponse.Unmarshal(m, b)
+}
+func (m *QueryAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuctionsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionsResponse.Merge(m, src)
+}
+func (m *QueryAuctionsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAuctionsResponse.Size(m)
+}
+func (m *QueryAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionsResponse) GetAuctions() []PrefixAuction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAuctionBidsRequest struct {
+	NumberIndex          string   `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryAuctionBidsRequest) Reset()         { *m = QueryAuctionBidsRequest{} }
+func (m *QueryAuctionBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsRequest) ProtoMessage()    {}
+func (*QueryAuctionBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{16}
+}
+func (m *QueryAuctionBidsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuctionBidsRequest.Unmarshal(m, b)
+}
+func (m *QueryAuctionBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuctionBidsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryAuctionBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsRequest.Merge(m, src)
+}
+func (m *QueryAuctionBidsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAuctionBidsRequest.Size(m)
+}
+func (m *QueryAuctionBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionBidsRequest) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+type QueryAuctionBidsResponse struct {
+	Auction              PrefixAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	Bids                 []PrefixBid   `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueryAuctionBidsResponse) Reset()         { *m = QueryAuctionBidsResponse{} }
+func (m *QueryAuctionBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsResponse) ProtoMessage()    {}
+func (*QueryAuctionBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{17}
+}
+func (m *QueryAuctionBidsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAuctionBidsResponse.Unmarshal(m, b)
+}
+func (m *QueryAuctionBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAuctionBidsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryAuctionBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsResponse.Merge(m, src)
+}
+func (m *QueryAuctionBidsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAuctionBidsResponse.Size(m)
+}
+func (m *QueryAuctionBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionBidsResponse) GetAuction() PrefixAuction {
+	if m != nil {
+		return m.Auction
+	}
+	return PrefixAuction{}
+}
+
+func (m *QueryAuctionBidsResponse) GetBids() []PrefixBid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+type QueryGatewayUptimeRequest struct {
+	GatewayAddress       string   `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayUptimeRequest) Reset()         { *m = QueryGatewayUptimeRequest{} }
+func (m *QueryGatewayUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayUptimeRequest) ProtoMessage()    {}
+func (*QueryGatewayUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{18}
+}
+func (m *QueryGatewayUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayUptimeRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewayUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayUptimeRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayUptimeRequest.Merge(m, src)
+}
+func (m *QueryGatewayUptimeRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayUptimeRequest.Size(m)
+}
+func (m *QueryGatewayUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayUptimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryGatewayUptimeRequest) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+type QueryGatewayUptimeResponse struct {
+	Liveness GatewayLiveness `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness"`
+
+	Windows uint64 `protobuf:"varint,2,opt,name=windows,proto3" json:"windows,omitempty"`
+
+	Uptime               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *QueryGatewayUptimeResponse) Reset()         { *m = QueryGatewayUptimeResponse{} }
+func (m *QueryGatewayUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayUptimeResponse) ProtoMessage()    {}
+func (*QueryGatewayUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{19}
+}
+func (m *QueryGatewayUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayUptimeResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewayUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayUptimeResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayUptimeResponse.Merge(m, src)
+}
+func (m *QueryGatewayUptimeResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayUptimeResponse.Size(m)
+}
+func (m *QueryGatewayUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayUptimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryGatewayUptimeResponse) GetLiveness() GatewayLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return GatewayLiveness{}
+}
+
+func (m *QueryGatewayUptimeResponse) GetWindows() uint64 {
+	if m != nil {
+		return m.Windows
+	}
+	return 0
+}
+
+type QueryGatewayEndpointsRequest struct {
+	NumberIndex          string   `protobuf:"bytes,1,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGatewayEndpointsRequest) Reset()         { *m = QueryGatewayEndpointsRequest{} }
+func (m *QueryGatewayEndpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayEndpointsRequest) ProtoMessage()    {}
+func (*QueryGatewayEndpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{20}
+}
+func (m *QueryGatewayEndpointsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayEndpointsRequest.Unmarshal(m, b)
+}
+func (m *QueryGatewayEndpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayEndpointsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayEndpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayEndpointsRequest.Merge(m, src)
+}
+func (m *QueryGatewayEndpointsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayEndpointsRequest.Size(m)
+}
+func (m *QueryGatewayEndpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayEndpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayEndpointsRequest proto.InternalMessageInfo
+
+func (m *QueryGatewayEndpointsRequest) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+type QueryGatewayEndpointsResponse struct {
+	GatewayAddress       string            `protobuf:"bytes,1,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	Endpoints            []GatewayEndpoint `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints"`
+	TlsFingerprint       string            `protobuf:"bytes,3,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"`
+	TransportPubKey      string            `protobuf:"bytes,4,opt,name=transport_pub_key,json=transportPubKey,proto3" json:"transport_pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryGatewayEndpointsResponse) Reset()         { *m = QueryGatewayEndpointsResponse{} }
+func (m *QueryGatewayEndpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatewayEndpointsResponse) ProtoMessage()    {}
+func (*QueryGatewayEndpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{21}
+}
+func (m *QueryGatewayEndpointsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGatewayEndpointsResponse.Unmarshal(m, b)
+}
+func (m *QueryGatewayEndpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGatewayEndpointsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryGatewayEndpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatewayEndpointsResponse.Merge(m, src)
+}
+func (m *QueryGatewayEndpointsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryGatewayEndpointsResponse.Size(m)
+}
+func (m *QueryGatewayEndpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatewayEndpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGatewayEndpointsResponse proto.InternalMessageInfo
+
+func (m *QueryGatewayEndpointsResponse) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *QueryGatewayEndpointsResponse) GetEndpoints() []GatewayEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *QueryGatewayEndpointsResponse) GetTlsFingerprint() string {
+	if m != nil {
+		return m.TlsFingerprint
+	}
+	return ""
+}
+
+func (m *QueryGatewayEndpointsResponse) GetTransportPubKey() string {
+	if m != nil {
+		return m.TransportPubKey
+	}
+	return ""
+}
+
+type QueryParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{22}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsRequest.Size(m)
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params               Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{23}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsResponse.Size(m)
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryGatewayRequest)(nil), "freemasonry.comm.v1.QueryGatewayRequest")
+	proto.RegisterType((*QueryGatewayResponse)(nil), "freemasonry.comm.v1.QueryGatewayResponse")
+	proto.RegisterType((*QueryGatewaysRequest)(nil), "freemasonry.comm.v1.QueryGatewaysRequest")
+	proto.RegisterType((*QueryGatewaysResponse)(nil), "freemasonry.comm.v1.QueryGatewaysResponse")
+	proto.RegisterType((*QueryGatewayByNumberRequest)(nil), "freemasonry.comm.v1.QueryGatewayByNumberRequest")
+	proto.RegisterType((*QueryGatewayByNumberResponse)(nil), "freemasonry.comm.v1.QueryGatewayByNumberResponse")
+	proto.RegisterType((*QueryNumberIndexRequest)(nil), "freemasonry.comm.v1.QueryNumberIndexRequest")
+	proto.RegisterType((*QueryNumberIndexResponse)(nil), "freemasonry.comm.v1.QueryNumberIndexResponse")
+	proto.RegisterType((*QueryNumbersRequest)(nil), "freemasonry.comm.v1.QueryNumbersRequest")
+	proto.RegisterType((*QueryNumbersResponse)(nil), "freemasonry.comm.v1.QueryNumbersResponse")
+	proto.RegisterType((*QueryRedeemingNumbersRequest)(nil), "freemasonry.comm.v1.QueryRedeemingNumbersRequest")
+	proto.RegisterType((*QueryRedeemingNumbersResponse)(nil), "freemasonry.comm.v1.QueryRedeemingNumbersResponse")
+	proto.RegisterType((*QueryGatewayBonusRequest)(nil), "freemasonry.comm.v1.QueryGatewayBonusRequest")
+	proto.RegisterType((*QueryGatewayBonusResponse)(nil), "freemasonry.comm.v1.QueryGatewayBonusResponse")
+	proto.RegisterType((*QueryAuctionsRequest)(nil), "freemasonry.comm.v1.QueryAuctionsRequest")
+	proto.RegisterType((*QueryAuctionsResponse)(nil), "freemasonry.comm.v1.QueryAuctionsResponse")
+	proto.RegisterType((*QueryAuctionBidsRequest)(nil), "freemasonry.comm.v1.QueryAuctionBidsRequest")
+	proto.RegisterType((*QueryAuctionBidsResponse)(nil), "freemasonry.comm.v1.QueryAuctionBidsResponse")
+	proto.RegisterType((*QueryGatewayUptimeRequest)(nil), "freemasonry.comm.v1.QueryGatewayUptimeRequest")
+	proto.RegisterType((*QueryGatewayUptimeResponse)(nil), "freemasonry.comm.v1.QueryGatewayUptimeResponse")
+	proto.RegisterType((*QueryGatewayEndpointsRequest)(nil), "freemasonry.comm.v1.QueryGatewayEndpointsRequest")
+	proto.RegisterType((*QueryGatewayEndpointsResponse)(nil), "freemasonry.comm.v1.QueryGatewayEndpointsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "freemasonry.comm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "freemasonry.comm.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+
+var fileDescriptor_5c6ac9b241082464 = []byte{
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xd9, 0x36, 0xd8, 0xe9, 0x73, 0x69, 0xca, 0x24, 0x05, 0x77, 0xf3, 0x0b, 0x96, 0x92,
+	0x98, 0x88, 0xec, 0xd4, 0x2e, 0x21, 0xad, 0x14, 0x2a, 0xc5, 0x0d, 0x29, 0x08, 0x88, 0x82, 0x05,
+	0x17, 0x84, 0xb0, 0xd6, 0xf6, 0xc4, 0x5d, 0x25, 0xde, 0xdd, 0xee, 0xec, 0x26, 0xb1, 0xa2, 0x48,
+	0x80, 0x10, 0xe2, 0xce, 0x01, 0x8e, 0x1c, 0x28, 0x12, 0xe2, 0x00, 0x7f, 0x05, 0xe2, 0xce, 0x8d,
+	0x43, 0x4f, 0x48, 0xfc, 0x1b, 0x68, 0x67, 0xdf, 0x38, 0xbb, 0x9b, 0xf5, 0x7a, 0x5d, 0xe5, 0x14,
+	0x7b, 0xfc, 0xbe, 0xef, 0x7d, 0xe6, 0xcd, 0x9b, 0x37, 0x4f, 0x81, 0xd2, 0x63, 0x9f, 0xb9, 0x7d,
+	0xdd, 0x71, 0x6d, 0xcf, 0x26, 0xd3, 0x7b, 0x2e, 0x63, 0x3d, 0x83, 0xdb, 0x96, 0xdb, 0xd7, 0xdb,
+	0x76, 0xaf, 0xa7, 0x1f, 0x56, 0xd5, 0xb9, 0xae, 0x6d, 0x77, 0x0f, 0x18, 0x35, 0x1c, 0x93, 0x1a,
+	0x96, 0x65, 0x7b, 0x86, 0x67, 0xda, 0x16, 0x0f, 0x25, 0xea, 0x4c, 0xd7, 0xee, 0xda, 0xe2, 0x23,
+	0x0d, 0x3e, 0xe1, 0xea, 0x4a, 0xdb, 0xe6, 0x3d, 0x9b, 0xd3, 0x96, 0xc1, 0x19, 0x15, 0x11, 0xe8,
+	0x61, 0xb5, 0xc5, 0x3c, 0xa3, 0x4a, 0x1d, 0xa3, 0x6b, 0x5a, 0xc2, 0x05, 0xda, 0x2e, 0x44, 0x6d,
+	0xa5, 0x55, 0xdb, 0x36, 0xe5, 0xef, 0x37, 0x02, 0x10, 0x7a, 0x58, 0xa5, 0x5d, 0xc3, 0x63, 0x47,
+	0x06, 0xb2, 0x6a, 0xf7, 0x61, 0xfa, 0xe3, 0xc0, 0xf1, 0xc3, 0x70, 0xb5, 0xc1, 0x1e, 0xfb, 0x8c,
+	0x7b, 0x64, 0x19, 0xa6, 0xd0, 0xae, 0x69, 0x74, 0x3a, 0x2e, 0xe3, 0xbc, 0xac, 0xbc, 0xa2, 0x54,
+	0xae, 0x34, 0xae, 0xe1, 0xf2, 0x66, 0xb8, 0xaa, 0x7d, 0x02, 0x33, 0x71, 0x3d, 0x77, 0x6c, 0x8b,
+	0x33, 0xb2, 0x01, 0x45, 0xb4, 0x14, 0xc2, 0x52, 0x6d, 0x4e, 0x4f, 0xc9, 0x8a, 0x8e, 0xb2, 0xfa,
+	0xc4, 0x5f, 0x4f, 0x17, 0x9f, 0x6b, 0x48, 0x89, 0xf6, 0x45, 0xdc, 0x2b, 0x97, 0x58, 0xdb, 0x00,
+	0x67, 0x1b, 0x47, 0xc7, 0x4b, 0x7a, 0xb8, 0x73, 0x3d, 0xd8, 0xb9, 0x1e, 0x9e, 0x03, 0xee, 0x5f,
+	0xdf, 0x35, 0xba, 0x0c, 0xb5, 0x8d, 0x88, 0x52, 0xfb, 0x49, 0x81, 0x1b, 0x89, 0x00, 0xc8, 0x7d,
+	0x1f, 0x26, 0x11, 0x22, 0xd8, 0xf1, 0xe5, 0x9c, 0xe0, 0x03, 0x0d, 0x79, 0x18, 0x23, 0xbc, 0x24,
+	0x08, 0x97, 0x47, 0x12, 0x86, 0xc1, 0x63, 0x88, 0x6b, 0x30, 0x1b, 0x25, 0xac, 0xf7, 0x77, 0xfc,
+	0x5e, 0x8b, 0xb9, 0x32, 0x13, 0x2f, 0x41, 0xc1, 0x12, 0x0b, 0x78, 0x2e, 0xf8, 0x4d, 0xfb, 0x1c,
+	0xe6, 0xd2, 0x65, 0x17, 0x72, 0x2e, 0x1b, 0xf0, 0xb2, 0xf0, 0x1e, 0x3a, 0x7d, 0xdf, 0xea, 0xb0,
+	0x63, 0x09, 0xf4, 0x2a, 0x5c, 0x0d, 0x11, 0x9a, 0x66, 0xb0, 0x8c, 0x58, 0x25, 0xeb, 0xcc, 0x52,
+	0x33, 0xa1, 0x7c, 0x5e, 0x8d, 0x5c, 0x1f, 0xa5, 0xc8, 0x4b, 0xb5, 0x5b, 0x59, 0x70, 0x3b, 0x7e,
+	0x4f, 0xf8, 0x40, 0xc8, 0x58, 0xa8, 0x6f, 0x15, 0x98, 0x8e, 0xc4, 0xe2, 0xe3, 0xd6, 0x35, 0xd9,
+	0x4e, 0x39, 0xc7, 0x67, 0xa9, 0xb4, 0x27, 0x0a, 0xcc, 0xc4, 0x41, 0x70, 0xc3, 0x5b, 0x50, 0x0c,
+	0x81, 0x65, 0x9d, 0x8d, 0xb3, 0x57, 0x29, 0xbd, 0xb8, 0x72, 0x5b, 0xc0, 0xba, 0x69, 0xb0, 0x0e,
+	0x63, 0x3d, 0xd3, 0xea, 0xc6, 0x13, 0xa7, 0x31, 0x98, 0x1f, 0xf2, 0xfb, 0x45, 0xee, 0x47, 0x7b,
+	0x80, 0x25, 0x22, 0xeb, 0xcf, 0xb6, 0x7c, 0xfe, 0x0c, 0x3d, 0xe9, 0x66, 0x8a, 0x13, 0xe4, 0x5c,
+	0x87, 0x82, 0xd1, 0xb3, 0x7d, 0xcb, 0xc3, 0x12, 0xbb, 0x19, 0xcb, 0x96, 0xcc, 0xd3, 0x03, 0xdb,
+	0xb4, 0x90, 0x0d, 0xcd, 0x07, 0x3d, 0x69, 0xd3, 0x6f, 0x8b, 0xce, 0x7d, 0xd1, 0x3d, 0xe9, 0x17,
+	0xd9, 0x93, 0xce, 0x02, 0x0c, 0x52, 0x3b, 0x69, 0xe0, 0x1a, 0xe6, 0x56, 0x4b, 0xcd, 0xed, 0xae,
+	0xcb, 0xf6, 0xcc, 0x63, 0x94, 0xcb, 0xce, 0x24, 0x95, 0x17, 0x57, 0x2a, 0xb2, 0x09, 0xc8, 0x40,
+	0x66, 0x87, 0x8f, 0xd1, 0x04, 0x7e, 0x54, 0xa0, 0x7c, 0x5e, 0x8e, 0x3b, 0xad, 0x43, 0x11, 0x79,
+	0x31, 0x91, 0xf9, 0x37, 0x2a, 0x85, 0xe4, 0x2e, 0x4c, 0xb4, 0xcc, 0x0e, 0x2f, 0x5f, 0x12, 0x99,
+	0x5a, 0xc8, 0x70, 0x50, 0x37, 0x3b, 0x28, 0x16, 0x0a, 0x6d, 0x2b, 0x5e, 0x37, 0x9f, 0x3a, 0x9e,
+	0xd9, 0x63, 0x63, 0x57, 0xdf, 0x9f, 0x0a, 0xa8, 0x69, 0x6e, 0x70, 0x8b, 0xdb, 0x30, 0x79, 0x60,
+	0x1e, 0x32, 0x4b, 0x3a, 0x18, 0x71, 0x51, 0x3e, 0x44, 0x5b, 0x79, 0x9c, 0x52, 0x4b, 0xca, 0x50,
+	0x3c, 0x32, 0xad, 0x8e, 0x7d, 0xc4, 0xc5, 0x59, 0x4e, 0x34, 0xe4, 0x57, 0xb2, 0x0d, 0x05, 0x5f,
+	0xc4, 0x2c, 0x5f, 0x0e, 0x00, 0xeb, 0x7a, 0xa0, 0xfc, 0xe7, 0xe9, 0xe2, 0x52, 0xd7, 0xf4, 0x1e,
+	0xf9, 0xad, 0x20, 0x02, 0xc5, 0x61, 0x21, 0xfc, 0xb3, 0xca, 0x3b, 0xfb, 0xd4, 0xeb, 0x3b, 0x8c,
+	0xeb, 0x5b, 0xac, 0xdd, 0x40, 0xb5, 0xb6, 0x19, 0x7f, 0x4a, 0xde, 0xb5, 0x3a, 0x8e, 0x6d, 0x5a,
+	0xde, 0x38, 0x87, 0xfd, 0x9f, 0x02, 0xf3, 0x43, 0x7c, 0x60, 0x3a, 0x72, 0x37, 0xe4, 0xf7, 0xe0,
+	0x0a, 0x93, 0x6a, 0x3c, 0xdb, 0xcc, 0xc4, 0xc9, 0x50, 0x98, 0xb8, 0x33, 0x71, 0x10, 0xd2, 0x3b,
+	0xe0, 0xcd, 0x3d, 0xd3, 0xea, 0x32, 0xd7, 0x71, 0x4d, 0xcb, 0x0b, 0x13, 0xd5, 0xb8, 0xe6, 0x1d,
+	0xf0, 0xed, 0xb3, 0x55, 0xb2, 0x02, 0x2f, 0x7a, 0xae, 0x61, 0x71, 0xc7, 0x76, 0xbd, 0xa6, 0xe3,
+	0xb7, 0x9a, 0xfb, 0xac, 0x5f, 0x9e, 0x10, 0xa6, 0x53, 0x83, 0x1f, 0x76, 0xfd, 0xd6, 0x07, 0xac,
+	0xaf, 0xcd, 0x00, 0x11, 0x1b, 0xdd, 0x35, 0x5c, 0xa3, 0x37, 0xe8, 0x9a, 0xbb, 0x30, 0x1d, 0x5b,
+	0xc5, 0x4d, 0xdf, 0x83, 0x82, 0x23, 0x56, 0xb0, 0x02, 0x66, 0xd3, 0x8b, 0x54, 0x98, 0xc8, 0x2e,
+	0x14, 0x0a, 0x6a, 0x5f, 0x4d, 0xc1, 0xf3, 0xc2, 0x25, 0xf9, 0x41, 0x81, 0x22, 0xee, 0x95, 0x54,
+	0x52, 0x1d, 0xa4, 0x0c, 0x76, 0xea, 0x1b, 0x39, 0x2c, 0x43, 0x4a, 0x6d, 0xfd, 0xeb, 0xbf, 0xff,
+	0xfd, 0xfe, 0x52, 0x95, 0x50, 0x1a, 0x91, 0xd0, 0xc4, 0x18, 0xc9, 0xe9, 0x49, 0xe2, 0xfc, 0x4e,
+	0xc9, 0x77, 0x0a, 0x4c, 0xa2, 0x33, 0x4e, 0x46, 0x07, 0x94, 0xd9, 0x52, 0x57, 0xf2, 0x98, 0x22,
+	0xdc, 0xeb, 0x02, 0x6e, 0x91, 0xcc, 0x67, 0xc2, 0x91, 0xdf, 0x15, 0x98, 0x4a, 0x8c, 0x42, 0xe4,
+	0xf6, 0xc8, 0x30, 0x89, 0x61, 0x4b, 0xad, 0x8e, 0xa1, 0x18, 0x27, 0x79, 0xcd, 0x56, 0xbf, 0x19,
+	0x5e, 0x17, 0x7a, 0x12, 0xfe, 0x3d, 0x25, 0x3f, 0x2b, 0x50, 0x8a, 0x0c, 0x48, 0xe4, 0xcd, 0xe1,
+	0xb1, 0xcf, 0x4f, 0x61, 0xea, 0x6a, 0x4e, 0x6b, 0xa4, 0xbc, 0x2b, 0x28, 0x6b, 0xe4, 0x76, 0x2a,
+	0x65, 0xf4, 0x76, 0xd3, 0x93, 0xe8, 0xb7, 0x53, 0xf2, 0x8d, 0x02, 0xc5, 0x1d, 0x1c, 0x42, 0x2a,
+	0xa3, 0x82, 0xf2, 0x1c, 0xd5, 0x97, 0x98, 0x27, 0xb4, 0x5b, 0x02, 0x6d, 0x81, 0xcc, 0x65, 0xa0,
+	0x71, 0xf2, 0xab, 0x02, 0xd7, 0x93, 0x23, 0x09, 0xc9, 0x38, 0xae, 0x21, 0xe3, 0x8d, 0x5a, 0x1b,
+	0x47, 0x82, 0x84, 0xba, 0x20, 0xac, 0x90, 0xa5, 0x54, 0x42, 0x57, 0xca, 0x9a, 0x92, 0xf5, 0x37,
+	0x05, 0xae, 0x46, 0x47, 0x12, 0xb2, 0x3a, 0xba, 0xac, 0x22, 0xf3, 0x8f, 0xaa, 0xe7, 0x35, 0x47,
+	0xbe, 0x0d, 0xc1, 0xf7, 0x36, 0x79, 0x2b, 0xbb, 0x04, 0x03, 0xcd, 0x90, 0x4b, 0x2c, 0x27, 0x91,
+	0xac, 0x4b, 0x9c, 0x18, 0x87, 0xd4, 0x95, 0x3c, 0xa6, 0xb9, 0x2e, 0xf1, 0x60, 0x72, 0x79, 0xa2,
+	0x40, 0x29, 0x32, 0x2d, 0x64, 0x5d, 0x89, 0xf3, 0x33, 0x89, 0xba, 0x9a, 0xd3, 0x1a, 0x99, 0xee,
+	0x09, 0xa6, 0x3b, 0xa4, 0x9a, 0xc9, 0x94, 0xb8, 0x0e, 0x34, 0x98, 0x1f, 0x82, 0x66, 0xf3, 0x42,
+	0xec, 0xd1, 0x27, 0xa3, 0x8f, 0x2c, 0x36, 0x64, 0xa8, 0x34, 0xb7, 0x3d, 0xd2, 0xbe, 0x23, 0x68,
+	0xd7, 0xc9, 0x5a, 0xe6, 0x19, 0x87, 0x0f, 0x7a, 0xca, 0x21, 0xff, 0xa1, 0xc0, 0xf5, 0xe4, 0xd3,
+	0x4c, 0x46, 0x77, 0xbb, 0xe4, 0x28, 0xa0, 0xd6, 0xc6, 0x91, 0x20, 0xfa, 0x9a, 0x40, 0xa7, 0x64,
+	0x35, 0x15, 0x7d, 0xf0, 0x5c, 0x27, 0x1b, 0xcf, 0x97, 0x0a, 0x14, 0xc2, 0x97, 0x91, 0x2c, 0x0f,
+	0x8f, 0x1a, 0x7b, 0x86, 0xd5, 0xca, 0x68, 0x43, 0x84, 0x7a, 0x4d, 0x40, 0xcd, 0x93, 0xd9, 0x54,
+	0xa8, 0xf0, 0x0d, 0xae, 0x57, 0x3e, 0x5b, 0x8a, 0xf9, 0x6b, 0xd3, 0xd6, 0x81, 0xdd, 0xde, 0x6f,
+	0x3f, 0x32, 0x4c, 0x8b, 0x1e, 0x87, 0xd6, 0x62, 0x9c, 0x6a, 0x15, 0xc4, 0x3f, 0x59, 0xee, 0xfc,
+	0x3f, 0x00, 0xf6, 0x6f, 0xf8, 0x34, 0x1f, 0x12, 0x00, 0x00,
+}
+
+
+var _ context.Context
+var _ grpc.ClientConn
+
+
+
+const _ = grpc.SupportPackageIsVersion4
+
+
+
+
+type QueryClient interface {
+
+	Gateway(ctx context.Context, in *QueryGatewayRequest, opts ...grpc.CallOption) (*QueryGatewayResponse, error)
+
+	Gateways(ctx context.Context, in *QueryGatewaysRequest, opts ...grpc.CallOption) (*QueryGatewaysResponse, error)
+
+	GatewayByNumber(ctx context.Context, in *QueryGatewayByNumberRequest, opts ...grpc.CallOption) (*QueryGatewayByNumberResponse, error)
+
+	NumberIndex(ctx context.Context, in *QueryNumberIndexRequest, opts ...grpc.CallOption) (*QueryNumberIndexResponse, error)
+
+	Numbers(ctx context.Context, in *QueryNumbersRequest, opts ...grpc.CallOption) (*QueryNumbersResponse, error)
+
+	RedeemingNumbers(ctx context.Context, in *QueryRedeemingNumbersRequest, opts ...grpc.CallOption) (*QueryRedeemingNumbersResponse, error)
+
+	GatewayBonus(ctx context.Context, in *QueryGatewayBonusRequest, opts ...grpc.CallOption) (*QueryGatewayBonusResponse, error)
+
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+
+	AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error)
+
+	GatewayUptime(ctx context.Context, in *QueryGatewayUptimeRequest, opts ...grpc.CallOption) (*QueryGatewayUptimeResponse, error)
+
+	GatewayEndpoints(ctx context.Context, in *QueryGatewayEndpointsRequest, opts ...grpc.CallOption) (*QueryGatewayEndpointsResponse, error)
+
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
-func (m *TestQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TestQueryRequest.Marshal(b, m, deterministic)
+
+type queryClient struct {
+	cc grpc1.ClientConn
 }
-func (m *TestQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TestQueryRequest.Merge(m, src)
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
-func (m *TestQueryRequest) XXX_Size() int {
-	return xxx_messageInfo_TestQueryRequest.Size(m)
+
+func (c *queryClient) Gateway(ctx context.Context, in *QueryGatewayRequest, opts ...grpc.CallOption) (*QueryGatewayResponse, error) {
+	out := new(QueryGatewayResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/Gateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TestQueryRequest.DiscardUnknown(m)
+
+func (c *queryClient) Gateways(ctx context.Context, in *QueryGatewaysRequest, opts ...grpc.CallOption) (*QueryGatewaysResponse, error) {
+	out := new(QueryGatewaysResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/Gateways", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var xxx_messageInfo_TestQueryRequest proto.InternalMessageInfo
+func (c *queryClient) GatewayByNumber(ctx context.Context, in *QueryGatewayByNumberRequest, opts ...grpc.CallOption) (*QueryGatewayByNumberResponse, error) {
+	out := new(QueryGatewayByNumberResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/GatewayByNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
+func (c *queryClient) NumberIndex(ctx context.Context, in *QueryNumberIndexRequest, opts ...grpc.CallOption) (*QueryNumberIndexResponse, error) {
+	out := new(QueryNumberIndexResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/NumberIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
+func (c *queryClient) Numbers(ctx context.Context, in *QueryNumbersRequest, opts ...grpc.CallOption) (*QueryNumbersResponse, error) {
+	out := new(QueryNumbersResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/Numbers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type TestQueryResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *queryClient) RedeemingNumbers(ctx context.Context, in *QueryRedeemingNumbersRequest, opts ...grpc.CallOption) (*QueryRedeemingNumbersResponse, error) {
+	out := new(QueryRedeemingNumbersResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/RedeemingNumbers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TestQueryResponse) Reset()         { *m = TestQueryResponse{} }
-func (m *TestQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*TestQueryResponse) ProtoMessage()    {}
-func (*TestQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{1}
+func (c *queryClient) GatewayBonus(ctx context.Context, in *QueryGatewayBonusRequest, opts ...grpc.CallOption) (*QueryGatewayBonusResponse, error) {
+	out := new(QueryGatewayBonusResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/GatewayBonus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TestQueryResponse.Unmarshal(m, b)
+
+func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/Auctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TestQueryResponse.Marshal(b, m, deterministic)
+
+func (c *queryClient) AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error) {
+	out := new(QueryAuctionBidsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/AuctionBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TestQueryResponse.Merge(m, src)
+
+func (c *queryClient) GatewayUptime(ctx context.Context, in *QueryGatewayUptimeRequest, opts ...grpc.CallOption) (*QueryGatewayUptimeResponse, error) {
+	out := new(QueryGatewayUptimeResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/GatewayUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryResponse) XXX_Size() int {
-	return xxx_messageInfo_TestQueryResponse.Size(m)
+
+func (c *queryClient) GatewayEndpoints(ctx context.Context, in *QueryGatewayEndpointsRequest, opts ...grpc.CallOption) (*QueryGatewayEndpointsResponse, error) {
+	out := new(QueryGatewayEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/GatewayEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *TestQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TestQueryResponse.DiscardUnknown(m)
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var xxx_messageInfo_TestQueryResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*TestQueryRequest)(nil), "freemasonry.comm.v1.TestQueryRequest")
-	proto.RegisterType((*TestQueryResponse)(nil), "freemasonry.comm.v1.TestQueryResponse")
-}
+type QueryServer interface {
 
-func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
+	Gateway(context.Context, *QueryGatewayRequest) (*QueryGatewayResponse, error)
 
-var fileDescriptor_5c6ac9b241082464 = []byte{
-	
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x8f, 0x31, 0x0b, 0xc2, 0x30,
-	0x10, 0x85, 0x5d, 0x14, 0x8c, 0x8b, 0xa6, 0x4e, 0xc5, 0xa9, 0x60, 0x71, 0x4a, 0x50, 0xff, 0x81,
-	0xff, 0x40, 0x71, 0x12, 0x97, 0x34, 0x9c, 0xb1, 0xd8, 0xe6, 0xd2, 0x5c, 0x2a, 0xf6, 0xdf, 0x4b,
-	0x5b, 0x10, 0x15, 0xc1, 0xed, 0xf1, 0xdd, 0x7b, 0xef, 0xee, 0xd8, 0xa4, 0xaa, 0xc1, 0x37, 0xc2,
-	0x79, 0x0c, 0xc8, 0xa3, 0x8b, 0x07, 0x28, 0x15, 0xa1, 0xf5, 0x8d, 0xd0, 0x58, 0x96, 0xe2, 0xbe,
-	0x8e, 0x17, 0x06, 0xd1, 0x14, 0x20, 0x95, 0xcb, 0xa5, 0xb2, 0x16, 0x83, 0x0a, 0x39, 0x5a, 0xea,
-	0x23, 0xf1, 0xdc, 0xa0, 0xc1, 0x4e, 0xca, 0x56, 0xf5, 0x34, 0xe1, 0x6c, 0x7a, 0x04, 0x0a, 0xfb,
-	0xb6, 0xfb, 0x00, 0x55, 0x0d, 0x14, 0x92, 0x88, 0xcd, 0xde, 0x18, 0x39, 0xb4, 0x04, 0x1b, 0x60,
-	0xc3, 0x0e, 0xf0, 0x33, 0x1b, 0xbf, 0xa6, 0x7c, 0x29, 0x7e, 0x1c, 0x22, 0xbe, 0x1b, 0xe3, 0xf4,
-	0x9f, 0xad, 0x5f, 0x92, 0x0c, 0x76, 0xab, 0x53, 0xfa, 0x61, 0xd5, 0x32, 0x2b, 0x50, 0xdf, 0xf4,
-	0x55, 0xe5, 0x56, 0x3e, 0x64, 0x1b, 0x95, 0xa1, 0x71, 0x40, 0xd9, 0xa8, 0x7b, 0x60, 0xfb, 0x0c,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0x5b, 0x7d, 0x23, 0x18, 0x01, 0x00, 0x00,
-}
+	Gateways(context.Context, *QueryGatewaysRequest) (*QueryGatewaysResponse, error)
 
+	GatewayByNumber(context.Context, *QueryGatewayByNumberRequest) (*QueryGatewayByNumberResponse, error)
 
-var _ context.Context
-var _ grpc.ClientConn
+	NumberIndex(context.Context, *QueryNumberIndexRequest) (*QueryNumberIndexResponse, error)
 
+	Numbers(context.Context, *QueryNumbersRequest) (*QueryNumbersResponse, error)
 
+	RedeemingNumbers(context.Context, *QueryRedeemingNumbersRequest) (*QueryRedeemingNumbersResponse, error)
 
-const _ = grpc.SupportPackageIsVersion4
+	GatewayBonus(context.Context, *QueryGatewayBonusRequest) (*QueryGatewayBonusResponse, error)
 
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
 
-//
+	AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error)
 
-type QueryClient interface {
-	
-	TestQuery(ctx context.Context, in *TestQueryRequest, opts ...grpc.CallOption) (*TestQueryResponse, error)
+	GatewayUptime(context.Context, *QueryGatewayUptimeRequest) (*QueryGatewayUptimeResponse, error)
+
+	GatewayEndpoints(context.Context, *QueryGatewayEndpointsRequest) (*QueryGatewayEndpointsResponse, error)
+
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-type queryClient struct {
-	cc *grpc.ClientConn
+
+type UnimplementedQueryServer struct {
 }
 
-func NewQueryClient(cc *grpc.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (*UnimplementedQueryServer) Gateway(ctx context.Context, req *QueryGatewayRequest) (*QueryGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gateway not implemented")
+}
+func (*UnimplementedQueryServer) Gateways(ctx context.Context, req *QueryGatewaysRequest) (*QueryGatewaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gateways not implemented")
+}
+func (*UnimplementedQueryServer) GatewayByNumber(ctx context.Context, req *QueryGatewayByNumberRequest) (*QueryGatewayByNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayByNumber not implemented")
+}
+func (*UnimplementedQueryServer) NumberIndex(ctx context.Context, req *QueryNumberIndexRequest) (*QueryNumberIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumberIndex not implemented")
+}
+func (*UnimplementedQueryServer) Numbers(ctx context.Context, req *QueryNumbersRequest) (*QueryNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Numbers not implemented")
+}
+func (*UnimplementedQueryServer) RedeemingNumbers(ctx context.Context, req *QueryRedeemingNumbersRequest) (*QueryRedeemingNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemingNumbers not implemented")
+}
+func (*UnimplementedQueryServer) GatewayBonus(ctx context.Context, req *QueryGatewayBonusRequest) (*QueryGatewayBonusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayBonus not implemented")
+}
+func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+}
+func (*UnimplementedQueryServer) AuctionBids(ctx context.Context, req *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBids not implemented")
+}
+func (*UnimplementedQueryServer) GatewayUptime(ctx context.Context, req *QueryGatewayUptimeRequest) (*QueryGatewayUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayUptime not implemented")
+}
+func (*UnimplementedQueryServer) GatewayEndpoints(ctx context.Context, req *QueryGatewayEndpointsRequest) (*QueryGatewayEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayEndpoints not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (c *queryClient) TestQuery(ctx context.Context, in *TestQueryRequest, opts ...grpc.CallOption) (*TestQueryResponse, error) {
-	out := new(TestQueryResponse)
-	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Query/TestQuery", in, out, opts...)
-	if err != nil {
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Gateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Gateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/Gateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gateway(ctx, req.(*QueryGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Gateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/Gateways",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gateways(ctx, req.(*QueryGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-type QueryServer interface {
-	
-	TestQuery(context.Context, *TestQueryRequest) (*TestQueryResponse, error)
+func _Query_GatewayByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatewayByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/GatewayByNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatewayByNumber(ctx, req.(*QueryGatewayByNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NumberIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumberIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NumberIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/NumberIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumberIndex(ctx, req.(*QueryNumberIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-type UnimplementedQueryServer struct {
+func _Query_Numbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Numbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/Numbers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Numbers(ctx, req.(*QueryNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedeemingNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedeemingNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedeemingNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/RedeemingNumbers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedeemingNumbers(ctx, req.(*QueryRedeemingNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedQueryServer) TestQuery(ctx context.Context, req *TestQueryRequest) (*TestQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TestQuery not implemented")
+func _Query_GatewayBonus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayBonusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatewayBonus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/GatewayBonus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatewayBonus(ctx, req.(*QueryGatewayBonusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/Auctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/AuctionBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionBids(ctx, req.(*QueryAuctionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GatewayUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatewayUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/GatewayUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatewayUptime(ctx, req.(*QueryGatewayUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GatewayEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatewayEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatewayEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Query/GatewayEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatewayEndpoints(ctx, req.(*QueryGatewayEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TestQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TestQueryRequest)
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TestQuery(ctx, in)
+		return srv.(QueryServer).Params(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/freemasonry.comm.v1.Query/TestQuery",
+		FullMethod: "/freemasonry.comm.v1.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TestQuery(ctx, req.(*TestQueryRequest))
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -187,8 +1560,52 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "TestQuery",
-			Handler:    _Query_TestQuery_Handler,
+			MethodName: "Gateway",
+			Handler:    _Query_Gateway_Handler,
+		},
+		{
+			MethodName: "Gateways",
+			Handler:    _Query_Gateways_Handler,
+		},
+		{
+			MethodName: "GatewayByNumber",
+			Handler:    _Query_GatewayByNumber_Handler,
+		},
+		{
+			MethodName: "NumberIndex",
+			Handler:    _Query_NumberIndex_Handler,
+		},
+		{
+			MethodName: "Numbers",
+			Handler:    _Query_Numbers_Handler,
+		},
+		{
+			MethodName: "RedeemingNumbers",
+			Handler:    _Query_RedeemingNumbers_Handler,
+		},
+		{
+			MethodName: "GatewayBonus",
+			Handler:    _Query_GatewayBonus_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _Query_Auctions_Handler,
+		},
+		{
+			MethodName: "AuctionBids",
+			Handler:    _Query_AuctionBids_Handler,
+		},
+		{
+			MethodName: "GatewayUptime",
+			Handler:    _Query_GatewayUptime_Handler,
+		},
+		{
+			MethodName: "GatewayEndpoints",
+			Handler:    _Query_GatewayEndpoints_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
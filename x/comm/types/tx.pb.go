@@ -28,21 +28,21 @@ var _ = math.Inf
 
 
 
-const _ = proto.GoGoProtoPackageIsVersion3 
+const _ = proto.GoGoProtoPackageIsVersion3
 
 
 type MsgGatewayRegister struct {
-	
+
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	
+
 	GatewayName string `protobuf:"bytes,2,opt,name=gateway_name,json=gatewayName,proto3" json:"gateway_name,omitempty"`
-	
+
 	GatewayUrl string `protobuf:"bytes,3,opt,name=gateway_url,json=gatewayUrl,proto3" json:"gateway_url,omitempty"`
-	
+
 	Delegation string `protobuf:"bytes,4,opt,name=delegation,proto3" json:"delegation,omitempty"`
-	
+
 	IndexNumber []string `protobuf:"bytes,5,rep,name=index_number,json=indexNumber,proto3" json:"index_number,omitempty"`
-	
+
 	PubKey               string                `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	Commission           types.CommissionRates `protobuf:"bytes,7,opt,name=commission,proto3" json:"commission"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -128,7 +128,7 @@ type MsgGatewayDelegate struct {
 	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
 	Amount           types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	
+
 	IndexNumber          []string `protobuf:"bytes,4,rep,name=index_number,json=indexNumber,proto3" json:"index_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -164,7 +164,7 @@ type MsgGatewayUndelegate struct {
 	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
 	Amount           types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	
+
 	IndexNumber          []string `protobuf:"bytes,4,rep,name=index_number,json=indexNumber,proto3" json:"index_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -196,6 +196,211 @@ func (m *MsgGatewayUndelegate) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgGatewayUndelegate proto.InternalMessageInfo
 
 
+type MsgWithdrawGatewayBonus struct {
+
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgWithdrawGatewayBonus) Reset()         { *m = MsgWithdrawGatewayBonus{} }
+func (m *MsgWithdrawGatewayBonus) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGatewayBonus) ProtoMessage()    {}
+func (*MsgWithdrawGatewayBonus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{3}
+}
+func (m *MsgWithdrawGatewayBonus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgWithdrawGatewayBonus.Unmarshal(m, b)
+}
+func (m *MsgWithdrawGatewayBonus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgWithdrawGatewayBonus.Marshal(b, m, deterministic)
+}
+func (m *MsgWithdrawGatewayBonus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGatewayBonus.Merge(m, src)
+}
+func (m *MsgWithdrawGatewayBonus) XXX_Size() int {
+	return xxx_messageInfo_MsgWithdrawGatewayBonus.Size(m)
+}
+func (m *MsgWithdrawGatewayBonus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGatewayBonus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawGatewayBonus proto.InternalMessageInfo
+
+func (m *MsgWithdrawGatewayBonus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+
+type MsgBid struct {
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+
+	NumberIndex string `protobuf:"bytes,2,opt,name=number_index,json=numberIndex,proto3" json:"number_index,omitempty"`
+
+	Amount               types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MsgBid) Reset()         { *m = MsgBid{} }
+func (m *MsgBid) String() string { return proto.CompactTextString(m) }
+func (*MsgBid) ProtoMessage()    {}
+func (*MsgBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{4}
+}
+func (m *MsgBid) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgBid.Unmarshal(m, b)
+}
+func (m *MsgBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgBid.Marshal(b, m, deterministic)
+}
+func (m *MsgBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBid.Merge(m, src)
+}
+func (m *MsgBid) XXX_Size() int {
+	return xxx_messageInfo_MsgBid.Size(m)
+}
+func (m *MsgBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBid proto.InternalMessageInfo
+
+func (m *MsgBid) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgBid) GetNumberIndex() string {
+	if m != nil {
+		return m.NumberIndex
+	}
+	return ""
+}
+
+func (m *MsgBid) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+
+type MsgGatewayHeartbeat struct {
+
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgGatewayHeartbeat) Reset()         { *m = MsgGatewayHeartbeat{} }
+func (m *MsgGatewayHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgGatewayHeartbeat) ProtoMessage()    {}
+func (*MsgGatewayHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{5}
+}
+func (m *MsgGatewayHeartbeat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgGatewayHeartbeat.Unmarshal(m, b)
+}
+func (m *MsgGatewayHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgGatewayHeartbeat.Marshal(b, m, deterministic)
+}
+func (m *MsgGatewayHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGatewayHeartbeat.Merge(m, src)
+}
+func (m *MsgGatewayHeartbeat) XXX_Size() int {
+	return xxx_messageInfo_MsgGatewayHeartbeat.Size(m)
+}
+func (m *MsgGatewayHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGatewayHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGatewayHeartbeat proto.InternalMessageInfo
+
+func (m *MsgGatewayHeartbeat) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+
+type MsgGatewayEditEndpoints struct {
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+
+	Endpoints []GatewayEndpoint `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints"`
+
+	TlsFingerprint string `protobuf:"bytes,3,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"`
+
+	TransportPubKey      string   `protobuf:"bytes,4,opt,name=transport_pub_key,json=transportPubKey,proto3" json:"transport_pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgGatewayEditEndpoints) Reset()         { *m = MsgGatewayEditEndpoints{} }
+func (m *MsgGatewayEditEndpoints) String() string { return proto.CompactTextString(m) }
+func (*MsgGatewayEditEndpoints) ProtoMessage()    {}
+func (*MsgGatewayEditEndpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{6}
+}
+func (m *MsgGatewayEditEndpoints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgGatewayEditEndpoints.Unmarshal(m, b)
+}
+func (m *MsgGatewayEditEndpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgGatewayEditEndpoints.Marshal(b, m, deterministic)
+}
+func (m *MsgGatewayEditEndpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGatewayEditEndpoints.Merge(m, src)
+}
+func (m *MsgGatewayEditEndpoints) XXX_Size() int {
+	return xxx_messageInfo_MsgGatewayEditEndpoints.Size(m)
+}
+func (m *MsgGatewayEditEndpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGatewayEditEndpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGatewayEditEndpoints proto.InternalMessageInfo
+
+func (m *MsgGatewayEditEndpoints) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgGatewayEditEndpoints) GetEndpoints() []GatewayEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *MsgGatewayEditEndpoints) GetTlsFingerprint() string {
+	if m != nil {
+		return m.TlsFingerprint
+	}
+	return ""
+}
+
+func (m *MsgGatewayEditEndpoints) GetTransportPubKey() string {
+	if m != nil {
+		return m.TransportPubKey
+	}
+	return ""
+}
+
+
 type MsgEmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -206,7 +411,7 @@ func (m *MsgEmptyResponse) Reset()         { *m = MsgEmptyResponse{} }
 func (m *MsgEmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEmptyResponse) ProtoMessage()    {}
 func (*MsgEmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{3}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{7}
 }
 func (m *MsgEmptyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgEmptyResponse.Unmarshal(m, b)
@@ -230,48 +435,64 @@ func init() {
 	proto.RegisterType((*MsgGatewayRegister)(nil), "freemasonry.comm.v1.MsgGatewayRegister")
 	proto.RegisterType((*MsgGatewayDelegate)(nil), "freemasonry.comm.v1.MsgGatewayDelegate")
 	proto.RegisterType((*MsgGatewayUndelegate)(nil), "freemasonry.comm.v1.MsgGatewayUndelegate")
+	proto.RegisterType((*MsgWithdrawGatewayBonus)(nil), "freemasonry.comm.v1.MsgWithdrawGatewayBonus")
+	proto.RegisterType((*MsgBid)(nil), "freemasonry.comm.v1.MsgBid")
+	proto.RegisterType((*MsgGatewayHeartbeat)(nil), "freemasonry.comm.v1.MsgGatewayHeartbeat")
+	proto.RegisterType((*MsgGatewayEditEndpoints)(nil), "freemasonry.comm.v1.MsgGatewayEditEndpoints")
 	proto.RegisterType((*MsgEmptyResponse)(nil), "freemasonry.comm.v1.MsgEmptyResponse")
 }
 
 func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
 
 var fileDescriptor_0fd2153dc07d3b5c = []byte{
-	
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0xdf, 0x97, 0xf4, 0x9b, 0x20, 0x91, 0x0c, 0x95, 0x70, 0xa3, 0xaa, 0x09, 0x16, 0xd0,
-	0xb0, 0xb1, 0x95, 0xb0, 0x40, 0xea, 0x8e, 0x00, 0x42, 0x15, 0x4a, 0x17, 0x96, 0xba, 0x61, 0x63,
-	0x8d, 0xed, 0xcb, 0x60, 0xc5, 0x33, 0x63, 0x79, 0x26, 0x21, 0x7e, 0x03, 0x96, 0x88, 0x3d, 0x52,
-	0x1f, 0x82, 0x87, 0xe0, 0x29, 0xba, 0x66, 0xcd, 0x13, 0x20, 0xdb, 0xe3, 0x24, 0x6e, 0x40, 0xed,
-	0x03, 0xb0, 0xf3, 0x3d, 0xe7, 0xcc, 0x3d, 0x73, 0x8f, 0x67, 0x06, 0x1d, 0xa8, 0xb5, 0x15, 0x27,
-	0x42, 0x09, 0xfc, 0xe0, 0x43, 0x02, 0xc0, 0x88, 0x14, 0x3c, 0x49, 0x2d, 0x5f, 0x30, 0x66, 0xad,
-	0x26, 0x83, 0x63, 0x2a, 0x04, 0x8d, 0xc0, 0x26, 0x71, 0x68, 0x13, 0xce, 0x85, 0x22, 0x2a, 0x14,
-	0x5c, 0x16, 0x4b, 0x06, 0x87, 0x54, 0x50, 0x91, 0x7f, 0xda, 0xd9, 0x97, 0x46, 0x8f, 0x7c, 0x21,
-	0x99, 0x90, 0x6e, 0x41, 0x14, 0x85, 0xa6, 0x4e, 0x8a, 0xca, 0xf6, 0x88, 0x04, 0x7b, 0x35, 0xf1,
-	0x40, 0x91, 0x89, 0xed, 0x8b, 0x90, 0x6b, 0xfe, 0xb1, 0xe6, 0xa5, 0x22, 0x8b, 0x90, 0xd3, 0x8d,
-	0x44, 0xd7, 0x85, 0xca, 0xfc, 0xd6, 0x40, 0x78, 0x2e, 0xe9, 0x5b, 0xa2, 0xe0, 0x13, 0x49, 0x1d,
-	0xa0, 0xa1, 0x54, 0x90, 0x60, 0x03, 0x75, 0x48, 0x10, 0x24, 0x20, 0xa5, 0x51, 0x1f, 0xd5, 0xc7,
-	0xff, 0x3b, 0x65, 0x89, 0x1f, 0xa1, 0x7b, 0xb4, 0x10, 0xbb, 0x9c, 0x30, 0x30, 0x1a, 0x39, 0xdd,
-	0xd5, 0xd8, 0x05, 0x61, 0x80, 0x87, 0xa8, 0x2c, 0xdd, 0x65, 0x12, 0x19, 0xcd, 0x5c, 0x81, 0x34,
-	0x74, 0x99, 0x44, 0xf8, 0x04, 0xa1, 0x00, 0x22, 0xa0, 0x79, 0x00, 0x46, 0xab, 0xe0, 0xb7, 0x48,
-	0xe6, 0x11, 0xf2, 0x00, 0xd6, 0x2e, 0x5f, 0x32, 0x0f, 0x12, 0xe3, 0xbf, 0x51, 0x33, 0xf3, 0xc8,
-	0xb1, 0x8b, 0x1c, 0xc2, 0x0f, 0x51, 0x27, 0x5e, 0x7a, 0xee, 0x02, 0x52, 0xa3, 0x9d, 0xaf, 0x6f,
-	0xc7, 0x4b, 0xef, 0x1d, 0xa4, 0x78, 0x8e, 0x50, 0x16, 0x78, 0x28, 0x65, 0xd6, 0xbb, 0x33, 0xaa,
-	0x8f, 0xbb, 0xd3, 0x53, 0x4b, 0x27, 0x57, 0xce, 0xae, 0xb3, 0xb0, 0x5e, 0x6d, 0x94, 0x0e, 0x51,
-	0x20, 0x67, 0xad, 0x1f, 0xd7, 0xc3, 0x9a, 0xb3, 0xd3, 0xc0, 0xfc, 0x52, 0xc9, 0xe7, 0x75, 0xb1,
-	0x47, 0xc0, 0xe7, 0xa8, 0xaf, 0xf7, 0x2b, 0x12, 0xb7, 0x92, 0xd4, 0xec, 0xf8, 0xd7, 0xf5, 0xd0,
-	0x48, 0x09, 0x8b, 0xce, 0xcc, 0x3d, 0x89, 0xe9, 0xf4, 0x36, 0xd8, 0x4b, 0x1d, 0xe8, 0x39, 0xea,
-	0xaf, 0x48, 0x14, 0x06, 0x95, 0x56, 0x8d, 0x9b, 0xad, 0xf6, 0x24, 0xa6, 0xd3, 0xdb, 0x60, 0x65,
-	0xab, 0x17, 0xa8, 0x4d, 0x98, 0x58, 0x72, 0x95, 0x67, 0xde, 0x9d, 0x1e, 0x95, 0x73, 0x67, 0x67,
-	0x64, 0x67, 0xe8, 0x90, 0xeb, 0x49, 0xb5, 0x7c, 0x2f, 0xf0, 0xd6, 0x5e, 0xe0, 0x67, 0x07, 0x9f,
-	0xaf, 0x86, 0xb5, 0x9f, 0x57, 0xc3, 0x9a, 0xf9, 0xb5, 0x81, 0x0e, 0xb7, 0x91, 0x5c, 0xf2, 0xe0,
-	0x5f, 0x28, 0x35, 0x13, 0xa3, 0xde, 0x5c, 0xd2, 0x37, 0x2c, 0x56, 0xa9, 0x03, 0x32, 0x16, 0x5c,
-	0xc2, 0xf4, 0x7b, 0x03, 0x35, 0xe7, 0x92, 0x62, 0x82, 0xee, 0xdf, 0xbc, 0x5f, 0xa7, 0xd6, 0x1f,
-	0x5e, 0x08, 0x6b, 0xff, 0x22, 0x0e, 0x9e, 0xfc, 0x4d, 0x58, 0xb1, 0xc2, 0x3e, 0xea, 0x57, 0x8f,
-	0x68, 0x76, 0x8d, 0x6e, 0x33, 0x29, 0x4f, 0xf3, 0x5d, 0x4d, 0x60, 0x63, 0xb2, 0xf3, 0xd3, 0x9f,
-	0xdd, 0x62, 0xb2, 0x95, 0xde, 0xd1, 0x66, 0x36, 0x7e, 0xff, 0xb4, 0xa2, 0xf3, 0x6d, 0x2f, 0x12,
-	0xfe, 0xc2, 0xff, 0x48, 0x42, 0x6e, 0xaf, 0xed, 0x6c, 0x9d, 0xad, 0xd2, 0x18, 0xa4, 0xd7, 0xce,
-	0xdf, 0xb0, 0xe7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x98, 0x75, 0x21, 0x79, 0x05, 0x00,
-	0x00,
+
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0xcd, 0x07, 0x04, 0x98, 0x3c, 0x3d, 0x92, 0x81, 0x27, 0x0c, 0x0f, 0x91, 0x3c, 0x8b, 0x57,
+	0xd2, 0x0a, 0xc5, 0x4a, 0x58, 0x54, 0x62, 0xd7, 0xb4, 0xb4, 0x44, 0x55, 0x50, 0x65, 0x09, 0x55,
+	0xea, 0xc6, 0x1a, 0xc7, 0x83, 0x19, 0xc5, 0x9e, 0xb1, 0x66, 0x26, 0x81, 0x6c, 0xba, 0xee, 0xb2,
+	0xea, 0xbe, 0x12, 0xbf, 0xa4, 0xeb, 0xae, 0xfb, 0x03, 0x58, 0x77, 0xdd, 0x5f, 0x50, 0xd9, 0x1e,
+	0x27, 0x04, 0x27, 0x24, 0xed, 0xba, 0x3b, 0xcf, 0xb9, 0x67, 0xee, 0xb9, 0xf7, 0xd8, 0xf7, 0xca,
+	0x60, 0x55, 0x5e, 0xd7, 0x03, 0xce, 0x24, 0x83, 0x1b, 0x17, 0x1c, 0x63, 0x1f, 0x09, 0x46, 0xf9,
+	0xb0, 0xde, 0x65, 0xbe, 0x5f, 0x1f, 0x34, 0x76, 0x76, 0x5d, 0xc6, 0x5c, 0x0f, 0x1b, 0x28, 0x20,
+	0x06, 0xa2, 0x94, 0x49, 0x24, 0x09, 0xa3, 0x22, 0xbe, 0xb2, 0xb3, 0xe9, 0x32, 0x97, 0x45, 0x8f,
+	0x46, 0xf8, 0xa4, 0xd0, 0xed, 0x2e, 0x13, 0x3e, 0x13, 0x56, 0x1c, 0x88, 0x0f, 0x2a, 0xb4, 0x17,
+	0x9f, 0x0c, 0x1b, 0x09, 0x6c, 0x0c, 0x1a, 0x36, 0x96, 0xa8, 0x61, 0x74, 0x19, 0xa1, 0x2a, 0xbe,
+	0xaf, 0xe2, 0x42, 0xa2, 0x1e, 0xa1, 0xee, 0x88, 0xa2, 0xce, 0x8a, 0xf5, 0x4f, 0x58, 0x9d, 0x31,
+	0x68, 0x18, 0x2e, 0x92, 0xf8, 0x0a, 0x0d, 0x63, 0x58, 0xff, 0x9c, 0x03, 0xb0, 0x23, 0xdc, 0x57,
+	0x31, 0x68, 0x62, 0x97, 0x08, 0x89, 0x39, 0xd4, 0xc0, 0x0a, 0x72, 0x1c, 0x8e, 0x85, 0xd0, 0xb2,
+	0xd5, 0x6c, 0x6d, 0xcd, 0x4c, 0x8e, 0xf0, 0x3f, 0xf0, 0x97, 0xca, 0x60, 0x51, 0xe4, 0x63, 0x2d,
+	0x17, 0x85, 0x8b, 0x0a, 0x3b, 0x43, 0x3e, 0x86, 0x15, 0x90, 0x1c, 0xad, 0x3e, 0xf7, 0xb4, 0x7c,
+	0xc4, 0x00, 0x0a, 0x3a, 0xe7, 0x1e, 0xdc, 0x03, 0xc0, 0xc1, 0x1e, 0x76, 0x23, 0x5f, 0xb4, 0xa5,
+	0x38, 0x3e, 0x46, 0x42, 0x0d, 0x42, 0x1d, 0x7c, 0x6d, 0xd1, 0xbe, 0x6f, 0x63, 0xae, 0x2d, 0x57,
+	0xf3, 0xa1, 0x46, 0x84, 0x9d, 0x45, 0x10, 0xdc, 0x02, 0x2b, 0x41, 0xdf, 0xb6, 0x7a, 0x78, 0xa8,
+	0x15, 0xa2, 0xfb, 0x85, 0xa0, 0x6f, 0xbf, 0xc6, 0x43, 0xd8, 0x01, 0x20, 0xec, 0x94, 0x08, 0x11,
+	0xe6, 0x5e, 0xa9, 0x66, 0x6b, 0xc5, 0xe6, 0x41, 0x5d, 0x19, 0x9a, 0x58, 0xa2, 0x2c, 0xaa, 0x3f,
+	0x1f, 0x31, 0x4d, 0x24, 0xb1, 0x68, 0x2d, 0x7d, 0xbd, 0xad, 0x64, 0xcc, 0x3b, 0x09, 0xf4, 0x8f,
+	0x13, 0xfe, 0xbc, 0x88, 0x6b, 0xc4, 0xb0, 0x0d, 0xca, 0xaa, 0x5e, 0xc6, 0xad, 0x09, 0xa7, 0x5a,
+	0xbb, 0x3f, 0x6e, 0x2b, 0xda, 0x10, 0xf9, 0xde, 0xb1, 0x9e, 0xa2, 0xe8, 0x66, 0x69, 0x84, 0x3d,
+	0x53, 0x86, 0xb6, 0x41, 0x79, 0x80, 0x3c, 0xe2, 0x4c, 0xa4, 0xca, 0xdd, 0x4f, 0x95, 0xa2, 0xe8,
+	0x66, 0x69, 0x84, 0x25, 0xa9, 0x9e, 0x82, 0x02, 0xf2, 0x59, 0x9f, 0xca, 0xc8, 0xf3, 0x62, 0x73,
+	0x3b, 0xe9, 0x3b, 0xfc, 0x74, 0xee, 0x34, 0x4d, 0xa8, 0xea, 0x54, 0xd1, 0x53, 0x86, 0x2f, 0xa5,
+	0x0c, 0x3f, 0x5e, 0xfd, 0x70, 0x53, 0xc9, 0x7c, 0xbf, 0xa9, 0x64, 0xf4, 0x4f, 0x39, 0xb0, 0x39,
+	0xb6, 0xe4, 0x9c, 0x3a, 0x7f, 0x4c, 0xc9, 0xe8, 0x47, 0x60, 0xab, 0x23, 0xdc, 0xb7, 0x44, 0x5e,
+	0x3a, 0x1c, 0x5d, 0x29, 0x6f, 0x5a, 0x8c, 0xf6, 0xc5, 0xec, 0x59, 0xd2, 0xdf, 0x83, 0x42, 0x47,
+	0xb8, 0x2d, 0xe2, 0x3c, 0x3c, 0x6f, 0xb1, 0xbe, 0x15, 0x09, 0x27, 0xf3, 0x16, 0x63, 0xed, 0x10,
+	0xfa, 0xed, 0x0e, 0x75, 0x03, 0x6c, 0x8c, 0x5f, 0xe4, 0x29, 0x46, 0x5c, 0xda, 0x18, 0xc9, 0x07,
+	0x0a, 0xfe, 0x96, 0x05, 0x5b, 0xe3, 0x1b, 0x27, 0x0e, 0x91, 0x27, 0xd4, 0x09, 0x18, 0xa1, 0xf2,
+	0x81, 0x36, 0xe1, 0x29, 0x58, 0xc3, 0x09, 0x4d, 0xcb, 0x55, 0xf3, 0xb5, 0x62, 0x73, 0xbf, 0x3e,
+	0x65, 0x71, 0xd6, 0x93, 0xbc, 0x8a, 0xac, 0xaa, 0x1d, 0x5f, 0x86, 0x07, 0x60, 0x5d, 0x7a, 0xc2,
+	0xba, 0x20, 0xd4, 0xc5, 0x3c, 0xe0, 0x44, 0xb5, 0xbc, 0x66, 0xfe, 0x2d, 0x3d, 0xf1, 0x72, 0x8c,
+	0xc2, 0x27, 0xa0, 0x2c, 0x39, 0xa2, 0x22, 0x60, 0x5c, 0x5a, 0xc9, 0xa2, 0x88, 0x17, 0xcd, 0xfa,
+	0x28, 0xf0, 0x26, 0xda, 0x18, 0x3a, 0x04, 0xa5, 0x8e, 0x70, 0x4f, 0xfc, 0x40, 0x0e, 0x4d, 0x2c,
+	0x02, 0x46, 0x05, 0x6e, 0x7e, 0x59, 0x06, 0xf9, 0x8e, 0x70, 0x21, 0x02, 0xeb, 0xf7, 0x57, 0xe3,
+	0xc1, 0xd4, 0xd2, 0xd3, 0x3b, 0x74, 0xe7, 0xff, 0x59, 0xc4, 0x09, 0x29, 0xd8, 0x05, 0xe5, 0xc9,
+	0xed, 0x12, 0x6e, 0xc0, 0x79, 0x22, 0x8a, 0x8a, 0x17, 0x15, 0xc1, 0xa0, 0x9c, 0x9e, 0xd7, 0xc7,
+	0x73, 0x44, 0xc6, 0xd4, 0x45, 0x65, 0x7a, 0x60, 0x73, 0xea, 0x08, 0x1c, 0xce, 0xba, 0x3e, 0x8d,
+	0xbd, 0xa8, 0x58, 0x1b, 0xe4, 0xc3, 0xd1, 0xf9, 0x77, 0x16, 0xbb, 0x45, 0x9c, 0xc5, 0xdf, 0x41,
+	0x29, 0x35, 0x05, 0xb5, 0x39, 0xee, 0x8c, 0x98, 0xbf, 0x60, 0xce, 0xd4, 0xc1, 0x39, 0x9c, 0x23,
+	0x34, 0xc1, 0x5e, 0x50, 0xac, 0x55, 0x7b, 0xf7, 0x68, 0x82, 0xd7, 0x35, 0x6c, 0x8f, 0x75, 0x7b,
+	0xdd, 0x4b, 0x44, 0xa8, 0x71, 0x6d, 0x44, 0x3f, 0x03, 0x72, 0x18, 0x60, 0x61, 0x17, 0xa2, 0x1f,
+	0x81, 0xa3, 0x9f, 0x03, 0x00, 0xa3, 0x15, 0x2d, 0x40, 0xd5, 0x08, 0x00, 0x00,
 }
 
 
@@ -283,22 +504,30 @@ var _ grpc.ClientConn
 const _ = grpc.SupportPackageIsVersion4
 
 
-//
+
 
 type MsgClient interface {
-	
+
 	GatewayRegister(ctx context.Context, in *MsgGatewayRegister, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	
+
 	GatewayDelegation(ctx context.Context, in *MsgGatewayDelegate, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
-	
+
 	GatewayUndelegate(ctx context.Context, in *MsgGatewayUndelegate, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+
+	WithdrawGatewayBonus(ctx context.Context, in *MsgWithdrawGatewayBonus, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+
+	Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+
+	GatewayHeartbeat(ctx context.Context, in *MsgGatewayHeartbeat, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
+
+	GatewayEditEndpoints(ctx context.Context, in *MsgGatewayEditEndpoints, opts ...grpc.CallOption) (*MsgEmptyResponse, error)
 }
 
 type msgClient struct {
-	cc *grpc.ClientConn
+	cc grpc1.ClientConn
 }
 
-func NewMsgClient(cc *grpc.ClientConn) MsgClient {
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
@@ -329,14 +558,58 @@ func (c *msgClient) GatewayUndelegate(ctx context.Context, in *MsgGatewayUndeleg
 	return out, nil
 }
 
+func (c *msgClient) WithdrawGatewayBonus(ctx context.Context, in *MsgWithdrawGatewayBonus, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Msg/WithdrawGatewayBonus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Msg/Bid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GatewayHeartbeat(ctx context.Context, in *MsgGatewayHeartbeat, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Msg/GatewayHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GatewayEditEndpoints(ctx context.Context, in *MsgGatewayEditEndpoints, opts ...grpc.CallOption) (*MsgEmptyResponse, error) {
+	out := new(MsgEmptyResponse)
+	err := c.cc.Invoke(ctx, "/freemasonry.comm.v1.Msg/GatewayEditEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 
 type MsgServer interface {
-	
+
 	GatewayRegister(context.Context, *MsgGatewayRegister) (*MsgEmptyResponse, error)
-	
+
 	GatewayDelegation(context.Context, *MsgGatewayDelegate) (*MsgEmptyResponse, error)
-	
+
 	GatewayUndelegate(context.Context, *MsgGatewayUndelegate) (*MsgEmptyResponse, error)
+
+	WithdrawGatewayBonus(context.Context, *MsgWithdrawGatewayBonus) (*MsgEmptyResponse, error)
+
+	Bid(context.Context, *MsgBid) (*MsgEmptyResponse, error)
+
+	GatewayHeartbeat(context.Context, *MsgGatewayHeartbeat) (*MsgEmptyResponse, error)
+
+	GatewayEditEndpoints(context.Context, *MsgGatewayEditEndpoints) (*MsgEmptyResponse, error)
 }
 
 
@@ -352,6 +625,18 @@ func (*UnimplementedMsgServer) GatewayDelegation(ctx context.Context, req *MsgGa
 func (*UnimplementedMsgServer) GatewayUndelegate(ctx context.Context, req *MsgGatewayUndelegate) (*MsgEmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GatewayUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawGatewayBonus(ctx context.Context, req *MsgWithdrawGatewayBonus) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawGatewayBonus not implemented")
+}
+func (*UnimplementedMsgServer) Bid(ctx context.Context, req *MsgBid) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (*UnimplementedMsgServer) GatewayHeartbeat(ctx context.Context, req *MsgGatewayHeartbeat) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) GatewayEditEndpoints(ctx context.Context, req *MsgGatewayEditEndpoints) (*MsgEmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayEditEndpoints not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -411,6 +696,78 @@ func _Msg_GatewayUndelegate_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawGatewayBonus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawGatewayBonus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawGatewayBonus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Msg/WithdrawGatewayBonus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawGatewayBonus(ctx, req.(*MsgWithdrawGatewayBonus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Msg/Bid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Bid(ctx, req.(*MsgBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GatewayHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGatewayHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GatewayHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Msg/GatewayHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GatewayHeartbeat(ctx, req.(*MsgGatewayHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GatewayEditEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGatewayEditEndpoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GatewayEditEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/freemasonry.comm.v1.Msg/GatewayEditEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GatewayEditEndpoints(ctx, req.(*MsgGatewayEditEndpoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "freemasonry.comm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +784,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GatewayUndelegate",
 			Handler:    _Msg_GatewayUndelegate_Handler,
 		},
+		{
+			MethodName: "WithdrawGatewayBonus",
+			Handler:    _Msg_WithdrawGatewayBonus_Handler,
+		},
+		{
+			MethodName: "Bid",
+			Handler:    _Msg_Bid_Handler,
+		},
+		{
+			MethodName: "GatewayHeartbeat",
+			Handler:    _Msg_GatewayHeartbeat_Handler,
+		},
+		{
+			MethodName: "GatewayEditEndpoints",
+			Handler:    _Msg_GatewayEditEndpoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tx.proto",
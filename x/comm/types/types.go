@@ -1,40 +1,16 @@
 package types
 
 const (
-	MSG_GATEWAY_REGISTER     = "comm/MsgGatewayRegister"
-	MSG_GATEWAY_DELEGATION   = "comm/MsgGatewayDelegation"
-	MSG_GATEWAY_UNDELEGATION = "comm/MsgGatewayUndelegation"
+	MSG_GATEWAY_REGISTER       = "comm/MsgGatewayRegister"
+	MSG_GATEWAY_DELEGATION     = "comm/MsgGatewayDelegation"
+	MSG_GATEWAY_UNDELEGATION   = "comm/MsgGatewayUndelegation"
+	MSG_WITHDRAW_GATEWAY_BONUS = "comm/MsgWithdrawGatewayBonus"
+	MSG_BID                    = "comm/MsgBid"
+	MSG_GATEWAY_HEARTBEAT      = "comm/MsgGatewayHeartbeat"
+	MSG_GATEWAY_EDIT_ENDPOINTS = "comm/MsgGatewayEditEndpoints"
 )
 
 
-type Gateway struct {
-	
-	GatewayAddress string `json:"gateway_address"`
-	
-	GatewayName string `json:"gateway_name"`
-	
-	GatewayUrl string `json:"gateway_url"`
-	
-	GatewayQuota int64 `json:"gateway_quota"`
-	
-	GatewayNum []GatewayNumIndex `json:"gateway_num"`
-}
-
-
-type GatewayNumIndex struct {
-	
-	GatewayAddress string `json:"gateway_address"`
-	
-	NumberIndex string `json:"number_index"`
-	
-	NumberEnd []string `json:"number_end"`
-	
-	Status int64 `json:"status"`
-	
-	Validity int64 `json:"validity"`
-}
-
-
 type ValidatorInfor struct {
 	ValidatorConsAddr string `json:"validator_consaddr"` 
 	ValidatorStatus   string `json:"validator_status"`   